@@ -0,0 +1,124 @@
+package maps_benchmarks
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/concurrent"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/types/optional"
+)
+
+const (
+	size = 100000
+)
+
+var (
+	data = generateData(size)
+)
+
+// concurrentMap a map that is safe for concurrent use.
+type concurrentMap interface {
+	collections.Map[int, int]
+	Compute(key int, f func(int, optional.Optional[int]) optional.Optional[int]) optional.Optional[int]
+}
+
+type constructor struct {
+	new  func() concurrentMap
+	name string
+}
+
+var constructors = []constructor{
+	{
+		name: "SynchronizedMap",
+		new:  func() concurrentMap { return concurrent.NewSynchronizedMap[int, int](hashmap.New[int, int]()) },
+	},
+	{
+		name: "ConcurrentHashMap",
+		new: func() concurrentMap {
+			return concurrent.NewConcurrentHashMap[int, int](concurrent.DefaultSegments, nil)
+		},
+	},
+}
+
+func generateData(size int) []int {
+	data := make([]int, size)
+	for i := range data {
+		data[i] = rand.Intn(size)
+	}
+	return data
+}
+
+func BenchmarkPut(b *testing.B) {
+
+	for _, constructor := range constructors {
+
+		b.Run(fmt.Sprintf("%v-input-count-%d", constructor.name, size), func(b *testing.B) {
+			m := constructor.new()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := rand.Intn(size)
+				for pb.Next() {
+					m.Put(data[i%size], i)
+					i++
+				}
+			})
+		})
+	}
+
+}
+
+func BenchmarkGet(b *testing.B) {
+
+	for _, constructor := range constructors {
+
+		b.Run(fmt.Sprintf("%v-input-count-%d", constructor.name, size), func(b *testing.B) {
+			m := constructor.new()
+			for i, key := range data {
+				m.Put(key, i)
+			}
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := rand.Intn(size)
+				for pb.Next() {
+					m.Get(data[i%size])
+					i++
+				}
+			})
+		})
+	}
+
+}
+
+func BenchmarkMixed(b *testing.B) {
+
+	increment := func(key int, value optional.Optional[int]) optional.Optional[int] {
+		if value.Empty() {
+			return optional.Of(1)
+		}
+		return optional.Of(value.Value() + 1)
+	}
+
+	for _, constructor := range constructors {
+
+		b.Run(fmt.Sprintf("%v-input-count-%d", constructor.name, size), func(b *testing.B) {
+			m := constructor.new()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := rand.Intn(size)
+				for pb.Next() {
+					key := data[i%size]
+					if i%4 == 0 {
+						m.Compute(key, increment)
+					} else {
+						m.Get(key)
+					}
+					i++
+				}
+			})
+		})
+	}
+
+}
@@ -0,0 +1,118 @@
+package dequeue_benchmarks
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/phantom820/collections/concurrent"
+	"github.com/phantom820/collections/queues/vectordequeue"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// mutexDequeue a VectorDequeue guarded by a mutex, the baseline for the lock free structures.
+type mutexDequeue struct {
+	mutex   sync.Mutex
+	dequeue *vectordequeue.VectorDequeue[int]
+}
+
+func (dequeue *mutexDequeue) AddFirst(e int) optional.Optional[int] {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	return dequeue.dequeue.AddFirst(e)
+}
+
+func (dequeue *mutexDequeue) AddLast(e int) optional.Optional[int] {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	return dequeue.dequeue.AddLast(e)
+}
+
+func (dequeue *mutexDequeue) RemoveFirst() optional.Optional[int] {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	return dequeue.dequeue.RemoveFirst()
+}
+
+// concurrentQueue the queue operations exercised by the benchmarks.
+type concurrentQueue interface {
+	AddLast(e int) optional.Optional[int]
+	RemoveFirst() optional.Optional[int]
+}
+
+type queueConstructor struct {
+	new  func() concurrentQueue
+	name string
+}
+
+type stackConstructor struct {
+	new  func() (push func(int), pop func() optional.Optional[int])
+	name string
+}
+
+func BenchmarkConcurrentQueue(b *testing.B) {
+
+	constructors := []queueConstructor{
+		{
+			name: "MutexVectorDequeue",
+			new: func() concurrentQueue {
+				return &mutexDequeue{dequeue: vectordequeue.New[int]()}
+			},
+		},
+		{
+			name: "LockFreeQueue",
+			new: func() concurrentQueue {
+				return concurrent.NewLockFreeQueue[int]()
+			},
+		},
+	}
+
+	for _, constructor := range constructors {
+
+		b.Run(constructor.name, func(b *testing.B) {
+			queue := constructor.new()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for i := 0; pb.Next(); i++ {
+					queue.AddLast(data[i%size])
+					queue.RemoveFirst()
+				}
+			})
+		})
+	}
+
+}
+
+func BenchmarkConcurrentStack(b *testing.B) {
+
+	constructors := []stackConstructor{
+		{
+			name: "MutexVectorDequeue",
+			new: func() (func(int), func() optional.Optional[int]) {
+				dequeue := &mutexDequeue{dequeue: vectordequeue.New[int]()}
+				return func(e int) { dequeue.AddFirst(e) }, dequeue.RemoveFirst
+			},
+		},
+		{
+			name: "LockFreeStack",
+			new: func() (func(int), func() optional.Optional[int]) {
+				stack := concurrent.NewLockFreeStack[int]()
+				return func(e int) { stack.Push(e) }, stack.Pop
+			},
+		},
+	}
+
+	for _, constructor := range constructors {
+
+		b.Run(constructor.name, func(b *testing.B) {
+			push, pop := constructor.new()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for i := 0; pb.Next(); i++ {
+					push(data[i%size])
+					pop()
+				}
+			})
+		})
+	}
+
+}
@@ -0,0 +1,236 @@
+package caches
+
+import (
+	"iter"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/maps/linkedhashmap"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// ARC an adaptive replacement cache. Entries used once are kept apart from entries used at least twice and the cache remembers the keys
+// recently evicted from either group. A miss on a remembered key shifts capacity towards the group it was evicted from, so the cache
+// adapts between favouring recency and favouring frequency. Get, Put and PutIfAbsent on a cached key count as a use.
+type ARC[K comparable, V any] struct {
+	base[K, V]
+	recent        *linkedhashmap.LinkedHashMap[K, V]        // Entries used once, least recently used first.
+	frequent      *linkedhashmap.LinkedHashMap[K, V]        // Entries used at least twice, least recently used first.
+	recentGhost   *linkedhashmap.LinkedHashMap[K, struct{}] // Keys recently evicted from recent, least recently evicted first.
+	frequentGhost *linkedhashmap.LinkedHashMap[K, struct{}] // Keys recently evicted from frequent, least recently evicted first.
+	target        int                                       // The target number of entries in recent.
+}
+
+// NewARC creates a cache that holds at most capacity entries. NewARC panics if the capacity is not positive.
+func NewARC[K comparable, V any](capacity int) *ARC[K, V] {
+	return &ARC[K, V]{
+		base:          newBase[K, V](capacity),
+		recent:        linkedhashmap.New[K, V](),
+		frequent:      linkedhashmap.New[K, V](),
+		recentGhost:   linkedhashmap.New[K, struct{}](),
+		frequentGhost: linkedhashmap.New[K, struct{}](),
+	}
+}
+
+// first returns the first entry of the map, which must not be empty.
+func first[K comparable, V any](linkedHashMap *linkedhashmap.LinkedHashMap[K, V]) pair.Pair[K, V] {
+	return linkedHashMap.Iterator().Next()
+}
+
+// promote moves the entry with the given key to the back of the frequently used entries.
+func (arc *ARC[K, V]) promote(key K, value V) {
+	arc.recent.Remove(key)
+	arc.frequent.Remove(key)
+	arc.frequent.Put(key, value)
+}
+
+// replace evicts an entry to make room for a new one if the cache is full. The entry is taken from the recently used entries if they
+// exceed their target, otherwise from the frequently used entries. The evicted key is remembered as a ghost.
+func (arc *ARC[K, V]) replace(frequentGhostHit bool) {
+	if arc.recent.Len()+arc.frequent.Len() < arc.capacity {
+		return
+	}
+	if !arc.recent.Empty() && (arc.recent.Len() > arc.target || (frequentGhostHit && arc.recent.Len() == arc.target)) {
+		entry := first(arc.recent)
+		arc.recent.Remove(entry.Key())
+		arc.recentGhost.Put(entry.Key(), struct{}{})
+		arc.evicted(entry.Key(), entry.Value())
+		return
+	}
+	entry := first(arc.frequent)
+	arc.frequent.Remove(entry.Key())
+	arc.frequentGhost.Put(entry.Key(), struct{}{})
+	arc.evicted(entry.Key(), entry.Value())
+}
+
+// Put adds a new key/value pair to the cache and optionally returns previously bound value. An entry is evicted if the cache is full.
+func (arc *ARC[K, V]) Put(key K, value V) optional.Optional[V] {
+	if storedValue := arc.Peek(key); !storedValue.Empty() {
+		arc.promote(key, value)
+		return storedValue
+	} else if arc.recentGhost.ContainsKey(key) {
+		arc.target = min(arc.capacity, arc.target+max(arc.frequentGhost.Len()/arc.recentGhost.Len(), 1))
+		arc.replace(false)
+		arc.recentGhost.Remove(key)
+		arc.frequent.Put(key, value)
+		return optional.Empty[V]()
+	} else if arc.frequentGhost.ContainsKey(key) {
+		arc.target = max(0, arc.target-max(arc.recentGhost.Len()/arc.frequentGhost.Len(), 1))
+		arc.replace(true)
+		arc.frequentGhost.Remove(key)
+		arc.frequent.Put(key, value)
+		return optional.Empty[V]()
+	}
+
+	if recentLen := arc.recent.Len() + arc.recentGhost.Len(); recentLen >= arc.capacity {
+		if arc.recent.Len() < arc.capacity {
+			arc.recentGhost.Remove(first(arc.recentGhost).Key())
+			arc.replace(false)
+		} else {
+			entry := first(arc.recent)
+			arc.recent.Remove(entry.Key())
+			arc.evicted(entry.Key(), entry.Value())
+		}
+	} else if totalLen := recentLen + arc.frequent.Len() + arc.frequentGhost.Len(); totalLen >= arc.capacity {
+		if totalLen >= 2*arc.capacity {
+			arc.frequentGhost.Remove(first(arc.frequentGhost).Key())
+		}
+		arc.replace(false)
+	}
+	arc.recent.Put(key, value)
+	return optional.Empty[V]()
+}
+
+// PutIfAbsent adds a new key/value pair to the cache if the key is not already bounded and optionally returns bound value.
+func (arc *ARC[K, V]) PutIfAbsent(key K, value V) optional.Optional[V] {
+	if storedValue := arc.Peek(key); !storedValue.Empty() {
+		arc.promote(key, storedValue.Value())
+		return storedValue
+	}
+	return arc.Put(key, value)
+}
+
+// Get optionally returns the value associated with a key and records a use of the entry.
+func (arc *ARC[K, V]) Get(key K) optional.Optional[V] {
+	value := arc.Peek(key)
+	if !value.Empty() {
+		arc.promote(key, value.Value())
+	}
+	return arc.lookup(value)
+}
+
+// Peek optionally returns the value associated with a key without counting as a use of the entry.
+func (arc *ARC[K, V]) Peek(key K) optional.Optional[V] {
+	if value := arc.recent.Peek(key); !value.Empty() {
+		return value
+	}
+	return arc.frequent.Peek(key)
+}
+
+// GetIf returns the values mapped by keys that match the given predicate.
+func (arc *ARC[K, V]) GetIf(f func(K) bool) []V {
+	return getIf(arc.All(), f)
+}
+
+// Remove removes a key from the cache, returning the value associated previously with that key as an option.
+func (arc *ARC[K, V]) Remove(key K) optional.Optional[V] {
+	if value := arc.recent.Remove(key); !value.Empty() {
+		return value
+	}
+	return arc.frequent.Remove(key)
+}
+
+// RemoveIf removes all the key, value mapping in which the key matches the given predicate.
+func (arc *ARC[K, V]) RemoveIf(f func(K) bool) bool {
+	recentChanged := arc.recent.RemoveIf(f)
+	frequentChanged := arc.frequent.RemoveIf(f)
+	return recentChanged || frequentChanged
+}
+
+// ContainsKey returns true if this cache contains a mapping for the specified key.
+func (arc *ARC[K, V]) ContainsKey(key K) bool {
+	return arc.recent.ContainsKey(key) || arc.frequent.ContainsKey(key)
+}
+
+// ContainsValue returns true if this cache maps one or more keys to the specified value.
+func (arc *ARC[K, V]) ContainsValue(value V, equals func(v1, v2 V) bool) bool {
+	return arc.recent.ContainsValue(value, equals) || arc.frequent.ContainsValue(value, equals)
+}
+
+// Clear removes all of the mappings from this cache and forgets the keys of evicted entries.
+func (arc *ARC[K, V]) Clear() {
+	arc.recent.Clear()
+	arc.frequent.Clear()
+	arc.recentGhost.Clear()
+	arc.frequentGhost.Clear()
+	arc.target = 0
+}
+
+// Keys returns a slice containing the keys in the cache. Keys used once come first followed by keys used at least twice, each group
+// from the least to the most recently used.
+func (arc *ARC[K, V]) Keys() []K {
+	return append(arc.recent.Keys(), arc.frequent.Keys()...)
+}
+
+// Values returns a slice containing the values in the cache in the same order as Keys.
+func (arc *ARC[K, V]) Values() []V {
+	return append(arc.recent.Values(), arc.frequent.Values()...)
+}
+
+// Len returns the number of entries in the cache.
+func (arc *ARC[K, V]) Len() int {
+	return arc.recent.Len() + arc.frequent.Len()
+}
+
+// Empty returns true if the cache has no entries.
+func (arc *ARC[K, V]) Empty() bool {
+	return arc.recent.Empty() && arc.frequent.Empty()
+}
+
+// ForEach performs the given action for each key, value mapping in the cache.
+func (arc *ARC[K, V]) ForEach(f func(K, V)) {
+	arc.recent.ForEach(f)
+	arc.frequent.ForEach(f)
+}
+
+// Iterator returns an iterator over the cache in the same order as Keys.
+func (arc *ARC[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
+	return iterator.Chain(arc.recent.Iterator(), arc.frequent.Iterator())
+}
+
+// All returns a sequence over the key, value pairs in the cache in the same order as Keys.
+func (arc *ARC[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, value := range arc.recent.All() {
+			if !yield(key, value) {
+				return
+			}
+		}
+		for key, value := range arc.frequent.All() {
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// AllKeys returns a sequence over the keys in the cache in the same order as Keys.
+func (arc *ARC[K, V]) AllKeys() iter.Seq[K] {
+	return keysOf(arc.All())
+}
+
+// AllValues returns a sequence over the values in the cache in the same order as Keys.
+func (arc *ARC[K, V]) AllValues() iter.Seq[V] {
+	return valuesOf(arc.All())
+}
+
+// Equals return true if the cache is equal to the given map. The comparison does not count as a use of any entry.
+func (arc *ARC[K, V]) Equals(other collections.Map[K, V], equals func(V, V) bool) bool {
+	return mapEquals(arc.Len(), arc.Peek, other, equals)
+}
+
+// String returns the string representation of the cache.
+func (arc *ARC[K, V]) String() string {
+	return toString(arc.All())
+}
@@ -0,0 +1,51 @@
+package caches
+
+import (
+	"testing"
+
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestARC(t *testing.T) {
+
+	arc := NewARC[int, string](2)
+	evicted := make([]int, 0)
+	arc.OnEviction(func(key int, value string) { evicted = append(evicted, key) })
+
+	type arcTest struct {
+		action   func()
+		expected []int
+		evicted  []int
+		target   int
+	}
+
+	arcTests := []arcTest{
+		{action: func() { arc.Put(1, "a"); arc.Put(2, "b") }, expected: []int{1, 2}, evicted: []int{}, target: 0},
+		{action: func() { arc.Put(3, "c") }, expected: []int{2, 3}, evicted: []int{1}, target: 0},
+		{action: func() { arc.Get(2) }, expected: []int{3, 2}, evicted: []int{1}, target: 0},
+		{action: func() { arc.Peek(3) }, expected: []int{3, 2}, evicted: []int{1}, target: 0},
+		{action: func() { arc.Put(4, "d") }, expected: []int{4, 2}, evicted: []int{1, 3}, target: 0},
+		// 3 was evicted from the recently used entries, asking for it again grows their target.
+		{action: func() { arc.Put(3, "C") }, expected: []int{4, 3}, evicted: []int{1, 3, 2}, target: 1},
+		// 2 was evicted from the frequently used entries, asking for it again shrinks the target of the recently used ones.
+		{action: func() { arc.Put(2, "B") }, expected: []int{3, 2}, evicted: []int{1, 3, 2, 4}, target: 0},
+		{action: func() { arc.PutIfAbsent(3, "c") }, expected: []int{2, 3}, evicted: []int{1, 3, 2, 4}, target: 0},
+	}
+
+	for _, test := range arcTests {
+		test.action()
+		assert.Equal(t, test.expected, arc.Keys())
+		assert.Equal(t, test.evicted, evicted)
+		assert.Equal(t, test.target, arc.target)
+	}
+	assert.Equal(t, "{2=B, 3=C}", arc.String())
+	assert.Equal(t, Stats{Hits: 1, Misses: 0, Evictions: 4}, arc.Stats())
+	assert.True(t, arc.Equals(hashmap.New(pair.Of(3, "C"), pair.Of(2, "B")), func(a, b string) bool { return a == b }))
+	assert.Equal(t, []int{2, 3}, arc.Keys())
+
+	arc.Clear()
+	assert.True(t, arc.Empty())
+	assert.Equal(t, 0, arc.recentGhost.Len()+arc.frequentGhost.Len())
+}
@@ -0,0 +1,174 @@
+// package caches defines bounded maps that evict entries according to a replacement policy. The caches are built on the map types of
+// this module and implement [collections.Map], lookups through Get are recorded in hit and miss statistics and listeners can be
+// notified of evicted entries.
+//
+//  1. LRU[K, V] : Evicts the least recently used entry, backed by an access ordered [linkedhashmap.LinkedHashMap].
+//  2. LFU[K, V] : Evicts the least frequently used entry, ties are broken by evicting the least recently used entry.
+//  3. ARC[K, V] : An adaptive replacement cache that balances between recency and frequency using ghost entries of evicted keys.
+//  4. TTL[K, V] : Expires entries a fixed duration after they were written and evicts the oldest entry when full.
+package caches
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// Cache a [collections.Map] that holds a bounded number of entries and evicts entries according to a replacement policy.
+type Cache[K comparable, V any] interface {
+	collections.Map[K, V]
+	Peek(k K) optional.Optional[V]  // Optionally returns the value associated with a key without counting as a use of the entry.
+	Capacity() int                  // Returns the maximum number of entries the cache holds.
+	Stats() Stats                   // Returns the hit, miss and eviction statistics of the cache.
+	OnEviction(listener func(K, V)) // Registers a listener that is notified of every entry evicted by the cache.
+}
+
+// Stats statistics on the usage of a cache.
+type Stats struct {
+	Hits      int // The number of lookups that found a value.
+	Misses    int // The number of lookups that did not find a value.
+	Evictions int // The number of entries evicted by the cache, either to make room for new entries or because they expired.
+}
+
+// HitRate returns the fraction of lookups that found a value, 0 if there have been no lookups.
+func (stats Stats) HitRate() float64 {
+	if stats.Hits+stats.Misses == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(stats.Hits+stats.Misses)
+}
+
+// Clock returns the current time. Caches that expire entries take a clock so that time can be controlled, [time.Now] is the usual choice.
+type Clock func() time.Time
+
+// base the bookkeeping shared by all caches.
+type base[K comparable, V any] struct {
+	capacity  int
+	stats     Stats
+	listeners []func(K, V)
+}
+
+// newBase creates the bookkeeping for a cache with the given capacity, panics if the capacity is not positive.
+func newBase[K comparable, V any](capacity int) base[K, V] {
+	if capacity < 1 {
+		panic(errors.IllegalArgument("capacity", capacity))
+	}
+	return base[K, V]{capacity: capacity, listeners: make([]func(K, V), 0)}
+}
+
+// Capacity returns the maximum number of entries the cache holds.
+func (base *base[K, V]) Capacity() int {
+	return base.capacity
+}
+
+// Stats returns the hit, miss and eviction statistics of the cache.
+func (base *base[K, V]) Stats() Stats {
+	return base.stats
+}
+
+// OnEviction registers a listener that is notified of every entry evicted by the cache. Listeners are not notified of entries removed
+// through Remove, RemoveIf or Clear.
+func (base *base[K, V]) OnEviction(listener func(K, V)) {
+	base.listeners = append(base.listeners, listener)
+}
+
+// lookup records the outcome of a lookup and returns the value unchanged.
+func (base *base[K, V]) lookup(value optional.Optional[V]) optional.Optional[V] {
+	if value.Empty() {
+		base.stats.Misses++
+	} else {
+		base.stats.Hits++
+	}
+	return value
+}
+
+// evicted records the eviction of an entry and notifies the listeners.
+func (base *base[K, V]) evicted(key K, value V) {
+	base.stats.Evictions++
+	for _, listener := range base.listeners {
+		listener(key, value)
+	}
+}
+
+// keysOf returns a sequence over the keys of a sequence.
+func keysOf[K comparable, V any](all iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range all {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// valuesOf returns a sequence over the values of a sequence.
+func valuesOf[K comparable, V any](all iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range all {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// getIf returns the values in the sequence mapped by keys that match the given predicate.
+func getIf[K comparable, V any](all iter.Seq2[K, V], f func(K) bool) []V {
+	values := make([]V, 0)
+	for k, v := range all {
+		if f(k) {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// containsValue returns true if any key in the sequence is mapped to the value.
+func containsValue[K comparable, V any](all iter.Seq2[K, V], value V, equals func(v1, v2 V) bool) bool {
+	for _, v := range all {
+		if equals(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// mapEquals returns true if a cache with the given length and lookup holds the same entries as the other map.
+func mapEquals[K comparable, V any](len int, peek func(K) optional.Optional[V], other collections.Map[K, V], equals func(V, V) bool) bool {
+	if len != other.Len() {
+		return false
+	}
+	it := other.Iterator()
+	for it.HasNext() {
+		pair := it.Next()
+		result := peek(pair.Key())
+		if result.Empty() {
+			return false
+		} else if !equals(pair.Value(), result.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// toString returns the string representation of the entries of a sequence.
+func toString[K comparable, V any](all iter.Seq2[K, V]) string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for k, v := range all {
+		if i == 0 {
+			sb.WriteString(fmt.Sprintf("%v=%v", k, v))
+		} else {
+			sb.WriteString(fmt.Sprintf(", %v=%v", k, v))
+		}
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
@@ -0,0 +1,87 @@
+package caches
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapacity(t *testing.T) {
+
+	assert.Equal(t, 2, NewLRU[int, string](2).Capacity())
+	assert.Equal(t, 2, NewLFU[int, string](2).Capacity())
+	assert.Equal(t, 2, NewARC[int, string](2).Capacity())
+	assert.Equal(t, 2, NewTTL[int, string](2, time.Second, nil).Capacity())
+
+	assert.PanicsWithError(t, errors.IllegalArgument("capacity", 0).Error(), func() { NewLRU[int, string](0) })
+	assert.PanicsWithError(t, errors.IllegalArgument("capacity", -1).Error(), func() { NewLFU[int, string](-1) })
+	assert.PanicsWithError(t, errors.IllegalArgument("capacity", 0).Error(), func() { NewARC[int, string](0) })
+	assert.PanicsWithError(t, errors.IllegalArgument("capacity", 0).Error(), func() { NewTTL[int, string](0, time.Second, nil) })
+}
+
+func TestStats(t *testing.T) {
+
+	type statsTest struct {
+		stats   Stats
+		hitRate float64
+	}
+
+	statsTests := []statsTest{
+		{stats: Stats{}, hitRate: 0},
+		{stats: Stats{Hits: 1, Misses: 3}, hitRate: 0.25},
+		{stats: Stats{Hits: 2, Evictions: 5}, hitRate: 1},
+	}
+
+	for _, test := range statsTests {
+		assert.Equal(t, test.hitRate, test.stats.HitRate())
+	}
+}
+
+func TestCache(t *testing.T) {
+
+	caches := []Cache[int, string]{NewLRU[int, string](3), NewLFU[int, string](3), NewARC[int, string](3),
+		NewTTL[int, string](3, time.Hour, nil)}
+	equals := func(a, b string) bool { return a == b }
+
+	for _, cache := range caches {
+		evicted := 0
+		cache.OnEviction(func(int, string) { evicted++ })
+
+		assert.True(t, cache.Put(1, "a").Empty())
+		assert.True(t, cache.PutIfAbsent(2, "b").Empty())
+		assert.Equal(t, "b", cache.PutIfAbsent(2, "B").Value())
+		assert.Equal(t, "a", cache.Put(1, "A").Value())
+		assert.Equal(t, "A", cache.Get(1).Value())
+		assert.True(t, cache.Get(3).Empty())
+		assert.Equal(t, "b", cache.Peek(2).Value())
+		assert.Equal(t, Stats{Hits: 1, Misses: 1}, cache.Stats())
+
+		assert.True(t, cache.ContainsKey(1))
+		assert.True(t, cache.ContainsValue("b", equals))
+		assert.False(t, cache.ContainsValue("c", equals))
+		assert.ElementsMatch(t, []int{1, 2}, cache.Keys())
+		assert.ElementsMatch(t, []string{"A", "b"}, cache.Values())
+		assert.ElementsMatch(t, []string{"A"}, cache.GetIf(func(k int) bool { return k == 1 }))
+		assert.Equal(t, 2, cache.Len())
+
+		cache.Put(3, "c")
+		cache.Put(4, "d")
+		assert.Equal(t, 3, cache.Len())
+		assert.Equal(t, 1, cache.Stats().Evictions)
+		assert.Equal(t, 1, evicted)
+
+		assert.Equal(t, "d", cache.Remove(4).Value())
+		assert.True(t, cache.Remove(4).Empty())
+		assert.True(t, cache.RemoveIf(func(k int) bool { return k != 1 }))
+		assert.False(t, cache.RemoveIf(func(k int) bool { return k != 1 }))
+		assert.Equal(t, 1, cache.Len())
+		assert.Equal(t, 1, evicted)
+
+		cache.Clear()
+		assert.True(t, cache.Empty())
+		assert.Equal(t, "{}", fmt.Sprint(cache))
+	}
+}
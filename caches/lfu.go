@@ -0,0 +1,245 @@
+package caches
+
+import (
+	"iter"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/maps/linkedhashmap"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// lfuEntry a value cached by an [LFU] along with the number of times it has been used.
+type lfuEntry[V any] struct {
+	value     V
+	frequency int
+}
+
+// LFU a cache that evicts the least frequently used entry once it is full, ties are broken by evicting the least recently used of the
+// candidates. Get, Put and PutIfAbsent on a cached key count as a use. All operations run in O(1).
+type LFU[K comparable, V any] struct {
+	base[K, V]
+	entries      hashmap.HashMap[K, *lfuEntry[V]]
+	frequencies  hashmap.HashMap[int, *linkedhashmap.LinkedHashMap[K, struct{}]] // The keys used a given number of times, least recently used first.
+	minFrequency int                                                             // The smallest frequency of a cached key.
+}
+
+// NewLFU creates a cache that holds at most capacity entries. NewLFU panics if the capacity is not positive.
+func NewLFU[K comparable, V any](capacity int) *LFU[K, V] {
+	return &LFU[K, V]{
+		base:        newBase[K, V](capacity),
+		entries:     hashmap.New[K, *lfuEntry[V]](),
+		frequencies: hashmap.New[int, *linkedhashmap.LinkedHashMap[K, struct{}]]()}
+}
+
+// link adds the key to the keys with the given frequency.
+func (lfu *LFU[K, V]) link(key K, frequency int) {
+	keys, ok := lfu.frequencies[frequency]
+	if !ok {
+		keys = linkedhashmap.New[K, struct{}]()
+		lfu.frequencies[frequency] = keys
+	}
+	keys.Put(key, struct{}{})
+}
+
+// unlink removes the key from the keys with the given frequency.
+func (lfu *LFU[K, V]) unlink(key K, frequency int) {
+	keys := lfu.frequencies[frequency]
+	keys.Remove(key)
+	if keys.Empty() {
+		delete(lfu.frequencies, frequency)
+	}
+}
+
+// use records a use of the entry with the given key.
+func (lfu *LFU[K, V]) use(key K, entry *lfuEntry[V]) {
+	lfu.unlink(key, entry.frequency)
+	if entry.frequency == lfu.minFrequency && !lfu.frequencies.ContainsKey(entry.frequency) {
+		lfu.minFrequency++
+	}
+	entry.frequency++
+	lfu.link(key, entry.frequency)
+}
+
+// evict evicts the least recently used of the least frequently used entries.
+func (lfu *LFU[K, V]) evict() {
+	key := first(lfu.frequencies[lfu.minFrequency]).Key()
+	entry := lfu.entries[key]
+	lfu.unlink(key, entry.frequency)
+	delete(lfu.entries, key)
+	lfu.evicted(key, entry.value)
+}
+
+// Put adds a new key/value pair to the cache and optionally returns previously bound value. The least frequently used entry is evicted
+// if the cache is full.
+func (lfu *LFU[K, V]) Put(key K, value V) optional.Optional[V] {
+	if entry, ok := lfu.entries[key]; ok {
+		storedValue := entry.value
+		entry.value = value
+		lfu.use(key, entry)
+		return optional.Of(storedValue)
+	}
+	if len(lfu.entries) >= lfu.capacity {
+		lfu.evict()
+	}
+	lfu.entries[key] = &lfuEntry[V]{value: value, frequency: 1}
+	lfu.link(key, 1)
+	lfu.minFrequency = 1
+	return optional.Empty[V]()
+}
+
+// PutIfAbsent adds a new key/value pair to the cache if the key is not already bounded and optionally returns bound value.
+func (lfu *LFU[K, V]) PutIfAbsent(key K, value V) optional.Optional[V] {
+	if entry, ok := lfu.entries[key]; ok {
+		lfu.use(key, entry)
+		return optional.Of(entry.value)
+	}
+	return lfu.Put(key, value)
+}
+
+// Get optionally returns the value associated with a key and records a use of the entry.
+func (lfu *LFU[K, V]) Get(key K) optional.Optional[V] {
+	entry, ok := lfu.entries[key]
+	if !ok {
+		return lfu.lookup(optional.Empty[V]())
+	}
+	lfu.use(key, entry)
+	return lfu.lookup(optional.Of(entry.value))
+}
+
+// Peek optionally returns the value associated with a key without counting as a use of the entry.
+func (lfu *LFU[K, V]) Peek(key K) optional.Optional[V] {
+	if entry, ok := lfu.entries[key]; ok {
+		return optional.Of(entry.value)
+	}
+	return optional.Empty[V]()
+}
+
+// Frequency returns the number of times the entry with the given key has been used since it was added, 0 if the key is not cached.
+func (lfu *LFU[K, V]) Frequency(key K) int {
+	if entry, ok := lfu.entries[key]; ok {
+		return entry.frequency
+	}
+	return 0
+}
+
+// GetIf returns the values mapped by keys that match the given predicate.
+func (lfu *LFU[K, V]) GetIf(f func(K) bool) []V {
+	return getIf(lfu.All(), f)
+}
+
+// Remove removes a key from the cache, returning the value associated previously with that key as an option.
+func (lfu *LFU[K, V]) Remove(key K) optional.Optional[V] {
+	entry, ok := lfu.entries[key]
+	if !ok {
+		return optional.Empty[V]()
+	}
+	// The smallest frequency may go stale here, that is harmless since only an insertion evicts and every insertion resets it.
+	lfu.unlink(key, entry.frequency)
+	delete(lfu.entries, key)
+	return optional.Of(entry.value)
+}
+
+// RemoveIf removes all the key, value mapping in which the key matches the given predicate.
+func (lfu *LFU[K, V]) RemoveIf(f func(K) bool) bool {
+	keysToRemove := make([]K, 0)
+	for key := range lfu.entries {
+		if f(key) {
+			keysToRemove = append(keysToRemove, key)
+		}
+	}
+	for _, key := range keysToRemove {
+		lfu.Remove(key)
+	}
+	return len(keysToRemove) > 0
+}
+
+// ContainsKey returns true if this cache contains a mapping for the specified key.
+func (lfu *LFU[K, V]) ContainsKey(key K) bool {
+	return lfu.entries.ContainsKey(key)
+}
+
+// ContainsValue returns true if this cache maps one or more keys to the specified value.
+func (lfu *LFU[K, V]) ContainsValue(value V, equals func(v1, v2 V) bool) bool {
+	return containsValue(lfu.All(), value, equals)
+}
+
+// Clear removes all of the mappings from this cache.
+func (lfu *LFU[K, V]) Clear() {
+	lfu.entries.Clear()
+	lfu.frequencies.Clear()
+	lfu.minFrequency = 0
+}
+
+// Keys returns a slice containing the keys in the cache.
+func (lfu *LFU[K, V]) Keys() []K {
+	return lfu.entries.Keys()
+}
+
+// Values returns a slice containing the values in the cache.
+func (lfu *LFU[K, V]) Values() []V {
+	values := make([]V, 0, len(lfu.entries))
+	for _, entry := range lfu.entries {
+		values = append(values, entry.value)
+	}
+	return values
+}
+
+// Len returns the number of entries in the cache.
+func (lfu *LFU[K, V]) Len() int {
+	return len(lfu.entries)
+}
+
+// Empty returns true if the cache has no entries.
+func (lfu *LFU[K, V]) Empty() bool {
+	return len(lfu.entries) == 0
+}
+
+// ForEach performs the given action for each key, value mapping in the cache.
+func (lfu *LFU[K, V]) ForEach(f func(K, V)) {
+	for key, entry := range lfu.entries {
+		f(key, entry.value)
+	}
+}
+
+// Iterator returns an iterator over a snapshot of the cache. There is no guarantee on the order of iteration.
+func (lfu *LFU[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
+	entries := make([]pair.Pair[K, V], 0, len(lfu.entries))
+	for key, entry := range lfu.entries {
+		entries = append(entries, pair.Of(key, entry.value))
+	}
+	return iterator.Of(entries...)
+}
+
+// All returns a sequence over the key, value pairs in the cache. There is no guarantee on the order of iteration.
+func (lfu *LFU[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, entry := range lfu.entries {
+			if !yield(key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// AllKeys returns a sequence over the keys in the cache.
+func (lfu *LFU[K, V]) AllKeys() iter.Seq[K] {
+	return keysOf(lfu.All())
+}
+
+// AllValues returns a sequence over the values in the cache.
+func (lfu *LFU[K, V]) AllValues() iter.Seq[V] {
+	return valuesOf(lfu.All())
+}
+
+// Equals return true if the cache is equal to the given map. The comparison does not count as a use of any entry.
+func (lfu *LFU[K, V]) Equals(other collections.Map[K, V], equals func(V, V) bool) bool {
+	return mapEquals(lfu.Len(), lfu.Peek, other, equals)
+}
+
+// String returns the string representation of the cache.
+func (lfu *LFU[K, V]) String() string {
+	return toString(lfu.All())
+}
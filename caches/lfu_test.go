@@ -0,0 +1,63 @@
+package caches
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLFU(t *testing.T) {
+
+	lfu := NewLFU[int, string](3)
+	evicted := make([]int, 0)
+	lfu.OnEviction(func(key int, value string) { evicted = append(evicted, key) })
+
+	type lfuTest struct {
+		action   func()
+		expected []int
+		evicted  []int
+	}
+
+	lfuTests := []lfuTest{
+		{action: func() { lfu.Put(1, "a"); lfu.Put(2, "b"); lfu.Put(3, "c") }, expected: []int{1, 2, 3}, evicted: []int{}},
+		{action: func() { lfu.Get(1); lfu.Get(1); lfu.Get(2) }, expected: []int{1, 2, 3}, evicted: []int{}},
+		{action: func() { lfu.Peek(3) }, expected: []int{1, 2, 3}, evicted: []int{}},
+		{action: func() { lfu.Put(4, "d") }, expected: []int{1, 2, 4}, evicted: []int{3}},
+		{action: func() { lfu.Put(4, "D"); lfu.Put(5, "e") }, expected: []int{1, 4, 5}, evicted: []int{3, 2}},
+		{action: func() { lfu.PutIfAbsent(5, "E"); lfu.Put(6, "f") }, expected: []int{1, 5, 6}, evicted: []int{3, 2, 4}},
+		{action: func() { lfu.Remove(6); lfu.Put(7, "g"); lfu.Put(8, "h") }, expected: []int{1, 5, 8}, evicted: []int{3, 2, 4, 7}},
+	}
+
+	for _, test := range lfuTests {
+		test.action()
+		assert.ElementsMatch(t, test.expected, lfu.Keys())
+		assert.Equal(t, test.evicted, evicted)
+	}
+	assert.Equal(t, 3, lfu.Frequency(1))
+	assert.Equal(t, 2, lfu.Frequency(5))
+	assert.Equal(t, 1, lfu.Frequency(8))
+	assert.Equal(t, 0, lfu.Frequency(2))
+	assert.Equal(t, Stats{Hits: 3, Misses: 0, Evictions: 4}, lfu.Stats())
+	assert.True(t, lfu.Equals(hashmap.New(pair.Of(1, "a"), pair.Of(5, "e"), pair.Of(8, "h")), func(a, b string) bool { return a == b }))
+	assert.Equal(t, 3, lfu.Frequency(1))
+}
+
+func TestLFUAbandonedIterators(t *testing.T) {
+
+	lfu := NewLFU[int, string](3)
+	lfu.Put(1, "a")
+	lfu.Put(2, "b")
+	other := hashmap.New(pair.Of(1, "a"), pair.Of(2, "c"))
+	equals := func(a, b string) bool { return a == b }
+
+	goroutines := runtime.NumGoroutine()
+	for i := 0; i < 1000; i++ {
+		lfu.Iterator().Next()
+		lfu.Iterator().HasNext()
+		assert.False(t, other.Equals(lfu, equals))
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), goroutines)
+}
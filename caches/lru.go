@@ -0,0 +1,136 @@
+package caches
+
+import (
+	"iter"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/maps/linkedhashmap"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// LRU a cache that evicts the least recently used entry once it is full. Get, Put and PutIfAbsent on a cached key count as a use.
+type LRU[K comparable, V any] struct {
+	base[K, V]
+	linkedHashMap *linkedhashmap.LinkedHashMap[K, V]
+}
+
+// NewLRU creates a cache that holds at most capacity entries. NewLRU panics if the capacity is not positive.
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	lru := LRU[K, V]{base: newBase[K, V](capacity), linkedHashMap: linkedhashmap.NewAccessOrder[K, V]()}
+	lru.linkedHashMap.SetRemoveEldest(func(eldest pair.Pair[K, V], len int) bool {
+		if len > lru.capacity {
+			lru.evicted(eldest.Key(), eldest.Value())
+			return true
+		}
+		return false
+	})
+	return &lru
+}
+
+// Put adds a new key/value pair to the cache and optionally returns previously bound value. The least recently used entry is evicted
+// if the cache is full.
+func (lru *LRU[K, V]) Put(key K, value V) optional.Optional[V] {
+	return lru.linkedHashMap.Put(key, value)
+}
+
+// PutIfAbsent adds a new key/value pair to the cache if the key is not already bounded and optionally returns bound value.
+func (lru *LRU[K, V]) PutIfAbsent(key K, value V) optional.Optional[V] {
+	return lru.linkedHashMap.PutIfAbsent(key, value)
+}
+
+// Get optionally returns the value associated with a key and marks the entry as the most recently used.
+func (lru *LRU[K, V]) Get(key K) optional.Optional[V] {
+	return lru.lookup(lru.linkedHashMap.Get(key))
+}
+
+// Peek optionally returns the value associated with a key without counting as a use of the entry.
+func (lru *LRU[K, V]) Peek(key K) optional.Optional[V] {
+	return lru.linkedHashMap.Peek(key)
+}
+
+// GetIf returns the values mapped by keys that match the given predicate.
+func (lru *LRU[K, V]) GetIf(f func(K) bool) []V {
+	return lru.linkedHashMap.GetIf(f)
+}
+
+// Remove removes a key from the cache, returning the value associated previously with that key as an option.
+func (lru *LRU[K, V]) Remove(key K) optional.Optional[V] {
+	return lru.linkedHashMap.Remove(key)
+}
+
+// RemoveIf removes all the key, value mapping in which the key matches the given predicate.
+func (lru *LRU[K, V]) RemoveIf(f func(K) bool) bool {
+	return lru.linkedHashMap.RemoveIf(f)
+}
+
+// ContainsKey returns true if this cache contains a mapping for the specified key.
+func (lru *LRU[K, V]) ContainsKey(key K) bool {
+	return lru.linkedHashMap.ContainsKey(key)
+}
+
+// ContainsValue returns true if this cache maps one or more keys to the specified value.
+func (lru *LRU[K, V]) ContainsValue(value V, equals func(v1, v2 V) bool) bool {
+	return lru.linkedHashMap.ContainsValue(value, equals)
+}
+
+// Clear removes all of the mappings from this cache.
+func (lru *LRU[K, V]) Clear() {
+	lru.linkedHashMap.Clear()
+}
+
+// Keys returns a slice containing the keys in the cache from the least to the most recently used.
+func (lru *LRU[K, V]) Keys() []K {
+	return lru.linkedHashMap.Keys()
+}
+
+// Values returns a slice containing the values in the cache from the least to the most recently used.
+func (lru *LRU[K, V]) Values() []V {
+	return lru.linkedHashMap.Values()
+}
+
+// Len returns the number of entries in the cache.
+func (lru *LRU[K, V]) Len() int {
+	return lru.linkedHashMap.Len()
+}
+
+// Empty returns true if the cache has no entries.
+func (lru *LRU[K, V]) Empty() bool {
+	return lru.linkedHashMap.Empty()
+}
+
+// ForEach performs the given action for each key, value mapping in the cache.
+func (lru *LRU[K, V]) ForEach(f func(K, V)) {
+	lru.linkedHashMap.ForEach(f)
+}
+
+// Iterator returns an iterator over the cache from the least to the most recently used entry.
+func (lru *LRU[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
+	return lru.linkedHashMap.Iterator()
+}
+
+// All returns a sequence over the key, value pairs in the cache from the least to the most recently used entry.
+func (lru *LRU[K, V]) All() iter.Seq2[K, V] {
+	return lru.linkedHashMap.All()
+}
+
+// AllKeys returns a sequence over the keys in the cache from the least to the most recently used.
+func (lru *LRU[K, V]) AllKeys() iter.Seq[K] {
+	return lru.linkedHashMap.AllKeys()
+}
+
+// AllValues returns a sequence over the values in the cache from the least to the most recently used.
+func (lru *LRU[K, V]) AllValues() iter.Seq[V] {
+	return lru.linkedHashMap.AllValues()
+}
+
+// Equals return true if the cache is equal to the given map. The comparison does not count as a use of any entry.
+func (lru *LRU[K, V]) Equals(other collections.Map[K, V], equals func(V, V) bool) bool {
+	return lru.linkedHashMap.Equals(other, equals)
+}
+
+// String returns the string representation of the cache.
+func (lru *LRU[K, V]) String() string {
+	return lru.linkedHashMap.String()
+}
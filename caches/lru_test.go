@@ -0,0 +1,42 @@
+package caches
+
+import (
+	"testing"
+
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU(t *testing.T) {
+
+	lru := NewLRU[int, string](3)
+	evicted := make([]int, 0)
+	lru.OnEviction(func(key int, value string) { evicted = append(evicted, key) })
+
+	type lruTest struct {
+		action   func()
+		expected []int
+		evicted  []int
+	}
+
+	lruTests := []lruTest{
+		{action: func() { lru.Put(1, "a"); lru.Put(2, "b"); lru.Put(3, "c") }, expected: []int{1, 2, 3}, evicted: []int{}},
+		{action: func() { lru.Get(1) }, expected: []int{2, 3, 1}, evicted: []int{}},
+		{action: func() { lru.Peek(2) }, expected: []int{2, 3, 1}, evicted: []int{}},
+		{action: func() { lru.Put(4, "d") }, expected: []int{3, 1, 4}, evicted: []int{2}},
+		{action: func() { lru.Put(3, "C") }, expected: []int{1, 4, 3}, evicted: []int{2}},
+		{action: func() { lru.Put(5, "e") }, expected: []int{4, 3, 5}, evicted: []int{2, 1}},
+		{action: func() { lru.Remove(3); lru.Put(6, "f") }, expected: []int{4, 5, 6}, evicted: []int{2, 1}},
+	}
+
+	for _, test := range lruTests {
+		test.action()
+		assert.Equal(t, test.expected, lru.Keys())
+		assert.Equal(t, test.evicted, evicted)
+	}
+	assert.Equal(t, "{4=d, 5=e, 6=f}", lru.String())
+	assert.Equal(t, Stats{Hits: 1, Misses: 0, Evictions: 2}, lru.Stats())
+	assert.True(t, lru.Equals(hashmap.New(pair.Of(6, "f"), pair.Of(5, "e"), pair.Of(4, "d")), func(a, b string) bool { return a == b }))
+	assert.Equal(t, []int{4, 5, 6}, lru.Keys())
+}
@@ -0,0 +1,210 @@
+package caches
+
+import (
+	"iter"
+	"time"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/maps/linkedhashmap"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// ttlEntry a value cached by a [TTL] along with the time at which it expires.
+type ttlEntry[V any] struct {
+	value  V
+	expiry time.Time
+}
+
+// TTL a cache in which entries expire a fixed duration after they were last written. Expired entries are evicted lazily, on the next
+// operation on the cache. When the cache is full the entry that was written the longest time ago is evicted.
+type TTL[K comparable, V any] struct {
+	base[K, V]
+	linkedHashMap *linkedhashmap.LinkedHashMap[K, ttlEntry[V]] // Every write moves an entry to the back, so entries are ordered by expiry.
+	ttl           time.Duration
+	clock         Clock
+}
+
+// NewTTL creates a cache that holds at most capacity entries, each expiring ttl after it was written. The clock is used to tell the
+// current time, a nil clock uses [time.Now]. NewTTL panics if the capacity or the ttl is not positive.
+func NewTTL[K comparable, V any](capacity int, ttl time.Duration, clock Clock) *TTL[K, V] {
+	if ttl <= 0 {
+		panic(errors.IllegalArgument("ttl", ttl))
+	} else if clock == nil {
+		clock = time.Now
+	}
+	cache := TTL[K, V]{base: newBase[K, V](capacity), linkedHashMap: linkedhashmap.New[K, ttlEntry[V]](), ttl: ttl, clock: clock}
+	cache.linkedHashMap.SetRemoveEldest(func(eldest pair.Pair[K, ttlEntry[V]], len int) bool {
+		if len > cache.capacity {
+			cache.evicted(eldest.Key(), eldest.Value().value)
+			return true
+		}
+		return false
+	})
+	return &cache
+}
+
+// entryValue returns the value of the entry as an option.
+func entryValue[V any](entry optional.Optional[ttlEntry[V]]) optional.Optional[V] {
+	if entry.Empty() {
+		return optional.Empty[V]()
+	}
+	return optional.Of(entry.Value().value)
+}
+
+// Expire evicts all the entries that have expired. Expired entries are also evicted by every other operation on the cache.
+func (cache *TTL[K, V]) Expire() {
+	now := cache.clock()
+	for !cache.linkedHashMap.Empty() {
+		eldest := first(cache.linkedHashMap)
+		if now.Before(eldest.Value().expiry) {
+			return
+		}
+		cache.linkedHashMap.Remove(eldest.Key())
+		cache.evicted(eldest.Key(), eldest.Value().value)
+	}
+}
+
+// TTL returns the duration after which an entry expires.
+func (cache *TTL[K, V]) TTL() time.Duration {
+	return cache.ttl
+}
+
+// Put adds a new key/value pair to the cache and optionally returns previously bound value. The entry expires ttl after the call.
+func (cache *TTL[K, V]) Put(key K, value V) optional.Optional[V] {
+	cache.Expire()
+	storedValue := cache.linkedHashMap.Remove(key)
+	cache.linkedHashMap.Put(key, ttlEntry[V]{value: value, expiry: cache.clock().Add(cache.ttl)})
+	if storedValue.Empty() {
+		return optional.Empty[V]()
+	}
+	return optional.Of(storedValue.Value().value)
+}
+
+// PutIfAbsent adds a new key/value pair to the cache if the key is not already bounded and optionally returns bound value. The expiry
+// of a bound value is left unchanged.
+func (cache *TTL[K, V]) PutIfAbsent(key K, value V) optional.Optional[V] {
+	if storedValue := cache.Peek(key); !storedValue.Empty() {
+		return storedValue
+	}
+	return cache.Put(key, value)
+}
+
+// Get optionally returns the value associated with a key, expired entries are not returned.
+func (cache *TTL[K, V]) Get(key K) optional.Optional[V] {
+	return cache.lookup(cache.Peek(key))
+}
+
+// Peek optionally returns the value associated with a key without recording a lookup.
+func (cache *TTL[K, V]) Peek(key K) optional.Optional[V] {
+	cache.Expire()
+	return entryValue(cache.linkedHashMap.Peek(key))
+}
+
+// GetIf returns the values mapped by keys that match the given predicate.
+func (cache *TTL[K, V]) GetIf(f func(K) bool) []V {
+	return getIf(cache.All(), f)
+}
+
+// Remove removes a key from the cache, returning the value associated previously with that key as an option.
+func (cache *TTL[K, V]) Remove(key K) optional.Optional[V] {
+	cache.Expire()
+	return entryValue(cache.linkedHashMap.Remove(key))
+}
+
+// RemoveIf removes all the key, value mapping in which the key matches the given predicate.
+func (cache *TTL[K, V]) RemoveIf(f func(K) bool) bool {
+	cache.Expire()
+	return cache.linkedHashMap.RemoveIf(f)
+}
+
+// ContainsKey returns true if this cache contains a mapping for the specified key.
+func (cache *TTL[K, V]) ContainsKey(key K) bool {
+	cache.Expire()
+	return cache.linkedHashMap.ContainsKey(key)
+}
+
+// ContainsValue returns true if this cache maps one or more keys to the specified value.
+func (cache *TTL[K, V]) ContainsValue(value V, equals func(v1, v2 V) bool) bool {
+	return containsValue(cache.All(), value, equals)
+}
+
+// Clear removes all of the mappings from this cache.
+func (cache *TTL[K, V]) Clear() {
+	cache.linkedHashMap.Clear()
+}
+
+// Keys returns a slice containing the keys in the cache from the first to the last to expire.
+func (cache *TTL[K, V]) Keys() []K {
+	cache.Expire()
+	return cache.linkedHashMap.Keys()
+}
+
+// Values returns a slice containing the values in the cache from the first to the last to expire.
+func (cache *TTL[K, V]) Values() []V {
+	values := make([]V, 0)
+	for value := range cache.AllValues() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Len returns the number of entries in the cache.
+func (cache *TTL[K, V]) Len() int {
+	cache.Expire()
+	return cache.linkedHashMap.Len()
+}
+
+// Empty returns true if the cache has no entries.
+func (cache *TTL[K, V]) Empty() bool {
+	return cache.Len() == 0
+}
+
+// ForEach performs the given action for each key, value mapping in the cache.
+func (cache *TTL[K, V]) ForEach(f func(K, V)) {
+	for key, value := range cache.All() {
+		f(key, value)
+	}
+}
+
+// Iterator returns an iterator over the cache from the first to the last entry to expire.
+func (cache *TTL[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
+	cache.Expire()
+	return iterator.Map(cache.linkedHashMap.Iterator(), func(entry pair.Pair[K, ttlEntry[V]]) pair.Pair[K, V] {
+		return pair.Of(entry.Key(), entry.Value().value)
+	})
+}
+
+// All returns a sequence over the key, value pairs in the cache from the first to the last entry to expire.
+func (cache *TTL[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		cache.Expire()
+		for key, entry := range cache.linkedHashMap.All() {
+			if !yield(key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// AllKeys returns a sequence over the keys in the cache from the first to the last to expire.
+func (cache *TTL[K, V]) AllKeys() iter.Seq[K] {
+	return keysOf(cache.All())
+}
+
+// AllValues returns a sequence over the values in the cache from the first to the last to expire.
+func (cache *TTL[K, V]) AllValues() iter.Seq[V] {
+	return valuesOf(cache.All())
+}
+
+// Equals return true if the cache is equal to the given map.
+func (cache *TTL[K, V]) Equals(other collections.Map[K, V], equals func(V, V) bool) bool {
+	return mapEquals(cache.Len(), cache.Peek, other, equals)
+}
+
+// String returns the string representation of the cache.
+func (cache *TTL[K, V]) String() string {
+	return toString(cache.All())
+}
@@ -0,0 +1,52 @@
+package caches
+
+import (
+	"testing"
+	"time"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTTL(t *testing.T) {
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	cache := NewTTL[int, string](3, time.Minute, clock)
+	evicted := make([]int, 0)
+	cache.OnEviction(func(key int, value string) { evicted = append(evicted, key) })
+	assert.Equal(t, time.Minute, cache.TTL())
+
+	type ttlTest struct {
+		action   func()
+		expected []int
+		evicted  []int
+	}
+
+	ttlTests := []ttlTest{
+		{action: func() { cache.Put(1, "a") }, expected: []int{1}, evicted: []int{}},
+		{action: func() { now = now.Add(20 * time.Second); cache.Put(2, "b") }, expected: []int{1, 2}, evicted: []int{}},
+		{action: func() { now = now.Add(20 * time.Second); cache.Put(3, "c") }, expected: []int{1, 2, 3}, evicted: []int{}},
+		{action: func() { now = now.Add(10 * time.Second); cache.Put(1, "A") }, expected: []int{2, 3, 1}, evicted: []int{}},
+		{action: func() { cache.PutIfAbsent(2, "B") }, expected: []int{2, 3, 1}, evicted: []int{}},
+		{action: func() { cache.Put(4, "d") }, expected: []int{3, 1, 4}, evicted: []int{2}},
+		{action: func() { now = now.Add(50 * time.Second); cache.Get(3) }, expected: []int{1, 4}, evicted: []int{2, 3}},
+		{action: func() { cache.Remove(1); now = now.Add(time.Minute) }, expected: []int{}, evicted: []int{2, 3, 4}},
+	}
+
+	for _, test := range ttlTests {
+		test.action()
+		assert.Equal(t, test.expected, cache.Keys())
+		assert.Equal(t, test.evicted, evicted)
+	}
+	assert.Equal(t, Stats{Hits: 0, Misses: 1, Evictions: 3}, cache.Stats())
+
+	cache.Put(5, "e")
+	now = now.Add(time.Minute - time.Nanosecond)
+	assert.Equal(t, "e", cache.Get(5).Value())
+	now = now.Add(time.Nanosecond)
+	assert.True(t, cache.Get(5).Empty())
+	assert.True(t, cache.Empty())
+
+	assert.PanicsWithError(t, errors.IllegalArgument("ttl", time.Duration(0)).Error(), func() { NewTTL[int, string](1, 0, clock) })
+}
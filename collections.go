@@ -5,6 +5,9 @@
 //     1.1 HashMap[K, V] : This is a wrapper around a standard map[K]V i.e has map[K]V as its base type and can be ranged over.
 //     1.2 LinkedHashMap[K, V] : This is similar to a HashMap[K, V] however elements are iterated over following their insertion order.
 //     1.2 TreeMap[K, V] : A sorted map that stored elements in a sorted order, this backed by a Red Black Tree.
+//     1.4 PersistentHashMap[K, V] / PersistentTreeMap[K, V] : Immutable hash and sorted maps whose versions share structure, backed by a hash array mapped trie and a red black tree.
+//     1.5 HashBiMap[K, V] / LinkedBiMap[K, V] : Maps in the bimap package that also keep their values unique and have a live inverse view mapping values to keys.
+//     - SortedMap[K, V] / NavigableMap[K, V] : Maps that keep their keys sorted and support nearest key lookups, satisfied by TreeMap[K, V].
 //
 // 2.Collection[T comparable] : This is an interface satisfied by
 //
@@ -14,11 +17,15 @@
 //			   c. ForwardList[T] : A singly linked list with a tail pointer.
 //			   d. ImmutableForwardList[T] : An immutable version of a [ForwardList[T]].
 //			   e. LinkedList[T] : A doubly linkedList.
+//			   f. PersistentVector[T] : An immutable vector whose versions share structure, backed by a 32 way trie.
 //
 //		    2.2 Queue[T] : Linear data structure that is used in for First in First Out Operations (FIFO).
 //				 - Dequeue[T] : A double ended queue to support additions and removals on both ends.
 //	          a. ListDequeue[T] : A linked list based implementation of a dequeue.
 //			  b. VectorDequeue[T] : A slice based implementastion of a dequeue.
+//			  c. PriorityQueue[T] : A d-ary heap based queue in which elements are removed in priority order.
+//			  d. IndexedPriorityQueue[K, P] : A heap of distinct keys with priorities that supports updating and removing keys in O(log n).
+//			  e. ArrayBlockingDequeue[T] / LinkedBlockingDequeue[T] : Bounded dequeues that are safe for concurrent use and block while full or empty.
 //
 // Dequeue[T] : This is a double ended queue and can either be backed by a Vector[T] or a LinkedList[T].
 //
@@ -26,9 +33,29 @@
 //		   a. HashSet[T] : A set implementation backed by a [HashMap] with no particular ordering for element iteration.
 //		   b. LinkedHashSet[T] : A set implementation backed by a [LinkedHashMap] in which elements are iterated on following their insertion order.
 //		   c.TreeSet[T] : A set implementation backed by a [TreeMap] in which elements are iterated on following particular ordering.
+//		   d. PersistentHashSet[T] : An immutable set whose versions share structure, backed by a PersistentHashMap.
+//
+//	 2.4 Stack[T] : Linear data structure that is used for Last In First Out operations (LIFO).
+//		   a. VectorStack[T] : A slice based implementation of a stack.
+//		   b. ListStack[T] : A singly linked list based implementation of a stack.
+//		   c. PersistentStack[T] : An immutable stack whose versions share structure.
+//		   d. DequeueStack[T] : A view of any Dequeue[T] as a stack whose top is the front of the dequeue.
+//
+// 3. SynchronizedList[T] / SynchronizedSet[T] / SynchronizedDequeue[T] / SynchronizedMap[K, V] : Decorators in the concurrent package that make any of the above safe for concurrent use.
+//   - ConcurrentHashMap[K, V] : A map that is safe for concurrent use and shards its keys across independently locked segments.
+//   - LockFreeQueue[T] / LockFreeStack[T] : A queue and a stack that are safe for concurrent use without locks, built on compare and swap.
+//
+// 4. ListMultimap[K, V] / SetMultimap[K, V] : Maps from keys to lists or sets of values in the multimap package, with hash, linked and tree backed implementations.
+//
+// 5. Multiset[T] : Collections in the multiset package that count the occurrences of each element, with hash, linked and tree backed implementations.
+//
+// 6. Table[R, C, V] : Maps in the table package from a row key and a column key to a value, with live row and column map views and hash, linked and tree backed implementations.
+//
+// 7. RangeSet[T] / RangeMap[T, V] : Collections in the ranges package of coalesced intervals and of disjoint intervals mapped to values, ordered by a lessThan function.
 package collections
 
 import (
+	"iter"
 	"reflect"
 
 	"github.com/phantom820/collections/iterable"
@@ -54,6 +81,35 @@ type Map[K comparable, V any] interface {
 	Values() []V                                     // Returns a slice containing all the values in the map.
 	Empty() bool                                     // Returns true if the map has no elements.
 	Equals(m Map[K, V], equals func(V, V) bool) bool // Returns true if the 2 maps are equal. Two maps are equal if thay have the same size and have the same key, value mappings.
+	All() iter.Seq2[K, V]                            // Returns a sequence over the key, value pairs in the map.
+	AllKeys() iter.Seq[K]                            // Returns a sequence over the keys in the map.
+	AllValues() iter.Seq[V]                          // Returns a sequence over the values in the map.
+}
+
+// SortedMap a [Map] that keeps its entries sorted by key.
+type SortedMap[K comparable, V any] interface {
+	Map[K, V]
+	FirstEntry() optional.Optional[pair.Pair[K, V]] // Returns the entry with the smallest key as an option.
+	LastEntry() optional.Optional[pair.Pair[K, V]]  // Returns the entry with the largest key as an option.
+}
+
+// NavigableMap a [SortedMap] that supports finding the closest matches for a given key.
+type NavigableMap[K comparable, V any] interface {
+	SortedMap[K, V]
+	FloorKey(k K) optional.Optional[K]                                                  // Returns the greatest key less than or equal to the given key as an option.
+	FloorEntry(k K) optional.Optional[pair.Pair[K, V]]                                  // Returns the entry with the greatest key less than or equal to the given key as an option.
+	CeilingKey(k K) optional.Optional[K]                                                // Returns the least key greater than or equal to the given key as an option.
+	CeilingEntry(k K) optional.Optional[pair.Pair[K, V]]                                // Returns the entry with the least key greater than or equal to the given key as an option.
+	LowerKey(k K) optional.Optional[K]                                                  // Returns the greatest key strictly less than the given key as an option.
+	LowerEntry(k K) optional.Optional[pair.Pair[K, V]]                                  // Returns the entry with the greatest key strictly less than the given key as an option.
+	HigherKey(k K) optional.Optional[K]                                                 // Returns the least key strictly greater than the given key as an option.
+	HigherEntry(k K) optional.Optional[pair.Pair[K, V]]                                 // Returns the entry with the least key strictly greater than the given key as an option.
+	PollFirstEntry() optional.Optional[pair.Pair[K, V]]                                 // Removes and returns the entry with the smallest key as an option.
+	PollLastEntry() optional.Optional[pair.Pair[K, V]]                                  // Removes and returns the entry with the largest key as an option.
+	DescendingMap() NavigableMap[K, V]                                                  // Returns a reverse order view of the map, changes to the view are reflected in the map and vice versa.
+	SubMap(fromKey K, fromInclusive bool, toKey K, toInclusive bool) NavigableMap[K, V] // Returns a view of the portion of the map with keys ranging from fromKey to toKey.
+	HeadMap(toKey K, inclusive bool) NavigableMap[K, V]                                 // Returns a view of the portion of the map with keys less than (or equal to, if inclusive is true) toKey.
+	TailMap(fromKey K, inclusive bool) NavigableMap[K, V]                               // Returns a view of the portion of the map with keys greater than (or equal to, if inclusive is true) fromKey.
 }
 
 // Collection a container for a grouping of elements.
@@ -73,6 +129,7 @@ type Collection[T comparable] interface {
 	ForEach(func(T))                              // Performs the given action for each element of the collection.
 	Len() int                                     // Returns the number of elements in the collection.
 	ToSlice() []T                                 // Returns a slice containing all of the elements of the collection.
+	All() iter.Seq[T]                             // Returns a sequence over the elements of the collection.
 }
 
 // List a linear ordered data structure that supports index based operations.
@@ -103,6 +160,15 @@ type Dequeue[T comparable] interface {
 	RemoveLast() optional.Optional[T]  // Returns and removes the back element of the dequeue as an option.
 }
 
+// Stack a linear data structure that is used for Last In First Out operations (LIFO). Iteration goes from the top of the stack to the
+// bottom, Add pushes and Remove removes the occurence closest to the top.
+type Stack[T comparable] interface {
+	Collection[T]
+	Push(e T) optional.Optional[T] // Adds an element to the top of the stack and returns the previous top element as an option.
+	Peek() optional.Optional[T]    // Returns the top element of the stack as an option.
+	Pop() optional.Optional[T]     // Returns and removes the top element of the stack as an option.
+}
+
 // Set a non-linear data structure that stores unique elements and supports quick lookups, insertions and deletions.
 type Set[T comparable] interface {
 	Collection[T]
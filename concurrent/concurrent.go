@@ -0,0 +1,170 @@
+// package concurrent defines decorators that make the collections and maps of this module safe for use by multiple goroutines. Each
+// decorator guards the wrapped container with a [sync.RWMutex], reads share the lock and modifications hold it exclusively.
+//
+//  1. SynchronizedList[T] : Wraps any [collections.List].
+//  2. SynchronizedSet[T] : Wraps any [collections.Set].
+//  3. SynchronizedDequeue[T] : Wraps any [collections.Dequeue].
+//  4. SynchronizedMap[K, V] : Wraps any [collections.Map] and adds atomic compound operations such as Compute.
+//
+// The package also defines containers designed for concurrent use, which scale better under contention than the decorators.
+//
+//  1. ConcurrentHashMap[K, V] : A map whose keys are spread across independently locked segments.
+//  2. LockFreeQueue[T] : A lock free Michael and Scott queue.
+//  3. LockFreeStack[T] : A lock free Treiber stack.
+//
+// Iterators, sequences and ForEach of the decorators work on a snapshot taken when they are created, they never fail because of
+// concurrent modification and do not reflect it. The wrapped container must not be used directly once wrapped. Maps that modify themselves
+// on reads, an access ordered LinkedHashMap or a cache, are read while holding the lock exclusively.
+package concurrent
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+)
+
+// synchronizedCollection the implementation of [collections.Collection] shared by the synchronized collections.
+type synchronizedCollection[T comparable, C collections.Collection[T]] struct {
+	mutex      sync.RWMutex
+	collection C
+}
+
+// Update performs the given action on the wrapped collection while holding the lock exclusively, which makes a sequence of operations
+// atomic. The action must not use the synchronized collection.
+func (synchronized *synchronizedCollection[T, C]) Update(f func(C)) {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	f(synchronized.collection)
+}
+
+// Add adds the given element to the collection and returns true if the element was added.
+func (synchronized *synchronizedCollection[T, C]) Add(e T) bool {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.collection.Add(e)
+}
+
+// AddAll adds all of the elements in the specified iterable to the collection. The iterable is read before the lock is taken, so it
+// may be the collection itself.
+func (synchronized *synchronizedCollection[T, C]) AddAll(iterable iterable.Iterable[T]) bool {
+	return synchronized.AddSlice(iterator.ToSlice(iterable.Iterator()))
+}
+
+// AddSlice adds all of the elements in the specified slice to the collection.
+func (synchronized *synchronizedCollection[T, C]) AddSlice(s []T) bool {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.collection.AddSlice(s)
+}
+
+// Clear removes all of the elements from the collection.
+func (synchronized *synchronizedCollection[T, C]) Clear() {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	synchronized.collection.Clear()
+}
+
+// Contains returns true if the collection contains the specified element.
+func (synchronized *synchronizedCollection[T, C]) Contains(e T) bool {
+	synchronized.mutex.RLock()
+	defer synchronized.mutex.RUnlock()
+	return synchronized.collection.Contains(e)
+}
+
+// Empty returns true if the collection contains no elements.
+func (synchronized *synchronizedCollection[T, C]) Empty() bool {
+	synchronized.mutex.RLock()
+	defer synchronized.mutex.RUnlock()
+	return synchronized.collection.Empty()
+}
+
+// Remove removes the first occurence of the given element and returns true if the collection changed as a result.
+func (synchronized *synchronizedCollection[T, C]) Remove(e T) bool {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.collection.Remove(e)
+}
+
+// RemoveIf removes all of the elements of the collection that satisfy the given predicate. The predicate is called while holding the
+// lock and must not use the synchronized collection.
+func (synchronized *synchronizedCollection[T, C]) RemoveIf(f func(T) bool) bool {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.collection.RemoveIf(f)
+}
+
+// RemoveAll removes all of the collection's elements that are also contained in the specified iterable. The iterable is read before the
+// lock is taken, so it may be the collection itself.
+func (synchronized *synchronizedCollection[T, C]) RemoveAll(iterable iterable.Iterable[T]) bool {
+	return synchronized.RemoveSlice(iterator.ToSlice(iterable.Iterator()))
+}
+
+// RemoveSlice removes all of the collection's elements that are also contained in the specified slice.
+func (synchronized *synchronizedCollection[T, C]) RemoveSlice(s []T) bool {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.collection.RemoveSlice(s)
+}
+
+// RetainAll retains only the elements in the collection that are contained in the specified collection. The specified collection is read
+// before the lock is taken, so it may be the collection itself.
+func (synchronized *synchronizedCollection[T, C]) RetainAll(c collections.Collection[T]) bool {
+	retained := make(map[T]struct{})
+	for _, e := range iterator.ToSlice(c.Iterator()) {
+		retained[e] = struct{}{}
+	}
+	return synchronized.RemoveIf(func(e T) bool {
+		_, ok := retained[e]
+		return !ok
+	})
+}
+
+// ForEach performs the given action for each element of a snapshot of the collection, the lock is not held while the action runs.
+func (synchronized *synchronizedCollection[T, C]) ForEach(f func(T)) {
+	for _, e := range synchronized.ToSlice() {
+		f(e)
+	}
+}
+
+// Len returns the number of elements in the collection.
+func (synchronized *synchronizedCollection[T, C]) Len() int {
+	synchronized.mutex.RLock()
+	defer synchronized.mutex.RUnlock()
+	return synchronized.collection.Len()
+}
+
+// ToSlice returns a slice containing all of the elements of the collection.
+func (synchronized *synchronizedCollection[T, C]) ToSlice() []T {
+	synchronized.mutex.RLock()
+	defer synchronized.mutex.RUnlock()
+	// Some collections return their backing slice, which must not escape the lock.
+	return slices.Clone(synchronized.collection.ToSlice())
+}
+
+// Iterator returns an iterator over a snapshot of the elements of the collection.
+func (synchronized *synchronizedCollection[T, C]) Iterator() iterator.Iterator[T] {
+	return iterator.Of(synchronized.ToSlice()...)
+}
+
+// All returns a sequence over a snapshot of the elements of the collection, taken when ranging starts.
+func (synchronized *synchronizedCollection[T, C]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, e := range synchronized.ToSlice() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the string representation of the collection.
+func (synchronized *synchronizedCollection[T, C]) String() string {
+	synchronized.mutex.RLock()
+	defer synchronized.mutex.RUnlock()
+	return fmt.Sprint(synchronized.collection)
+}
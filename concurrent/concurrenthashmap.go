@@ -0,0 +1,383 @@
+package concurrent
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/hashing"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+)
+
+const (
+	DefaultSegments = 16 // The number of segments used by a ConcurrentHashMap when none is given.
+)
+
+// segment a part of a [ConcurrentHashMap] guarded by its own lock.
+type segment[K comparable, V any] struct {
+	mutex   sync.RWMutex
+	hashMap hashmap.HashMap[K, V]
+	_       [32]byte // Keeps neighbouring segments off the same cache line.
+}
+
+// snapshot returns the entries of the segment.
+func (segment *segment[K, V]) snapshot() []pair.Pair[K, V] {
+	segment.mutex.RLock()
+	defer segment.mutex.RUnlock()
+	entries := make([]pair.Pair[K, V], 0, len(segment.hashMap))
+	for key, value := range segment.hashMap {
+		entries = append(entries, pair.Of(key, value))
+	}
+	return entries
+}
+
+// ConcurrentHashMap a [collections.Map] that is safe for concurrent use. Keys are spread across a fixed number of segments by a hash
+// function and each segment has its own lock, so operations on keys in different segments do not contend. Operations that span the whole
+// map (Len aside) visit the segments one at a time and are weakly consistent, they reflect each segment as it was when visited.
+type ConcurrentHashMap[K comparable, V any] struct {
+	segments []segment[K, V]
+	hash     func(K) uint64
+	len      atomic.Int64
+}
+
+// NewConcurrentHashMap creates a map with the given number of segments that uses the given hash function to pick the segment of a key.
+// A nil hash uses [hashing.Hash]. Will panic if the number of segments is less than 1.
+func NewConcurrentHashMap[K comparable, V any](segments int, hash func(K) uint64) *ConcurrentHashMap[K, V] {
+	if segments < 1 {
+		panic(errors.IllegalArgument("segments", segments))
+	} else if hash == nil {
+		hash = hashing.Hash[K]
+	}
+	concurrentHashMap := ConcurrentHashMap[K, V]{segments: make([]segment[K, V], segments), hash: hash}
+	for i := range concurrentHashMap.segments {
+		concurrentHashMap.segments[i].hashMap = hashmap.New[K, V]()
+	}
+	return &concurrentHashMap
+}
+
+// segment returns the segment that the key belongs to.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) segment(key K) *segment[K, V] {
+	return &concurrentHashMap.segments[concurrentHashMap.hash(key)%uint64(len(concurrentHashMap.segments))]
+}
+
+// update performs the given action on the segment of the key while holding its lock exclusively and keeps the length up to date.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) update(key K, f func(hashmap.HashMap[K, V])) {
+	segment := concurrentHashMap.segment(key)
+	segment.mutex.Lock()
+	defer segment.mutex.Unlock()
+	n := len(segment.hashMap)
+	f(segment.hashMap)
+	concurrentHashMap.len.Add(int64(len(segment.hashMap) - n))
+}
+
+// Put adds a new key/value pair to the map and optionally returns previously bound value.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) Put(key K, value V) optional.Optional[V] {
+	var previous optional.Optional[V]
+	concurrentHashMap.update(key, func(hashMap hashmap.HashMap[K, V]) { previous = hashMap.Put(key, value) })
+	return previous
+}
+
+// PutIfAbsent atomically adds a new key/value pair to the map if the key is not already bounded and optionally returns bound value.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) PutIfAbsent(key K, value V) optional.Optional[V] {
+	var current optional.Optional[V]
+	concurrentHashMap.update(key, func(hashMap hashmap.HashMap[K, V]) { current = hashMap.PutIfAbsent(key, value) })
+	return current
+}
+
+// Compute atomically computes a new mapping for the key from the key and its current value as an option. The key is removed if the
+// result is empty. Compute returns the new value as an option. The function is called while holding the lock of the key's segment and
+// must not use the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) Compute(key K, f func(K, optional.Optional[V]) optional.Optional[V]) optional.Optional[V] {
+	var value optional.Optional[V]
+	concurrentHashMap.update(key, func(hashMap hashmap.HashMap[K, V]) {
+		value = f(key, hashMap.Get(key))
+		if value.Empty() {
+			delete(hashMap, key)
+		} else {
+			hashMap[key] = value.Value()
+		}
+	})
+	return value
+}
+
+// ComputeIfAbsent atomically maps the key to the result of f if the key is not mapped and returns the value the key is mapped to. The
+// function is called while holding the lock of the key's segment and must not use the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) ComputeIfAbsent(key K, f func(K) V) V {
+	segment := concurrentHashMap.segment(key)
+	segment.mutex.RLock()
+	value, ok := segment.hashMap[key]
+	segment.mutex.RUnlock()
+	if ok {
+		return value
+	}
+	concurrentHashMap.update(key, func(hashMap hashmap.HashMap[K, V]) {
+		if value, ok = hashMap[key]; !ok {
+			value = f(key)
+			hashMap[key] = value
+		}
+	})
+	return value
+}
+
+// ComputeIfPresent atomically computes a new mapping for the key from the key and its current value if the key is mapped. The key is
+// removed if the result is empty. ComputeIfPresent returns the new value as an option. The function is called while holding the lock
+// of the key's segment and must not use the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) ComputeIfPresent(key K, f func(K, V) optional.Optional[V]) optional.Optional[V] {
+	value := optional.Empty[V]()
+	concurrentHashMap.update(key, func(hashMap hashmap.HashMap[K, V]) {
+		if current, ok := hashMap[key]; ok {
+			if value = f(key, current); value.Empty() {
+				delete(hashMap, key)
+			} else {
+				hashMap[key] = value.Value()
+			}
+		}
+	})
+	return value
+}
+
+// Merge atomically maps the key to the given value if the key is not mapped, otherwise it maps the key to the result of applying f to
+// the current value and the given value, removing the key if the result is empty. Merge returns the new value as an option. The function
+// is called while holding the lock of the key's segment and must not use the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) Merge(key K, value V, f func(V, V) optional.Optional[V]) optional.Optional[V] {
+	var merged optional.Optional[V]
+	concurrentHashMap.update(key, func(hashMap hashmap.HashMap[K, V]) {
+		current, ok := hashMap[key]
+		if !ok {
+			merged = optional.Of(value)
+		} else {
+			merged = f(current, value)
+		}
+		if merged.Empty() {
+			delete(hashMap, key)
+		} else {
+			hashMap[key] = merged.Value()
+		}
+	})
+	return merged
+}
+
+// Get optionally returns the value associated with a key.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) Get(key K) optional.Optional[V] {
+	segment := concurrentHashMap.segment(key)
+	segment.mutex.RLock()
+	defer segment.mutex.RUnlock()
+	return segment.hashMap.Get(key)
+}
+
+// GetIf returns the values mapped by keys that match the given predicate. The predicate is called while holding the lock of a segment
+// and must not use the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) GetIf(f func(K) bool) []V {
+	values := make([]V, 0)
+	for i := range concurrentHashMap.segments {
+		segment := &concurrentHashMap.segments[i]
+		segment.mutex.RLock()
+		values = append(values, segment.hashMap.GetIf(f)...)
+		segment.mutex.RUnlock()
+	}
+	return values
+}
+
+// Remove removes a key from the map, returning the value associated previously with that key as an option.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) Remove(key K) optional.Optional[V] {
+	var previous optional.Optional[V]
+	concurrentHashMap.update(key, func(hashMap hashmap.HashMap[K, V]) { previous = hashMap.Remove(key) })
+	return previous
+}
+
+// RemoveIf removes all the key, value mapping in which the key matches the given predicate. The predicate is called while holding the
+// lock of a segment and must not use the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) RemoveIf(f func(K) bool) bool {
+	removed := false
+	for i := range concurrentHashMap.segments {
+		segment := &concurrentHashMap.segments[i]
+		segment.mutex.Lock()
+		n := len(segment.hashMap)
+		if segment.hashMap.RemoveIf(f) {
+			removed = true
+			concurrentHashMap.len.Add(int64(len(segment.hashMap) - n))
+		}
+		segment.mutex.Unlock()
+	}
+	return removed
+}
+
+// ContainsKey returns true if the map contains a mapping for the specified key.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) ContainsKey(key K) bool {
+	segment := concurrentHashMap.segment(key)
+	segment.mutex.RLock()
+	defer segment.mutex.RUnlock()
+	return segment.hashMap.ContainsKey(key)
+}
+
+// ContainsValue returns true if the map maps one or more keys to the specified value.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) ContainsValue(value V, equals func(v1, v2 V) bool) bool {
+	for i := range concurrentHashMap.segments {
+		segment := &concurrentHashMap.segments[i]
+		segment.mutex.RLock()
+		ok := segment.hashMap.ContainsValue(value, equals)
+		segment.mutex.RUnlock()
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Clear removes all of the mappings from the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) Clear() {
+	for i := range concurrentHashMap.segments {
+		segment := &concurrentHashMap.segments[i]
+		segment.mutex.Lock()
+		concurrentHashMap.len.Add(-int64(len(segment.hashMap)))
+		segment.hashMap.Clear()
+		segment.mutex.Unlock()
+	}
+}
+
+// Keys returns a slice containing the keys in the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) Keys() []K {
+	keys := make([]K, 0, concurrentHashMap.Len())
+	for key := range concurrentHashMap.AllKeys() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns a slice containing the values in the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) Values() []V {
+	values := make([]V, 0, concurrentHashMap.Len())
+	for value := range concurrentHashMap.AllValues() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Len returns the number of entries in the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) Len() int {
+	return int(concurrentHashMap.len.Load())
+}
+
+// Empty returns true if the map has no entries.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) Empty() bool {
+	return concurrentHashMap.Len() == 0
+}
+
+// ForEach performs the given action for each entry of the map. Each segment is snapshotted when it is reached and the lock is not held
+// while the action runs, so the action may use the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) ForEach(f func(K, V)) {
+	for key, value := range concurrentHashMap.All() {
+		f(key, value)
+	}
+}
+
+// Iterator returns a weakly consistent iterator over the map. Each segment is snapshotted when the iterator reaches it, the iterator
+// never fails because of concurrent modification.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
+	return &concurrentHashMapIterator[K, V]{concurrentHashMap: concurrentHashMap}
+}
+
+// All returns a weakly consistent sequence over the key, value pairs in the map. Each segment is snapshotted when it is reached.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i := range concurrentHashMap.segments {
+			for _, entry := range concurrentHashMap.segments[i].snapshot() {
+				if !yield(entry.Key(), entry.Value()) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// AllKeys returns a weakly consistent sequence over the keys in the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) AllKeys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range concurrentHashMap.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// AllValues returns a weakly consistent sequence over the values in the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) AllValues() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range concurrentHashMap.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Equals return true if the map is equal to the given map. Two maps are equal if they contain the same key, value pairs. The result is
+// only reliable if neither map is modified during the comparison.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) Equals(other collections.Map[K, V], equals func(V, V) bool) bool {
+	if other == collections.Map[K, V](concurrentHashMap) {
+		return true
+	}
+	entries := iterator.ToSlice(other.Iterator())
+	if len(entries) != concurrentHashMap.Len() {
+		return false
+	}
+	for _, entry := range entries {
+		value := concurrentHashMap.Get(entry.Key())
+		if value.Empty() || !equals(entry.Value(), value.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the string representation of the map.
+func (concurrentHashMap *ConcurrentHashMap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for key, value := range concurrentHashMap.All() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", key, value))
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// concurrentHashMapIterator a weakly consistent iterator for [ConcurrentHashMap].
+type concurrentHashMapIterator[K comparable, V any] struct {
+	concurrentHashMap *ConcurrentHashMap[K, V]
+	segment           int // The index of the next segment to snapshot.
+	entries           []pair.Pair[K, V]
+	index             int
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *concurrentHashMapIterator[K, V]) HasNext() bool {
+	for it.index >= len(it.entries) && it.segment < len(it.concurrentHashMap.segments) {
+		it.entries = it.concurrentHashMap.segments[it.segment].snapshot()
+		it.index = 0
+		it.segment++
+	}
+	return it.index < len(it.entries)
+}
+
+// Next returns the next element in the iterator.
+func (it *concurrentHashMapIterator[K, V]) Next() pair.Pair[K, V] {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	}
+	entry := it.entries[it.index]
+	it.index++
+	return entry
+}
@@ -0,0 +1,183 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConcurrentHashMap(t *testing.T) {
+
+	assert.PanicsWithError(t, errors.IllegalArgument("segments", 0).Error(), func() { NewConcurrentHashMap[int, int](0, nil) })
+
+	m := NewConcurrentHashMap[int, int](DefaultSegments, nil)
+	assert.Equal(t, DefaultSegments, len(m.segments))
+	assert.True(t, m.Empty())
+	assert.Equal(t, "{}", m.String())
+}
+
+func TestConcurrentHashMap(t *testing.T) {
+
+	m := NewConcurrentHashMap[int, string](4, func(k int) uint64 { return uint64(k) })
+	equals := func(a, b string) bool { return a == b }
+
+	assert.True(t, m.Put(1, "a").Empty())
+	assert.Equal(t, optional.Of("a"), m.PutIfAbsent(1, "A"))
+	assert.True(t, m.PutIfAbsent(2, "b").Empty())
+	assert.Equal(t, optional.Of("b"), m.Get(2))
+	assert.True(t, m.Get(3).Empty())
+	assert.True(t, m.ContainsKey(2))
+	assert.False(t, m.ContainsKey(3))
+	assert.True(t, m.ContainsValue("b", equals))
+	assert.False(t, m.ContainsValue("c", equals))
+	assert.Equal(t, []string{"a"}, m.GetIf(func(k int) bool { return k == 1 }))
+	assert.ElementsMatch(t, []int{1, 2}, m.Keys())
+	assert.ElementsMatch(t, []string{"a", "b"}, m.Values())
+	assert.Equal(t, 2, m.Len())
+	assert.Equal(t, "{1=a, 2=b}", m.String())
+
+	assert.Equal(t, optional.Of("c"), m.Compute(3, func(k int, v optional.Optional[string]) optional.Optional[string] {
+		assert.True(t, v.Empty())
+		return optional.Of("c")
+	}))
+	assert.Equal(t, 3, m.Len())
+	assert.True(t, m.Compute(3, func(k int, v optional.Optional[string]) optional.Optional[string] {
+		assert.Equal(t, optional.Of("c"), v)
+		return optional.Empty[string]()
+	}).Empty())
+	assert.Equal(t, 2, m.Len())
+
+	assert.Equal(t, "a", m.ComputeIfAbsent(1, func(k int) string { return "x" }))
+	assert.Equal(t, "d", m.ComputeIfAbsent(4, func(k int) string { return "d" }))
+	assert.True(t, m.ComputeIfPresent(5, func(k int, v string) optional.Optional[string] { return optional.Of("e") }).Empty())
+	assert.False(t, m.ContainsKey(5))
+	assert.Equal(t, optional.Of("dd"), m.ComputeIfPresent(4, func(k int, v string) optional.Optional[string] { return optional.Of(v + v) }))
+	assert.True(t, m.ComputeIfPresent(4, func(k int, v string) optional.Optional[string] { return optional.Empty[string]() }).Empty())
+	assert.Equal(t, 2, m.Len())
+
+	concat := func(a, b string) optional.Optional[string] { return optional.Of(a + b) }
+	assert.Equal(t, optional.Of("e"), m.Merge(5, "e", concat))
+	assert.Equal(t, optional.Of("ee"), m.Merge(5, "e", concat))
+	assert.True(t, m.Merge(5, "e", func(a, b string) optional.Optional[string] { return optional.Empty[string]() }).Empty())
+	assert.False(t, m.ContainsKey(5))
+	assert.Equal(t, 2, m.Len())
+
+	assert.True(t, m.Equals(m, equals))
+	assert.True(t, m.Equals(hashmap.New(pair.Of(1, "a"), pair.Of(2, "b")), equals))
+	assert.True(t, hashmap.New(pair.Of(1, "a"), pair.Of(2, "b")).Equals(m, equals))
+	assert.False(t, m.Equals(hashmap.New(pair.Of(1, "a")), equals))
+	assert.False(t, m.Equals(hashmap.New(pair.Of(1, "a"), pair.Of(2, "c")), equals))
+
+	entries := make(map[int]string)
+	m.ForEach(func(k int, v string) { entries[k] = v })
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, entries)
+	assert.ElementsMatch(t, []pair.Pair[int, string]{pair.Of(1, "a"), pair.Of(2, "b")}, iterator.ToSlice(m.Iterator()))
+	for k, v := range m.All() {
+		assert.Equal(t, entries[k], v)
+		break
+	}
+	for range m.AllKeys() {
+		break
+	}
+	for range m.AllValues() {
+		break
+	}
+
+	it := m.Iterator()
+	for it.HasNext() {
+		it.Next()
+	}
+	assert.PanicsWithError(t, errors.NoSuchElement().Error(), func() { it.Next() })
+
+	assert.Equal(t, optional.Of("a"), m.Remove(1))
+	assert.True(t, m.Remove(1).Empty())
+	assert.False(t, m.RemoveIf(func(k int) bool { return k == 1 }))
+	assert.True(t, m.RemoveIf(func(k int) bool { return k == 2 }))
+	assert.True(t, m.Empty())
+
+	m.Put(1, "a")
+	m.Put(6, "f")
+	m.Clear()
+	assert.True(t, m.Empty())
+	assert.Equal(t, 0, len(m.Keys()))
+}
+
+func TestConcurrentHashMapWeaklyConsistentIteration(t *testing.T) {
+
+	m := NewConcurrentHashMap[int, int](2, func(k int) uint64 { return uint64(k) })
+	m.Put(0, 0)
+	m.Put(1, 1)
+
+	// The even segment is snapshotted before the modifications, the odd segment after them.
+	it := m.Iterator()
+	assert.Equal(t, pair.Of(0, 0), it.Next())
+	m.Put(2, 2)
+	m.Put(3, 3)
+	m.Remove(1)
+	assert.Equal(t, []pair.Pair[int, int]{pair.Of(3, 3)}, iterator.ToSlice(it))
+
+	m.ForEach(func(k int, v int) { m.Remove(k) })
+	assert.True(t, m.Empty())
+}
+
+func TestConcurrentHashMapPointerKeys(t *testing.T) {
+
+	type node struct{ value int }
+
+	// Pointer keys are equal by identity, mutating what they point to must not move them to another segment.
+	m := NewConcurrentHashMap[*node, int](DefaultSegments, nil)
+	nodes := make([]*node, 64)
+	for i := range nodes {
+		nodes[i] = &node{value: i}
+		m.Put(nodes[i], i)
+	}
+	for i, node := range nodes {
+		node.value = -i - 1
+	}
+	for i, node := range nodes {
+		assert.Equal(t, optional.Of(i), m.Get(node))
+		assert.True(t, m.ContainsKey(node))
+	}
+	assert.False(t, m.ContainsKey(&node{value: -1}))
+	assert.Equal(t, optional.Of(0), m.Put(nodes[0], 0))
+	assert.Equal(t, len(nodes), m.Len())
+}
+
+func TestConcurrentHashMapConcurrency(t *testing.T) {
+
+	m := NewConcurrentHashMap[int, int](DefaultSegments, nil)
+	increment := func(a, b int) optional.Optional[int] { return optional.Of(a + b) }
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				m.Merge(i%100, 1, increment)
+				m.ComputeIfAbsent(1000+i%10, func(k int) int { return k })
+				m.Get(i % 100)
+				if i%100 == 0 {
+					for range m.All() {
+					}
+					m.Len()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 110, m.Len())
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, optional.Of(80), m.Get(i))
+	}
+	for i := 1000; i < 1010; i++ {
+		assert.Equal(t, optional.Of(i), m.Get(i))
+	}
+}
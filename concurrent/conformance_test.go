@@ -0,0 +1,557 @@
+package concurrent
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+// backing creates a synchronized container over one of the implementations of this module, the conformance tests below run the cases
+// of the suites of those implementations against every backing.
+type backing[E, C any] struct {
+	name string
+	new  func(elements ...E) C
+}
+
+func testList(t *testing.T, newList func(elements ...int) *SynchronizedList[int]) {
+
+	type addAtTest struct {
+		input    *SynchronizedList[int]
+		index    int
+		value    int
+		expected []int
+	}
+
+	addAtTests := []addAtTest{
+		{input: newList(), index: 0, value: 1, expected: []int{1}},
+		{input: newList(1, 2, 3), index: 1, value: -2, expected: []int{1, -2, 2, 3}},
+	}
+
+	for _, test := range addAtTests {
+		test.input.AddAt(test.index, test.value)
+		assert.Equal(t, test.expected, test.input.ToSlice())
+	}
+
+	type atTest struct {
+		input    *SynchronizedList[int]
+		index    int
+		expected int
+		panics   bool
+	}
+
+	atTests := []atTest{
+		{input: newList(1, 2, 3), index: 0, expected: 1},
+		{input: newList(1, 2, 3), index: 2, expected: 3},
+		{input: newList(1, 2, 3), index: 3, panics: true},
+		{input: newList(), index: -1, panics: true},
+	}
+
+	for _, test := range atTests {
+		if test.panics {
+			assert.Panics(t, func() { test.input.At(test.index) })
+			assert.Panics(t, func() { test.input.Set(test.index, 0) })
+			assert.Panics(t, func() { test.input.RemoveAt(test.index) })
+			assert.Panics(t, func() { test.input.AddAt(test.input.Len()+1, 0) })
+			// The lock is released when the wrapped list panics.
+			test.input.Add(0)
+			continue
+		}
+		assert.Equal(t, test.expected, test.input.At(test.index))
+		assert.Equal(t, test.expected, test.input.Set(test.index, -1))
+		assert.Equal(t, -1, test.input.At(test.index))
+		assert.Equal(t, -1, test.input.RemoveAt(test.index))
+		assert.Equal(t, 2, test.input.Len())
+	}
+
+	type removeTest struct {
+		input    *SynchronizedList[int]
+		remove   []int
+		expected []int
+		changed  bool
+	}
+
+	removeTests := []removeTest{
+		{input: newList(), remove: []int{1}, expected: []int{}, changed: false},
+		{input: newList(1, 2, 1), remove: []int{1}, expected: []int{2, 1}, changed: true},
+		{input: newList(1, 2, 3), remove: []int{4, 5}, expected: []int{1, 2, 3}, changed: false},
+	}
+
+	for _, test := range removeTests {
+		changed := false
+		for _, e := range test.remove {
+			changed = test.input.Remove(e) || changed
+		}
+		assert.Equal(t, test.changed, changed)
+		assert.ElementsMatch(t, test.expected, test.input.ToSlice())
+	}
+
+	type bulkTest struct {
+		input    *SynchronizedList[int]
+		f        func(list *SynchronizedList[int]) bool
+		expected []int
+		changed  bool
+	}
+
+	bulkTests := []bulkTest{
+		{input: newList(1, 2), f: func(list *SynchronizedList[int]) bool { return list.AddAll(list) }, expected: []int{1, 2, 1, 2}, changed: true},
+		{input: newList(1, 2, 3, 4), f: func(list *SynchronizedList[int]) bool { return list.RemoveIf(func(e int) bool { return e%2 == 0 }) },
+			expected: []int{1, 3}, changed: true},
+		{input: newList(1, 2, 3, 2), f: func(list *SynchronizedList[int]) bool { return list.RemoveSlice([]int{2, 5}) }, expected: []int{1, 3},
+			changed: true},
+		{input: newList(1, 2, 3), f: func(list *SynchronizedList[int]) bool { return list.RemoveAll(list) }, expected: []int{}, changed: true},
+		{input: newList(1, 2, 3), f: func(list *SynchronizedList[int]) bool { return list.RetainAll(vector.New(1, 3)) }, expected: []int{1, 3},
+			changed: true},
+		{input: newList(1, 2, 3), f: func(list *SynchronizedList[int]) bool { return list.RetainAll(list) }, expected: []int{1, 2, 3}},
+		{input: newList(1, 2), f: func(list *SynchronizedList[int]) bool { return list.AddIfAbsent(2) }, expected: []int{1, 2}},
+		{input: newList(1, 2), f: func(list *SynchronizedList[int]) bool { return list.AddIfAbsent(3) }, expected: []int{1, 2, 3}, changed: true},
+	}
+
+	for _, test := range bulkTests {
+		assert.Equal(t, test.changed, test.f(test.input))
+		assert.Equal(t, test.expected, append([]int{}, test.input.ToSlice()...))
+		assert.Equal(t, len(test.expected), test.input.Len())
+		assert.Equal(t, len(test.expected) == 0, test.input.Empty())
+	}
+
+	type equalsTest struct {
+		a, b     *SynchronizedList[int]
+		expected bool
+	}
+
+	equalsTests := []equalsTest{
+		{a: newList(), b: newList(), expected: true},
+		{a: newList(1, 2, 3), b: newList(1, 2, 3), expected: true},
+		{a: newList(1, 2, 3), b: newList(1, 3, 2), expected: false},
+		{a: newList(1, 2), b: newList(1, 2, 3), expected: false},
+	}
+
+	for _, test := range equalsTests {
+		assert.True(t, test.a.Equals(test.a))
+		assert.Equal(t, test.expected, test.a.Equals(test.b))
+		assert.Equal(t, test.expected, test.b.Equals(test.a))
+	}
+
+	type viewTest struct {
+		input    *SynchronizedList[int]
+		expected []int
+		sorted   []int
+		str      string
+	}
+
+	viewTests := []viewTest{
+		{input: newList(), expected: []int{}, sorted: []int{}, str: "[]"},
+		{input: newList(3, 1, 2), expected: []int{3, 1, 2}, sorted: []int{1, 2, 3}, str: "[3 1 2]"},
+	}
+
+	for _, test := range viewTests {
+		assert.Equal(t, test.str, test.input.String())
+		elements := []int{}
+		test.input.ForEach(func(e int) { elements = append(elements, e) })
+		assert.Equal(t, test.expected, elements)
+		assert.Equal(t, test.expected, append([]int{}, slices.Collect(test.input.All())...))
+		it := test.input.Iterator()
+		test.input.Add(4) // Iterators work on a snapshot.
+		elements = []int{}
+		for it.HasNext() {
+			elements = append(elements, it.Next())
+		}
+		assert.Equal(t, test.expected, elements)
+		assert.Equal(t, 0, test.input.Compute(test.input.Len()-1, func(e int) int { return e - 4 }))
+		assert.True(t, test.input.Remove(0))
+		test.input.Sort(func(a, b int) bool { return a < b })
+		assert.Equal(t, test.sorted, append([]int{}, test.input.ToSlice()...))
+		for _, e := range test.expected {
+			assert.True(t, test.input.Contains(e))
+		}
+		test.input.Clear()
+		assert.True(t, test.input.Empty())
+	}
+}
+
+func testSet(t *testing.T, newSet func(elements ...int) *SynchronizedSet[int]) {
+
+	type addTest struct {
+		input    *SynchronizedSet[int]
+		elements []int
+		expected []int
+		changed  bool
+	}
+
+	addTests := []addTest{
+		{input: newSet(), elements: []int{1, 1, 2}, expected: []int{1, 2}, changed: true},
+		{input: newSet(1, 2), elements: []int{1, 2}, expected: []int{1, 2}, changed: false},
+	}
+
+	for _, test := range addTests {
+		changed := false
+		for _, e := range test.elements {
+			changed = test.input.Add(e) || changed
+		}
+		assert.Equal(t, test.changed, changed)
+		assert.ElementsMatch(t, test.expected, test.input.ToSlice())
+		assert.Equal(t, len(test.expected), test.input.Len())
+	}
+
+	type removeTest struct {
+		input    *SynchronizedSet[int]
+		remove   []int
+		expected []int
+		changed  bool
+	}
+
+	removeTests := []removeTest{
+		{input: newSet(), remove: []int{1}, expected: []int{}, changed: false},
+		{input: newSet(1, 2, 3), remove: []int{1, 3}, expected: []int{2}, changed: true},
+		{input: newSet(1, 2, 3), remove: []int{4}, expected: []int{1, 2, 3}, changed: false},
+	}
+
+	for _, test := range removeTests {
+		changed := false
+		for _, e := range test.remove {
+			changed = test.input.Remove(e) || changed
+		}
+		assert.Equal(t, test.changed, changed)
+		assert.ElementsMatch(t, test.expected, test.input.ToSlice())
+	}
+
+	type containsAllTest struct {
+		input    *SynchronizedSet[int]
+		elements []int
+		expected bool
+	}
+
+	containsAllTests := []containsAllTest{
+		{input: newSet(), elements: []int{}, expected: true},
+		{input: newSet(1, 2, 3), elements: []int{1, 3}, expected: true},
+		{input: newSet(1, 2, 3), elements: []int{1, 5}, expected: false},
+	}
+
+	for _, test := range containsAllTests {
+		assert.True(t, test.input.ContainsAll(test.input))
+		assert.Equal(t, test.expected, test.input.ContainsAll(vector.New(test.elements...)))
+	}
+
+	type bulkTest struct {
+		input    *SynchronizedSet[int]
+		f        func(set *SynchronizedSet[int]) bool
+		expected []int
+		changed  bool
+	}
+
+	bulkTests := []bulkTest{
+		{input: newSet(1, 2), f: func(set *SynchronizedSet[int]) bool { return set.AddSlice([]int{2, 3}) }, expected: []int{1, 2, 3}, changed: true},
+		{input: newSet(1, 2), f: func(set *SynchronizedSet[int]) bool { return set.AddAll(set) }, expected: []int{1, 2}},
+		{input: newSet(1, 2, 3, 4), f: func(set *SynchronizedSet[int]) bool { return set.RemoveIf(func(e int) bool { return e%2 == 0 }) },
+			expected: []int{1, 3}, changed: true},
+		{input: newSet(1, 2, 3), f: func(set *SynchronizedSet[int]) bool { return set.RemoveSlice([]int{2, 5}) }, expected: []int{1, 3},
+			changed: true},
+		{input: newSet(1, 2, 3), f: func(set *SynchronizedSet[int]) bool { return set.RemoveAll(set) }, expected: []int{}, changed: true},
+		{input: newSet(1, 2, 3), f: func(set *SynchronizedSet[int]) bool { return set.RetainAll(vector.New(1, 3)) }, expected: []int{1, 3},
+			changed: true},
+		{input: newSet(1, 2, 3), f: func(set *SynchronizedSet[int]) bool { return set.RetainAll(set) }, expected: []int{1, 2, 3}},
+	}
+
+	for _, test := range bulkTests {
+		assert.Equal(t, test.changed, test.f(test.input))
+		assert.ElementsMatch(t, test.expected, test.input.ToSlice())
+		assert.Equal(t, len(test.expected) == 0, test.input.Empty())
+	}
+
+	type viewTest struct {
+		input    *SynchronizedSet[int]
+		expected []int
+	}
+
+	viewTests := []viewTest{
+		{input: newSet(), expected: []int{}},
+		{input: newSet(1, 2, 3), expected: []int{1, 2, 3}},
+	}
+
+	for _, test := range viewTests {
+		elements := []int{}
+		test.input.ForEach(func(e int) { elements = append(elements, e) })
+		assert.ElementsMatch(t, test.expected, elements)
+		assert.ElementsMatch(t, test.expected, slices.Collect(test.input.All()))
+		for _, e := range test.expected {
+			assert.True(t, test.input.Contains(e))
+		}
+		it := test.input.Iterator()
+		test.input.Add(4) // Iterators work on a snapshot.
+		elements = []int{}
+		for it.HasNext() {
+			elements = append(elements, it.Next())
+		}
+		assert.ElementsMatch(t, test.expected, elements)
+		test.input.Clear()
+		assert.True(t, test.input.Empty())
+	}
+}
+
+func testDequeue(t *testing.T, newDequeue func(elements ...int) *SynchronizedDequeue[int]) {
+
+	type peekTest struct {
+		input *SynchronizedDequeue[int]
+		first optional.Optional[int]
+		last  optional.Optional[int]
+	}
+
+	peekTests := []peekTest{
+		{input: newDequeue(), first: optional.Empty[int](), last: optional.Empty[int]()},
+		{input: newDequeue(1), first: optional.Of(1), last: optional.Of(1)},
+		{input: newDequeue(1, 2, 3), first: optional.Of(1), last: optional.Of(3)},
+	}
+
+	for _, test := range peekTests {
+		assert.Equal(t, test.first, test.input.PeekFirst())
+		assert.Equal(t, test.last, test.input.PeekLast())
+		assert.Equal(t, test.first, test.input.RemoveFirst())
+		if test.input.Empty() {
+			assert.True(t, test.input.RemoveLast().Empty())
+		} else {
+			assert.Equal(t, test.last, test.input.RemoveLast())
+		}
+	}
+
+	type addTest struct {
+		input    *SynchronizedDequeue[int]
+		first    []int
+		last     []int
+		expected []int
+	}
+
+	addTests := []addTest{
+		{input: newDequeue(), first: []int{1}, last: []int{}, expected: []int{1}},
+		{input: newDequeue(), first: []int{}, last: []int{1, 2}, expected: []int{1, 2}},
+		{input: newDequeue(3), first: []int{2, 1}, last: []int{4, 5}, expected: []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, test := range addTests {
+		for _, e := range test.first {
+			previous := test.input.PeekFirst()
+			assert.Equal(t, previous, test.input.AddFirst(e))
+		}
+		for _, e := range test.last {
+			previous := test.input.PeekLast()
+			assert.Equal(t, previous, test.input.AddLast(e))
+		}
+		assert.Equal(t, test.expected, test.input.ToSlice())
+		assert.Equal(t, len(test.expected), test.input.Len())
+	}
+
+	type addAllTest struct {
+		input    *SynchronizedDequeue[int]
+		f        func(dequeue *SynchronizedDequeue[int]) bool
+		expected []int
+		changed  bool
+	}
+
+	addAllTests := []addAllTest{
+		{input: newDequeue(1, 2), f: func(dequeue *SynchronizedDequeue[int]) bool { return dequeue.AddSlice([]int{3}) }, expected: []int{1, 2, 3},
+			changed: true},
+		{input: newDequeue(1, 2), f: func(dequeue *SynchronizedDequeue[int]) bool { return dequeue.AddAll(dequeue) }, expected: []int{1, 2, 1, 2},
+			changed: true},
+	}
+
+	for _, test := range addAllTests {
+		assert.Equal(t, test.changed, test.f(test.input))
+		assert.Equal(t, test.expected, test.input.ToSlice())
+		for _, e := range test.expected {
+			assert.True(t, test.input.Contains(e))
+		}
+		assert.False(t, test.input.Contains(0))
+	}
+
+	type viewTest struct {
+		input    *SynchronizedDequeue[int]
+		expected []int
+		str      string
+	}
+
+	viewTests := []viewTest{
+		{input: newDequeue(), expected: []int{}, str: "[]"},
+		{input: newDequeue(1, 2, 3), expected: []int{1, 2, 3}, str: "[1 2 3]"},
+	}
+
+	for _, test := range viewTests {
+		assert.Equal(t, test.str, test.input.String())
+		elements := []int{}
+		test.input.ForEach(func(e int) { elements = append(elements, e) })
+		assert.Equal(t, test.expected, elements)
+		assert.Equal(t, test.expected, append([]int{}, slices.Collect(test.input.All())...))
+		it := test.input.Iterator()
+		test.input.AddFirst(0) // Iterators work on a snapshot.
+		elements = []int{}
+		for it.HasNext() {
+			elements = append(elements, it.Next())
+		}
+		assert.Equal(t, test.expected, elements)
+		test.input.Clear()
+		assert.True(t, test.input.Empty())
+	}
+}
+
+func testMap(t *testing.T, newMap func(pairs ...pair.Pair[int, string]) *SynchronizedMap[int, string]) {
+
+	equals := func(a, b string) bool { return a == b }
+
+	type putTest struct {
+		input  *SynchronizedMap[int, string]
+		key    int
+		value  string
+		absent optional.Optional[string]
+		stored string // The value after PutIfAbsent.
+	}
+
+	putTests := []putTest{
+		{input: newMap(), key: 1, value: "a", absent: optional.Empty[string](), stored: "a"},
+		{input: newMap(pair.Of(1, "a")), key: 1, value: "b", absent: optional.Of("a"), stored: "a"},
+	}
+
+	for _, test := range putTests {
+		assert.Equal(t, test.absent, test.input.PutIfAbsent(test.key, test.value))
+		assert.Equal(t, optional.Of(test.stored), test.input.Get(test.key))
+		assert.Equal(t, optional.Of(test.stored), test.input.Put(test.key, test.value))
+		assert.Equal(t, optional.Of(test.value), test.input.Get(test.key))
+		assert.Equal(t, 1, test.input.Len())
+	}
+
+	type getTest struct {
+		input    *SynchronizedMap[int, string]
+		key      int
+		expected optional.Optional[string]
+	}
+
+	getTests := []getTest{
+		{input: newMap(), key: 1, expected: optional.Empty[string]()},
+		{input: newMap(pair.Of(1, "a"), pair.Of(2, "b")), key: 2, expected: optional.Of("b")},
+		{input: newMap(pair.Of(1, "a"), pair.Of(2, "b")), key: 3, expected: optional.Empty[string]()},
+	}
+
+	for _, test := range getTests {
+		assert.Equal(t, test.expected, test.input.Get(test.key))
+		assert.Equal(t, !test.expected.Empty(), test.input.ContainsKey(test.key))
+		if !test.expected.Empty() {
+			assert.True(t, test.input.ContainsValue(test.expected.Value(), equals))
+			assert.Equal(t, []string{test.expected.Value()}, test.input.GetIf(func(k int) bool { return k == test.key }))
+		}
+		assert.Equal(t, test.expected, test.input.Remove(test.key))
+		assert.False(t, test.input.ContainsKey(test.key))
+	}
+
+	type removeIfTest struct {
+		input    *SynchronizedMap[int, string]
+		f        func(int) bool
+		expected []int
+		changed  bool
+	}
+
+	removeIfTests := []removeIfTest{
+		{input: newMap(), f: func(k int) bool { return true }, expected: []int{}},
+		{input: newMap(pair.Of(1, "a"), pair.Of(2, "b"), pair.Of(3, "c")), f: func(k int) bool { return k%2 == 1 }, expected: []int{2},
+			changed: true},
+		{input: newMap(pair.Of(1, "a")), f: func(k int) bool { return k > 1 }, expected: []int{1}},
+	}
+
+	for _, test := range removeIfTests {
+		assert.Equal(t, test.changed, test.input.RemoveIf(test.f))
+		assert.ElementsMatch(t, test.expected, test.input.Keys())
+		assert.Equal(t, len(test.expected) == 0, test.input.Empty())
+	}
+
+	type computeTest struct {
+		input    *SynchronizedMap[int, string]
+		f        func(m *SynchronizedMap[int, string]) optional.Optional[string]
+		expected optional.Optional[string]
+		keys     []int
+	}
+
+	computeTests := []computeTest{
+		{input: newMap(), f: func(m *SynchronizedMap[int, string]) optional.Optional[string] {
+			return m.Compute(1, func(k int, v optional.Optional[string]) optional.Optional[string] { return optional.Of("a") })
+		}, expected: optional.Of("a"), keys: []int{1}},
+		{input: newMap(pair.Of(1, "a")), f: func(m *SynchronizedMap[int, string]) optional.Optional[string] {
+			return m.Compute(1, func(k int, v optional.Optional[string]) optional.Optional[string] { return optional.Empty[string]() })
+		}, expected: optional.Empty[string](), keys: []int{}},
+		{input: newMap(pair.Of(1, "a")), f: func(m *SynchronizedMap[int, string]) optional.Optional[string] {
+			return optional.Of(m.ComputeIfAbsent(1, func(k int) string { return "b" }))
+		}, expected: optional.Of("a"), keys: []int{1}},
+		{input: newMap(pair.Of(1, "a")), f: func(m *SynchronizedMap[int, string]) optional.Optional[string] {
+			return optional.Of(m.ComputeIfAbsent(2, func(k int) string { return "b" }))
+		}, expected: optional.Of("b"), keys: []int{1, 2}},
+		{input: newMap(), f: func(m *SynchronizedMap[int, string]) optional.Optional[string] {
+			return m.ComputeIfPresent(1, func(k int, v string) optional.Optional[string] { return optional.Of("b") })
+		}, expected: optional.Empty[string](), keys: []int{}},
+		{input: newMap(pair.Of(1, "a")), f: func(m *SynchronizedMap[int, string]) optional.Optional[string] {
+			return m.ComputeIfPresent(1, func(k int, v string) optional.Optional[string] { return optional.Of(v + v) })
+		}, expected: optional.Of("aa"), keys: []int{1}},
+		{input: newMap(pair.Of(1, "a")), f: func(m *SynchronizedMap[int, string]) optional.Optional[string] {
+			return m.ComputeIfPresent(1, func(k int, v string) optional.Optional[string] { return optional.Empty[string]() })
+		}, expected: optional.Empty[string](), keys: []int{}},
+	}
+
+	for _, test := range computeTests {
+		assert.Equal(t, test.expected, test.f(test.input))
+		assert.ElementsMatch(t, test.keys, test.input.Keys())
+	}
+
+	type equalsTest struct {
+		input    *SynchronizedMap[int, string]
+		other    hashmap.HashMap[int, string]
+		expected bool
+	}
+
+	equalsTests := []equalsTest{
+		{input: newMap(), other: hashmap.New[int, string](), expected: true},
+		{input: newMap(pair.Of(1, "a"), pair.Of(2, "b")), other: hashmap.New(pair.Of(2, "b"), pair.Of(1, "a")), expected: true},
+		{input: newMap(pair.Of(1, "a"), pair.Of(2, "b")), other: hashmap.New(pair.Of(1, "a")), expected: false},
+		{input: newMap(pair.Of(1, "a")), other: hashmap.New(pair.Of(1, "b")), expected: false},
+	}
+
+	for _, test := range equalsTests {
+		assert.True(t, test.input.Equals(test.input, equals))
+		assert.Equal(t, test.expected, test.input.Equals(test.other, equals))
+	}
+
+	type viewTest struct {
+		input  *SynchronizedMap[int, string]
+		keys   []int
+		values []string
+		str    string
+	}
+
+	viewTests := []viewTest{
+		{input: newMap(), keys: []int{}, values: []string{}, str: "{}"},
+		{input: newMap(pair.Of(1, "a")), keys: []int{1}, values: []string{"a"}, str: "{1=a}"},
+		{input: newMap(pair.Of(1, "a"), pair.Of(2, "b")), keys: []int{1, 2}, values: []string{"a", "b"}},
+	}
+
+	for _, test := range viewTests {
+		if test.str != "" {
+			assert.Equal(t, test.str, test.input.String())
+		}
+		assert.ElementsMatch(t, test.keys, test.input.Keys())
+		assert.ElementsMatch(t, test.values, test.input.Values())
+		assert.ElementsMatch(t, test.keys, slices.Collect(test.input.AllKeys()))
+		assert.ElementsMatch(t, test.values, slices.Collect(test.input.AllValues()))
+		entries := hashmap.New[int, string]()
+		test.input.ForEach(func(k int, v string) { entries.Put(k, v) })
+		assert.True(t, test.input.Equals(entries, equals))
+		it := test.input.Iterator()
+		test.input.Put(0, "") // Iterators work on a snapshot.
+		keys := []int{}
+		for it.HasNext() {
+			keys = append(keys, it.Next().Key())
+		}
+		assert.ElementsMatch(t, test.keys, keys)
+		for k, v := range test.input.All() {
+			assert.Equal(t, optional.Of(v), test.input.Get(k))
+		}
+		test.input.Clear()
+		assert.True(t, test.input.Empty())
+	}
+}
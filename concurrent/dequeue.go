@@ -0,0 +1,60 @@
+package concurrent
+
+import (
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// SynchronizedDequeue a [collections.Dequeue] that is safe for concurrent use.
+type SynchronizedDequeue[T comparable] struct {
+	synchronizedCollection[T, collections.Dequeue[T]]
+}
+
+// NewSynchronizedDequeue wraps the given dequeue, which must not be used directly afterwards.
+func NewSynchronizedDequeue[T comparable](dequeue collections.Dequeue[T]) *SynchronizedDequeue[T] {
+	synchronized := SynchronizedDequeue[T]{}
+	synchronized.collection = dequeue
+	return &synchronized
+}
+
+// AddFirst adds an element to the front of the dequeue and returns the previous front element as an option.
+func (synchronized *SynchronizedDequeue[T]) AddFirst(e T) optional.Optional[T] {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.collection.AddFirst(e)
+}
+
+// AddLast adds an element to the back of the dequeue and returns the previous back element as an option.
+func (synchronized *SynchronizedDequeue[T]) AddLast(e T) optional.Optional[T] {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.collection.AddLast(e)
+}
+
+// PeekFirst returns the front element of the dequeue as an option.
+func (synchronized *SynchronizedDequeue[T]) PeekFirst() optional.Optional[T] {
+	synchronized.mutex.RLock()
+	defer synchronized.mutex.RUnlock()
+	return synchronized.collection.PeekFirst()
+}
+
+// PeekLast returns the back element of the dequeue as an option.
+func (synchronized *SynchronizedDequeue[T]) PeekLast() optional.Optional[T] {
+	synchronized.mutex.RLock()
+	defer synchronized.mutex.RUnlock()
+	return synchronized.collection.PeekLast()
+}
+
+// RemoveFirst returns and removes the front element of the dequeue as an option.
+func (synchronized *SynchronizedDequeue[T]) RemoveFirst() optional.Optional[T] {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.collection.RemoveFirst()
+}
+
+// RemoveLast returns and removes the back element of the dequeue as an option.
+func (synchronized *SynchronizedDequeue[T]) RemoveLast() optional.Optional[T] {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.collection.RemoveLast()
+}
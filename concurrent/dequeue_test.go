@@ -0,0 +1,64 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/phantom820/collections/queues/listdequeue"
+	"github.com/phantom820/collections/queues/vectordequeue"
+	"github.com/stretchr/testify/assert"
+)
+
+var synchronizedDequeues = []backing[int, *SynchronizedDequeue[int]]{
+	{name: "VectorDequeue", new: func(elements ...int) *SynchronizedDequeue[int] {
+		return NewSynchronizedDequeue[int](vectordequeue.New(elements...))
+	}},
+	{name: "ListDequeue", new: func(elements ...int) *SynchronizedDequeue[int] {
+		return NewSynchronizedDequeue[int](listdequeue.New(elements...))
+	}},
+}
+
+func TestSynchronizedDequeue(t *testing.T) {
+
+	for _, backing := range synchronizedDequeues {
+		t.Run(backing.name, func(t *testing.T) { testDequeue(t, backing.new) })
+	}
+}
+
+func TestSynchronizedDequeueConcurrency(t *testing.T) {
+
+	dequeue := NewSynchronizedDequeue[int](vectordequeue.New[int]())
+
+	var wg sync.WaitGroup
+	sums := make([]int, 4)
+	for g := 0; g < 4; g++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 1; i <= 100; i++ {
+				if i%2 == 0 {
+					dequeue.AddFirst(i)
+				} else {
+					dequeue.AddLast(i)
+				}
+			}
+		}()
+		go func(g int) {
+			defer wg.Done()
+			for removed := 0; removed < 100; {
+				if e := dequeue.RemoveFirst(); !e.Empty() {
+					sums[g] += e.Value()
+					removed++
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	total := 0
+	for _, sum := range sums {
+		total += sum
+	}
+	assert.Equal(t, 4*5050, total)
+	assert.True(t, dequeue.Empty())
+}
@@ -0,0 +1,83 @@
+package concurrent
+
+import (
+	"slices"
+
+	"github.com/phantom820/collections"
+)
+
+// SynchronizedList a [collections.List] that is safe for concurrent use.
+type SynchronizedList[T comparable] struct {
+	synchronizedCollection[T, collections.List[T]]
+}
+
+// NewSynchronizedList wraps the given list, which must not be used directly afterwards.
+func NewSynchronizedList[T comparable](list collections.List[T]) *SynchronizedList[T] {
+	synchronized := SynchronizedList[T]{}
+	synchronized.collection = list
+	return &synchronized
+}
+
+// AddAt inserts the specified element at the specified index in the list.
+func (synchronized *SynchronizedList[T]) AddAt(i int, e T) {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	synchronized.collection.AddAt(i, e)
+}
+
+// At returns the element at the specified index in the list.
+func (synchronized *SynchronizedList[T]) At(i int) T {
+	synchronized.mutex.RLock()
+	defer synchronized.mutex.RUnlock()
+	return synchronized.collection.At(i)
+}
+
+// Set replaces the element at the specified index in the list with the specified element and returns the replaced element.
+func (synchronized *SynchronizedList[T]) Set(i int, e T) T {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.collection.Set(i, e)
+}
+
+// RemoveAt removes the element at the specified index in the list.
+func (synchronized *SynchronizedList[T]) RemoveAt(i int) T {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.collection.RemoveAt(i)
+}
+
+// Compute atomically replaces the element at the specified index with the result of applying f to it and returns the new element.
+// The function is called while holding the lock and must not use the list.
+func (synchronized *SynchronizedList[T]) Compute(i int, f func(T) T) T {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	e := f(synchronized.collection.At(i))
+	synchronized.collection.Set(i, e)
+	return e
+}
+
+// AddIfAbsent atomically appends the element to the list if the list does not contain it and returns true if the element was added.
+func (synchronized *SynchronizedList[T]) AddIfAbsent(e T) bool {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	if synchronized.collection.Contains(e) {
+		return false
+	}
+	return synchronized.collection.Add(e)
+}
+
+// Sort sorts the list according to the ordering defined by the given less function.
+func (synchronized *SynchronizedList[T]) Sort(less func(a, b T) bool) {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	synchronized.collection.Sort(less)
+}
+
+// Equals returns true if the list is equal to the given list. Two list are equal if they have the same size and have the same elements
+// in the same order. The given list is read before the lock is taken.
+func (synchronized *SynchronizedList[T]) Equals(list collections.List[T]) bool {
+	if list == collections.List[T](synchronized) {
+		return true
+	}
+	return slices.Equal(synchronized.ToSlice(), list.ToSlice())
+}
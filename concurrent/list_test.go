@@ -0,0 +1,68 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/lists/linkedlist"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/stretchr/testify/assert"
+)
+
+var synchronizedLists = []backing[int, *SynchronizedList[int]]{
+	{name: "Vector", new: func(elements ...int) *SynchronizedList[int] { return NewSynchronizedList[int](vector.New(elements...)) }},
+	{name: "LinkedList", new: func(elements ...int) *SynchronizedList[int] {
+		return NewSynchronizedList[int](linkedlist.New(elements...))
+	}},
+}
+
+func TestSynchronizedList(t *testing.T) {
+
+	for _, backing := range synchronizedLists {
+		t.Run(backing.name, func(t *testing.T) { testList(t, backing.new) })
+	}
+}
+
+func TestSynchronizedListConcurrency(t *testing.T) {
+
+	list := NewSynchronizedList[int](vector.New[int]())
+	list.Add(0)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				list.Add(g)
+				list.AddIfAbsent(-1)
+				list.Compute(0, func(e int) int { return e + 1 })
+				list.Contains(g)
+				list.Len()
+				for range list.All() {
+				}
+				it := list.Iterator()
+				for it.HasNext() {
+					it.Next()
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 800, list.At(0))
+	assert.Equal(t, 802, list.Len())
+	count := 0
+	list.ForEach(func(e int) {
+		if e == -1 {
+			count++
+		}
+	})
+	assert.Equal(t, 1, count)
+
+	list.Update(func(list collections.List[int]) {
+		list.RemoveIf(func(e int) bool { return e < 8 })
+	})
+	assert.Equal(t, []int{800}, list.ToSlice())
+}
@@ -0,0 +1,257 @@
+package concurrent
+
+import (
+	"fmt"
+	"iter"
+	"sync/atomic"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// queueNode a node of a [LockFreeQueue]. A node whose item is nil holds no element, either because it is the sentinel at the front of
+// the queue or because its element has been removed.
+type queueNode[T comparable] struct {
+	item atomic.Pointer[T]
+	next atomic.Pointer[queueNode[T]]
+}
+
+// LockFreeQueue an unbounded [collections.Queue] that is safe for concurrent use by multiple producers and consumers without locks. It
+// is the queue of Michael and Scott, a singly linked list with a sentinel node in which AddLast links nodes at the tail and RemoveFirst
+// advances the head, both with compare and swap. Elements are removed by clearing the item of their node, so Remove and friends may
+// remove elements from anywhere in the queue, the emptied nodes are unlinked once they reach the front.
+//
+// AddLast, PeekFirst and RemoveFirst are linearizable. Len is exact when no operation is in progress, bulk operations are not atomic and
+// iterators are weakly consistent, they never fail because of concurrent modification and may or may not reflect it.
+type LockFreeQueue[T comparable] struct {
+	head atomic.Pointer[queueNode[T]]
+	tail atomic.Pointer[queueNode[T]]
+	len  atomic.Int64
+}
+
+// NewLockFreeQueue creates a queue with the given elements.
+func NewLockFreeQueue[T comparable](elements ...T) *LockFreeQueue[T] {
+	queue := LockFreeQueue[T]{}
+	sentinel := &queueNode[T]{}
+	queue.head.Store(sentinel)
+	queue.tail.Store(sentinel)
+	for _, e := range elements {
+		queue.AddLast(e)
+	}
+	return &queue
+}
+
+// Add adds the element to the back of the queue and returns true.
+func (queue *LockFreeQueue[T]) Add(e T) bool {
+	queue.AddLast(e)
+	return true
+}
+
+// AddLast adds an element to the back of the queue and returns the previous back element as an option, which is empty if the queue was
+// empty or the back element was being removed.
+func (queue *LockFreeQueue[T]) AddLast(e T) optional.Optional[T] {
+	node := &queueNode[T]{}
+	node.item.Store(&e)
+	for {
+		tail := queue.tail.Load()
+		next := tail.next.Load()
+		if tail != queue.tail.Load() {
+			continue
+		} else if next != nil {
+			queue.tail.CompareAndSwap(tail, next) // Help a lagging AddLast.
+			continue
+		}
+		previous := tail.item.Load()
+		if tail.next.CompareAndSwap(nil, node) {
+			queue.tail.CompareAndSwap(tail, node)
+			queue.len.Add(1)
+			if previous == nil {
+				return optional.Empty[T]()
+			}
+			return optional.Of(*previous)
+		}
+	}
+}
+
+// AddAll adds all of the elements in the specified iterable to the back of the queue.
+func (queue *LockFreeQueue[T]) AddAll(iterable iterable.Iterable[T]) bool {
+	return queue.AddSlice(iterator.ToSlice(iterable.Iterator()))
+}
+
+// AddSlice adds all of the elements in the specified slice to the back of the queue, other operations may interleave with the additions.
+func (queue *LockFreeQueue[T]) AddSlice(s []T) bool {
+	for _, e := range s {
+		queue.AddLast(e)
+	}
+	return len(s) > 0
+}
+
+// PeekFirst returns the front element of the queue as an option.
+func (queue *LockFreeQueue[T]) PeekFirst() optional.Optional[T] {
+	for node := queue.head.Load().next.Load(); node != nil; node = node.next.Load() {
+		if item := node.item.Load(); item != nil {
+			return optional.Of(*item)
+		}
+	}
+	return optional.Empty[T]()
+}
+
+// RemoveFirst returns and removes the front element of the queue as an option.
+func (queue *LockFreeQueue[T]) RemoveFirst() optional.Optional[T] {
+	for {
+		head := queue.head.Load()
+		tail := queue.tail.Load()
+		next := head.next.Load()
+		if head != queue.head.Load() {
+			continue
+		} else if next == nil {
+			return optional.Empty[T]()
+		} else if head == tail {
+			queue.tail.CompareAndSwap(tail, next) // Keep the tail from falling behind the head.
+			continue
+		}
+		// The next node becomes the sentinel, its element is claimed unless a concurrent removal already took it.
+		if queue.head.CompareAndSwap(head, next) {
+			if item := next.item.Swap(nil); item != nil {
+				queue.len.Add(-1)
+				return optional.Of(*item)
+			}
+		}
+	}
+}
+
+// Contains returns true if the queue contains the specified element.
+func (queue *LockFreeQueue[T]) Contains(e T) bool {
+	for x := range queue.All() {
+		if x == e {
+			return true
+		}
+	}
+	return false
+}
+
+// Clear removes all of the elements from the queue, elements added concurrently may or may not be removed.
+func (queue *LockFreeQueue[T]) Clear() {
+	queue.RemoveIf(func(T) bool { return true })
+}
+
+// Empty returns true if the queue contains no elements.
+func (queue *LockFreeQueue[T]) Empty() bool {
+	return queue.PeekFirst().Empty()
+}
+
+// Len returns the number of elements in the queue.
+func (queue *LockFreeQueue[T]) Len() int {
+	return max(int(queue.len.Load()), 0)
+}
+
+// Remove removes the first occurence of the given element and returns true if the queue changed as a result.
+func (queue *LockFreeQueue[T]) Remove(e T) bool {
+	for node := queue.head.Load().next.Load(); node != nil; node = node.next.Load() {
+		if item := node.item.Load(); item != nil && *item == e && node.item.CompareAndSwap(item, nil) {
+			queue.len.Add(-1)
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveIf removes all of the elements of the queue that satisfy the given predicate.
+func (queue *LockFreeQueue[T]) RemoveIf(f func(T) bool) bool {
+	removed := false
+	for node := queue.head.Load().next.Load(); node != nil; node = node.next.Load() {
+		if item := node.item.Load(); item != nil && f(*item) && node.item.CompareAndSwap(item, nil) {
+			queue.len.Add(-1)
+			removed = true
+		}
+	}
+	return removed
+}
+
+// RemoveAll removes all of the queue's elements that are also contained in the specified iterable.
+func (queue *LockFreeQueue[T]) RemoveAll(iterable iterable.Iterable[T]) bool {
+	return queue.RemoveSlice(iterator.ToSlice(iterable.Iterator()))
+}
+
+// RemoveSlice removes all of the queue's elements that are also contained in the specified slice.
+func (queue *LockFreeQueue[T]) RemoveSlice(s []T) bool {
+	elements := make(map[T]struct{}, len(s))
+	for _, e := range s {
+		elements[e] = struct{}{}
+	}
+	return queue.RemoveIf(func(e T) bool {
+		_, ok := elements[e]
+		return ok
+	})
+}
+
+// RetainAll retains only the elements in the queue that are contained in the specified collection.
+func (queue *LockFreeQueue[T]) RetainAll(c collections.Collection[T]) bool {
+	return queue.RemoveIf(func(e T) bool { return !c.Contains(e) })
+}
+
+// ForEach performs the given action for each element of the queue.
+func (queue *LockFreeQueue[T]) ForEach(f func(T)) {
+	for e := range queue.All() {
+		f(e)
+	}
+}
+
+// ToSlice returns a slice containing the elements of the queue.
+func (queue *LockFreeQueue[T]) ToSlice() []T {
+	slice := make([]T, 0, queue.Len())
+	for e := range queue.All() {
+		slice = append(slice, e)
+	}
+	return slice
+}
+
+// Iterator returns a weakly consistent iterator over the elements of the queue from front to back.
+func (queue *LockFreeQueue[T]) Iterator() iterator.Iterator[T] {
+	return &lockFreeQueueIterator[T]{node: queue.head.Load()}
+}
+
+// All returns a weakly consistent sequence over the elements of the queue from front to back.
+func (queue *LockFreeQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := queue.head.Load().next.Load(); node != nil; node = node.next.Load() {
+			if item := node.item.Load(); item != nil && !yield(*item) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the string representation of the queue.
+func (queue *LockFreeQueue[T]) String() string {
+	return fmt.Sprint(queue.ToSlice())
+}
+
+// lockFreeQueueIterator a weakly consistent iterator for [LockFreeQueue].
+type lockFreeQueueIterator[T comparable] struct {
+	node *queueNode[T] // The node before the next element.
+	item *T            // The next element, nil until found.
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *lockFreeQueueIterator[T]) HasNext() bool {
+	for it.item == nil && it.node != nil {
+		if it.node = it.node.next.Load(); it.node != nil {
+			it.item = it.node.item.Load()
+		}
+	}
+	return it.item != nil
+}
+
+// Next returns the next element in the iterator.
+func (it *lockFreeQueueIterator[T]) Next() T {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	}
+	item := it.item
+	it.item = nil
+	return *item
+}
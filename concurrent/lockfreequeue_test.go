@@ -0,0 +1,191 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockFreeQueue(t *testing.T) {
+
+	queue := NewLockFreeQueue[int]()
+	assert.True(t, queue.Empty())
+	assert.True(t, queue.PeekFirst().Empty())
+	assert.True(t, queue.RemoveFirst().Empty())
+	assert.Equal(t, "[]", queue.String())
+
+	assert.True(t, queue.AddLast(1).Empty())
+	assert.Equal(t, optional.Of(1), queue.AddLast(2))
+	assert.True(t, queue.Add(3))
+	assert.True(t, queue.AddSlice([]int{4, 5}))
+	assert.False(t, queue.AddSlice([]int{}))
+	assert.True(t, queue.AddAll(vector.Of(6, 7)))
+	assert.Equal(t, 7, queue.Len())
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, queue.ToSlice())
+	assert.Equal(t, "[1 2 3 4 5 6 7]", queue.String())
+	assert.True(t, queue.Contains(7))
+	assert.False(t, queue.Contains(8))
+
+	assert.Equal(t, optional.Of(1), queue.PeekFirst())
+	assert.Equal(t, optional.Of(1), queue.RemoveFirst())
+	assert.Equal(t, optional.Of(2), queue.RemoveFirst())
+
+	assert.True(t, queue.Remove(3))
+	assert.False(t, queue.Remove(3))
+	assert.Equal(t, optional.Of(4), queue.PeekFirst())
+	assert.Equal(t, optional.Of(7), queue.AddLast(8))
+	assert.True(t, queue.Remove(8))
+	assert.True(t, queue.AddLast(9).Empty())
+	assert.True(t, queue.RemoveIf(func(e int) bool { return e%2 == 1 }))
+	assert.False(t, queue.RemoveIf(func(e int) bool { return e%2 == 1 }))
+	assert.Equal(t, []int{4, 6}, queue.ToSlice())
+	assert.True(t, queue.AddSlice([]int{10, 11, 12}))
+	assert.True(t, queue.RemoveAll(vector.Of(4, 11)))
+	assert.True(t, queue.RemoveSlice([]int{12}))
+	assert.False(t, queue.RemoveSlice([]int{12}))
+	assert.True(t, queue.RetainAll(hashset.New(10)))
+	assert.False(t, queue.RetainAll(hashset.New(10)))
+	assert.Equal(t, 1, queue.Len())
+
+	elements := make([]int, 0)
+	queue.ForEach(func(e int) { elements = append(elements, e) })
+	assert.Equal(t, []int{10}, elements)
+
+	queue.Clear()
+	assert.True(t, queue.Empty())
+	assert.Equal(t, 0, queue.Len())
+	assert.True(t, queue.RemoveFirst().Empty())
+	assert.Equal(t, optional.Of(1), NewLockFreeQueue(1, 2).RemoveFirst())
+}
+
+func TestLockFreeQueueIterator(t *testing.T) {
+
+	queue := NewLockFreeQueue(1, 2, 3, 4)
+	it := queue.Iterator()
+	assert.Equal(t, 1, it.Next())
+
+	// The iterator is weakly consistent, it skips removed elements it has not reached and sees added ones.
+	queue.Remove(2)
+	queue.RemoveFirst()
+	queue.AddLast(5)
+	assert.Equal(t, []int{3, 4, 5}, iterator.ToSlice(it))
+	assert.PanicsWithError(t, errors.NoSuchElement().Error(), func() { it.Next() })
+
+	for e := range queue.All() {
+		queue.Remove(e)
+	}
+	assert.True(t, queue.Empty())
+}
+
+type message struct {
+	producer int
+	sequence int
+}
+
+func TestLockFreeQueueProducersConsumers(t *testing.T) {
+
+	const (
+		producers = 4
+		consumers = 4
+		messages  = 2000
+	)
+
+	queue := NewLockFreeQueue[message]()
+	received := make([][]message, consumers)
+	var remaining sync.WaitGroup
+	remaining.Add(producers * messages)
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < messages; i++ {
+				queue.AddLast(message{producer: p, sequence: i})
+			}
+		}(p)
+	}
+	done := make(chan struct{})
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func(c int) {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if m := queue.RemoveFirst(); !m.Empty() {
+					received[c] = append(received[c], m.Value())
+					remaining.Done()
+				}
+			}
+		}(c)
+	}
+	remaining.Wait()
+	close(done)
+	wg.Wait()
+
+	// Every message is received exactly once and, since a queue is FIFO, each consumer sees the messages of a producer in order.
+	seen := make(map[message]bool)
+	for _, messages := range received {
+		last := make(map[int]int)
+		for _, m := range messages {
+			assert.False(t, seen[m])
+			seen[m] = true
+			if sequence, ok := last[m.producer]; ok {
+				assert.Less(t, sequence, m.sequence)
+			}
+			last[m.producer] = m.sequence
+		}
+	}
+	assert.Equal(t, producers*messages, len(seen))
+	assert.True(t, queue.Empty())
+	assert.Equal(t, 0, queue.Len())
+}
+
+func TestLockFreeQueueConcurrentRemoval(t *testing.T) {
+
+	const n = 4000
+
+	elements := make([]int, n)
+	for i := range elements {
+		elements[i] = i
+	}
+	queue := NewLockFreeQueue(elements...)
+
+	// RemoveFirst and Remove race for the same elements, each element must be claimed by exactly one of them.
+	claimed := make([][]int, 4)
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				if g%2 == 0 {
+					if e := queue.RemoveFirst(); !e.Empty() {
+						claimed[g] = append(claimed[g], e.Value())
+					}
+				} else if e := n - 1 - i; queue.Remove(e) {
+					claimed[g] = append(claimed[g], e)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	all := make([]int, 0, n)
+	for _, elements := range claimed {
+		all = append(all, elements...)
+	}
+	assert.ElementsMatch(t, elements, all)
+	assert.True(t, queue.Empty())
+	assert.Equal(t, 0, queue.Len())
+}
@@ -0,0 +1,136 @@
+package concurrent
+
+import (
+	"fmt"
+	"iter"
+	"sync/atomic"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// stackNode a node of a [LockFreeStack]. Nodes are never modified once pushed, which is what makes reading them without locks safe.
+type stackNode[T any] struct {
+	value T
+	next  *stackNode[T]
+}
+
+// LockFreeStack an unbounded Last In First Out stack that is safe for concurrent use without locks. It is the stack of Treiber, a
+// singly linked list whose top is replaced with compare and swap. Push, Pop and Peek are linearizable and iterators see the stack as it
+// was when they were created.
+type LockFreeStack[T any] struct {
+	top atomic.Pointer[stackNode[T]]
+	len atomic.Int64
+}
+
+// NewLockFreeStack creates a stack with the given elements, the last element is at the top.
+func NewLockFreeStack[T any](elements ...T) *LockFreeStack[T] {
+	stack := LockFreeStack[T]{}
+	for _, e := range elements {
+		stack.Push(e)
+	}
+	return &stack
+}
+
+// Push adds an element to the top of the stack and returns the previous top element as an option.
+func (stack *LockFreeStack[T]) Push(e T) optional.Optional[T] {
+	node := &stackNode[T]{value: e}
+	for {
+		node.next = stack.top.Load()
+		if stack.top.CompareAndSwap(node.next, node) {
+			stack.len.Add(1)
+			if node.next == nil {
+				return optional.Empty[T]()
+			}
+			return optional.Of(node.next.value)
+		}
+	}
+}
+
+// Pop returns and removes the top element of the stack as an option.
+func (stack *LockFreeStack[T]) Pop() optional.Optional[T] {
+	for {
+		top := stack.top.Load()
+		if top == nil {
+			return optional.Empty[T]()
+		} else if stack.top.CompareAndSwap(top, top.next) {
+			stack.len.Add(-1)
+			return optional.Of(top.value)
+		}
+	}
+}
+
+// Peek returns the top element of the stack as an option.
+func (stack *LockFreeStack[T]) Peek() optional.Optional[T] {
+	if top := stack.top.Load(); top != nil {
+		return optional.Of(top.value)
+	}
+	return optional.Empty[T]()
+}
+
+// Clear removes all of the elements from the stack.
+func (stack *LockFreeStack[T]) Clear() {
+	for !stack.Pop().Empty() {
+	}
+}
+
+// Len returns the number of elements in the stack. Len is exact when no operation is in progress.
+func (stack *LockFreeStack[T]) Len() int {
+	return max(int(stack.len.Load()), 0)
+}
+
+// Empty returns true if the stack contains no elements.
+func (stack *LockFreeStack[T]) Empty() bool {
+	return stack.top.Load() == nil
+}
+
+// ToSlice returns a slice containing the elements of the stack from top to bottom.
+func (stack *LockFreeStack[T]) ToSlice() []T {
+	slice := make([]T, 0, stack.Len())
+	for e := range stack.All() {
+		slice = append(slice, e)
+	}
+	return slice
+}
+
+// Iterator returns an iterator over the elements of the stack from top to bottom as it was when the iterator was created.
+func (stack *LockFreeStack[T]) Iterator() iterator.Iterator[T] {
+	return &lockFreeStackIterator[T]{node: stack.top.Load()}
+}
+
+// All returns a sequence over the elements of the stack from top to bottom as it was when ranging started.
+func (stack *LockFreeStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := stack.top.Load(); node != nil; node = node.next {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the string representation of the stack from top to bottom.
+func (stack *LockFreeStack[T]) String() string {
+	return fmt.Sprint(stack.ToSlice())
+}
+
+// lockFreeStackIterator iterator implementation for [LockFreeStack].
+type lockFreeStackIterator[T any] struct {
+	node *stackNode[T]
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *lockFreeStackIterator[T]) HasNext() bool {
+	return it.node != nil
+}
+
+// Next returns the next element in the iterator.
+func (it *lockFreeStackIterator[T]) Next() T {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	}
+	value := it.node.value
+	it.node = it.node.next
+	return value
+}
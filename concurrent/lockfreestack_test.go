@@ -0,0 +1,94 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockFreeStack(t *testing.T) {
+
+	stack := NewLockFreeStack[int]()
+	assert.True(t, stack.Empty())
+	assert.True(t, stack.Peek().Empty())
+	assert.True(t, stack.Pop().Empty())
+	assert.Equal(t, "[]", stack.String())
+
+	assert.True(t, stack.Push(1).Empty())
+	assert.Equal(t, optional.Of(1), stack.Push(2))
+	assert.Equal(t, optional.Of(2), stack.Push(3))
+	assert.Equal(t, 3, stack.Len())
+	assert.Equal(t, []int{3, 2, 1}, stack.ToSlice())
+	assert.Equal(t, "[3 2 1]", stack.String())
+
+	assert.Equal(t, optional.Of(3), stack.Peek())
+	assert.Equal(t, optional.Of(3), stack.Pop())
+	assert.Equal(t, optional.Of(2), stack.Pop())
+	assert.Equal(t, 1, stack.Len())
+
+	stack.Clear()
+	assert.True(t, stack.Empty())
+	assert.Equal(t, 0, stack.Len())
+	assert.Equal(t, optional.Of(2), NewLockFreeStack(1, 2).Pop())
+}
+
+func TestLockFreeStackIterator(t *testing.T) {
+
+	stack := NewLockFreeStack(1, 2, 3)
+	it := stack.Iterator()
+
+	// The iterator sees the stack as it was when it was created.
+	stack.Pop()
+	stack.Push(4)
+	assert.Equal(t, []int{3, 2, 1}, iterator.ToSlice(it))
+	assert.PanicsWithError(t, errors.NoSuchElement().Error(), func() { it.Next() })
+
+	for e := range stack.All() {
+		assert.Equal(t, 4, e)
+		break
+	}
+}
+
+func TestLockFreeStackConcurrency(t *testing.T) {
+
+	const (
+		goroutines = 8
+		n          = 2000
+	)
+
+	stack := NewLockFreeStack[int]()
+	popped := make([][]int, goroutines)
+
+	// Each goroutine pushes its own elements and pops after every push, so a pop never finds the stack empty.
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				stack.Push(g*n + i)
+				e := stack.Pop()
+				if assert.False(t, e.Empty()) {
+					popped[g] = append(popped[g], e.Value())
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	// Every element is popped exactly once.
+	seen := make(map[int]bool)
+	for _, elements := range popped {
+		for _, e := range elements {
+			assert.False(t, seen[e])
+			seen[e] = true
+		}
+	}
+	assert.Equal(t, goroutines*n, len(seen))
+	assert.True(t, stack.Empty())
+	assert.Equal(t, 0, stack.Len())
+}
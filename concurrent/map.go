@@ -0,0 +1,279 @@
+package concurrent
+
+import (
+	"fmt"
+	"iter"
+	"sync"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/caches"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// SynchronizedMap a [collections.Map] that is safe for concurrent use.
+type SynchronizedMap[K comparable, V any] struct {
+	mutex          sync.RWMutex
+	m              collections.Map[K, V]
+	exclusiveReads bool // True if reads modify the wrapped map, such reads must hold the lock exclusively.
+}
+
+// NewSynchronizedMap wraps the given map, which must not be used directly afterwards. Reads share the lock unless the map modifies itself
+// on reads, which is the case for an access ordered LinkedHashMap and for caches, then reads hold the lock exclusively.
+func NewSynchronizedMap[K comparable, V any](m collections.Map[K, V]) *SynchronizedMap[K, V] {
+	return &SynchronizedMap[K, V]{m: m, exclusiveReads: modifiedOnRead(m)}
+}
+
+// modifiedOnRead returns true if reading the map changes its structure or state.
+func modifiedOnRead[K comparable, V any](m collections.Map[K, V]) bool {
+	if _, ok := m.(caches.Cache[K, V]); ok {
+		return true
+	}
+	ordered, ok := m.(interface{ AccessOrder() bool })
+	return ok && ordered.AccessOrder()
+}
+
+// readLock locks the map for reading.
+func (synchronized *SynchronizedMap[K, V]) readLock() {
+	if synchronized.exclusiveReads {
+		synchronized.mutex.Lock()
+	} else {
+		synchronized.mutex.RLock()
+	}
+}
+
+// readUnlock undoes a call to readLock.
+func (synchronized *SynchronizedMap[K, V]) readUnlock() {
+	if synchronized.exclusiveReads {
+		synchronized.mutex.Unlock()
+	} else {
+		synchronized.mutex.RUnlock()
+	}
+}
+
+// Update performs the given action on the wrapped map while holding the lock exclusively, which makes a sequence of operations atomic.
+// The action must not use the synchronized map.
+func (synchronized *SynchronizedMap[K, V]) Update(f func(collections.Map[K, V])) {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	f(synchronized.m)
+}
+
+// Compute atomically computes a new mapping for the key from the key and its current value as an option. The key is removed if the
+// result is empty. Compute returns the new value as an option. The function is called while holding the lock and must not use the map.
+func (synchronized *SynchronizedMap[K, V]) Compute(key K, f func(K, optional.Optional[V]) optional.Optional[V]) optional.Optional[V] {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	value := f(key, synchronized.m.Get(key))
+	if value.Empty() {
+		synchronized.m.Remove(key)
+	} else {
+		synchronized.m.Put(key, value.Value())
+	}
+	return value
+}
+
+// ComputeIfAbsent atomically maps the key to the result of f if the key is not mapped and returns the value the key is mapped to. The
+// function is called while holding the lock and must not use the map.
+func (synchronized *SynchronizedMap[K, V]) ComputeIfAbsent(key K, f func(K) V) V {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	if value := synchronized.m.Get(key); !value.Empty() {
+		return value.Value()
+	}
+	value := f(key)
+	synchronized.m.Put(key, value)
+	return value
+}
+
+// ComputeIfPresent atomically computes a new mapping for the key from the key and its current value if the key is mapped. The key is
+// removed if the result is empty. ComputeIfPresent returns the new value as an option. The function is called while holding the lock and
+// must not use the map.
+func (synchronized *SynchronizedMap[K, V]) ComputeIfPresent(key K, f func(K, V) optional.Optional[V]) optional.Optional[V] {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	current := synchronized.m.Get(key)
+	if current.Empty() {
+		return current
+	}
+	value := f(key, current.Value())
+	if value.Empty() {
+		synchronized.m.Remove(key)
+	} else {
+		synchronized.m.Put(key, value.Value())
+	}
+	return value
+}
+
+// Put adds a new key/value pair to the map and optionally returns previously bound value.
+func (synchronized *SynchronizedMap[K, V]) Put(key K, value V) optional.Optional[V] {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.m.Put(key, value)
+}
+
+// PutIfAbsent atomically adds a new key/value pair to the map if the key is not already bounded and optionally returns bound value.
+func (synchronized *SynchronizedMap[K, V]) PutIfAbsent(key K, value V) optional.Optional[V] {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.m.PutIfAbsent(key, value)
+}
+
+// Get optionally returns the value associated with a key.
+func (synchronized *SynchronizedMap[K, V]) Get(key K) optional.Optional[V] {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return synchronized.m.Get(key)
+}
+
+// GetIf returns the values mapped by keys that match the given predicate. The predicate is called while holding the lock and must not
+// use the map.
+func (synchronized *SynchronizedMap[K, V]) GetIf(f func(K) bool) []V {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return synchronized.m.GetIf(f)
+}
+
+// Remove removes a key from the map, returning the value associated previously with that key as an option.
+func (synchronized *SynchronizedMap[K, V]) Remove(key K) optional.Optional[V] {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.m.Remove(key)
+}
+
+// RemoveIf removes all the key, value mapping in which the key matches the given predicate. The predicate is called while holding the
+// lock and must not use the map.
+func (synchronized *SynchronizedMap[K, V]) RemoveIf(f func(K) bool) bool {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	return synchronized.m.RemoveIf(f)
+}
+
+// ContainsKey returns true if the map contains a mapping for the specified key.
+func (synchronized *SynchronizedMap[K, V]) ContainsKey(key K) bool {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return synchronized.m.ContainsKey(key)
+}
+
+// ContainsValue returns true if the map maps one or more keys to the specified value.
+func (synchronized *SynchronizedMap[K, V]) ContainsValue(value V, equals func(v1, v2 V) bool) bool {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return synchronized.m.ContainsValue(value, equals)
+}
+
+// Clear removes all of the mappings from the map.
+func (synchronized *SynchronizedMap[K, V]) Clear() {
+	synchronized.mutex.Lock()
+	defer synchronized.mutex.Unlock()
+	synchronized.m.Clear()
+}
+
+// Keys returns a slice containing the keys in the map.
+func (synchronized *SynchronizedMap[K, V]) Keys() []K {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return synchronized.m.Keys()
+}
+
+// Values returns a slice containing the values in the map.
+func (synchronized *SynchronizedMap[K, V]) Values() []V {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return synchronized.m.Values()
+}
+
+// Len returns the number of entries in the map.
+func (synchronized *SynchronizedMap[K, V]) Len() int {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return synchronized.m.Len()
+}
+
+// Empty returns true if the map has no entries.
+func (synchronized *SynchronizedMap[K, V]) Empty() bool {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return synchronized.m.Empty()
+}
+
+// snapshot returns the entries of the map.
+func (synchronized *SynchronizedMap[K, V]) snapshot() []pair.Pair[K, V] {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return iterator.ToSlice(synchronized.m.Iterator())
+}
+
+// ForEach performs the given action for each entry of a snapshot of the map, the lock is not held while the action runs.
+func (synchronized *SynchronizedMap[K, V]) ForEach(f func(K, V)) {
+	for _, entry := range synchronized.snapshot() {
+		f(entry.Key(), entry.Value())
+	}
+}
+
+// Iterator returns an iterator over a snapshot of the entries of the map.
+func (synchronized *SynchronizedMap[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
+	return iterator.Of(synchronized.snapshot()...)
+}
+
+// All returns a sequence over a snapshot of the entries of the map, taken when ranging starts.
+func (synchronized *SynchronizedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, entry := range synchronized.snapshot() {
+			if !yield(entry.Key(), entry.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// AllKeys returns a sequence over a snapshot of the keys of the map, taken when ranging starts.
+func (synchronized *SynchronizedMap[K, V]) AllKeys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, key := range synchronized.Keys() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// AllValues returns a sequence over a snapshot of the values of the map, taken when ranging starts.
+func (synchronized *SynchronizedMap[K, V]) AllValues() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range synchronized.Values() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Equals return true if the map is equal to the given map. The given map is read before the lock is taken.
+func (synchronized *SynchronizedMap[K, V]) Equals(other collections.Map[K, V], equals func(V, V) bool) bool {
+	if other == collections.Map[K, V](synchronized) {
+		return true
+	}
+	entries := iterator.ToSlice(other.Iterator())
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	if len(entries) != synchronized.m.Len() {
+		return false
+	}
+	for _, entry := range entries {
+		value := synchronized.m.Get(entry.Key())
+		if value.Empty() || !equals(entry.Value(), value.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the string representation of the map.
+func (synchronized *SynchronizedMap[K, V]) String() string {
+	synchronized.readLock()
+	defer synchronized.readUnlock()
+	return fmt.Sprint(synchronized.m)
+}
@@ -0,0 +1,110 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/caches"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/maps/linkedhashmap"
+	"github.com/phantom820/collections/maps/treemap"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+var synchronizedMaps = []backing[pair.Pair[int, string], *SynchronizedMap[int, string]]{
+	{name: "HashMap", new: func(pairs ...pair.Pair[int, string]) *SynchronizedMap[int, string] {
+		return NewSynchronizedMap[int, string](hashmap.New(pairs...))
+	}},
+	{name: "TreeMap", new: func(pairs ...pair.Pair[int, string]) *SynchronizedMap[int, string] {
+		return NewSynchronizedMap[int, string](treemap.New(func(a, b int) bool { return a < b }, pairs...))
+	}},
+	{name: "AccessOrderLinkedHashMap", new: func(pairs ...pair.Pair[int, string]) *SynchronizedMap[int, string] {
+		return NewSynchronizedMap[int, string](linkedhashmap.NewAccessOrder(pairs...))
+	}},
+	{name: "LRU", new: func(pairs ...pair.Pair[int, string]) *SynchronizedMap[int, string] {
+		cache := caches.NewLRU[int, string](16)
+		for _, pair := range pairs {
+			cache.Put(pair.Key(), pair.Value())
+		}
+		return NewSynchronizedMap[int, string](cache)
+	}},
+}
+
+func TestSynchronizedMap(t *testing.T) {
+
+	for _, backing := range synchronizedMaps {
+		t.Run(backing.name, func(t *testing.T) { testMap(t, backing.new) })
+	}
+	assert.False(t, synchronizedMaps[0].new().exclusiveReads)
+	assert.False(t, NewSynchronizedMap[int, string](linkedhashmap.New[int, string]()).exclusiveReads)
+	assert.True(t, synchronizedMaps[2].new().exclusiveReads)
+	assert.True(t, synchronizedMaps[3].new().exclusiveReads)
+}
+
+func TestSynchronizedMapConcurrency(t *testing.T) {
+
+	m := NewSynchronizedMap[string, int](hashmap.New[string, int]())
+	words := []string{"a", "b", "c", "d"}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				word := words[i%len(words)]
+				m.Compute(word, func(k string, v optional.Optional[int]) optional.Optional[int] {
+					if v.Empty() {
+						return optional.Of(1)
+					}
+					return optional.Of(v.Value() + 1)
+				})
+				m.Get(word)
+				for range m.All() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, word := range words {
+		assert.Equal(t, optional.Of(200), m.Get(word))
+	}
+
+	m.Update(func(m collections.Map[string, int]) {
+		for _, word := range words {
+			m.Put(word, m.Get(word).Value()*2)
+		}
+	})
+	assert.Equal(t, optional.Of(400), m.Get("a"))
+}
+
+func TestSynchronizedMapAccessOrderConcurrency(t *testing.T) {
+
+	// Reads reorder these maps, so they must not run concurrently.
+	maps := []*SynchronizedMap[int, int]{NewSynchronizedMap[int, int](linkedhashmap.NewAccessOrder[int, int]()),
+		NewSynchronizedMap[int, int](caches.NewLRU[int, int](8))}
+
+	for _, m := range maps {
+		for i := 0; i < 8; i++ {
+			m.Put(i, i)
+		}
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					assert.Equal(t, optional.Of(g), m.Get(g))
+					m.ContainsKey(i % 8)
+					m.Keys()
+				}
+			}(g)
+		}
+		wg.Wait()
+		assert.ElementsMatch(t, []int{0, 1, 2, 3, 4, 5, 6, 7}, m.Keys())
+	}
+}
@@ -0,0 +1,33 @@
+package concurrent
+
+import (
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+)
+
+// SynchronizedSet a [collections.Set] that is safe for concurrent use.
+type SynchronizedSet[T comparable] struct {
+	synchronizedCollection[T, collections.Set[T]]
+}
+
+// NewSynchronizedSet wraps the given set, which must not be used directly afterwards.
+func NewSynchronizedSet[T comparable](set collections.Set[T]) *SynchronizedSet[T] {
+	synchronized := SynchronizedSet[T]{}
+	synchronized.collection = set
+	return &synchronized
+}
+
+// ContainsAll returns true if the set contains all of the elements in the specified iterable. The iterable is read before the lock is
+// taken, so it may be the set itself.
+func (synchronized *SynchronizedSet[T]) ContainsAll(iterable iterable.Iterable[T]) bool {
+	elements := iterator.ToSlice(iterable.Iterator())
+	synchronized.mutex.RLock()
+	defer synchronized.mutex.RUnlock()
+	for _, e := range elements {
+		if !synchronized.collection.Contains(e) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/phantom820/collections/sets/linkedhashset"
+	"github.com/phantom820/collections/sets/treeset"
+	"github.com/stretchr/testify/assert"
+)
+
+var synchronizedSets = []backing[int, *SynchronizedSet[int]]{
+	{name: "HashSet", new: func(elements ...int) *SynchronizedSet[int] { return NewSynchronizedSet[int](hashset.New(elements...)) }},
+	{name: "LinkedHashSet", new: func(elements ...int) *SynchronizedSet[int] {
+		return NewSynchronizedSet[int](linkedhashset.New(elements...))
+	}},
+	{name: "TreeSet", new: func(elements ...int) *SynchronizedSet[int] {
+		return NewSynchronizedSet[int](treeset.New(func(a, b int) bool { return a < b }, elements...))
+	}},
+}
+
+func TestSynchronizedSet(t *testing.T) {
+
+	for _, backing := range synchronizedSets {
+		t.Run(backing.name, func(t *testing.T) { testSet(t, backing.new) })
+	}
+}
+
+func TestSynchronizedSetConcurrency(t *testing.T) {
+
+	set := NewSynchronizedSet[int](hashset.New[int]())
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				set.Add(i)
+				set.Contains(i)
+				if i%10 == g {
+					set.Remove(i)
+				}
+				set.ContainsAll(set)
+			}
+		}(g)
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, set.Len(), 100)
+
+	set.Update(func(set collections.Set[int]) {
+		set.Clear()
+		set.Add(-1)
+	})
+	assert.Equal(t, []int{-1}, set.ToSlice())
+}
@@ -8,17 +8,25 @@ import (
 )
 
 const (
-	IndexOutOfBoundsCode      = 1 //  Invalid indexing i.e indexing a buffere outside its range.
-	UnsupportedOperationCode  = 2 //  An operation that is not supported i.e mutating operation on an immutable data structure.
-	IndexBoundsOutOfRangeCode = 3 //  Misconfigured indexing i.e lower index being greater than upper index.
-	NoSuchElementCode         = 4 //  An absent element i.e next on iterator without has next guard.
+	IndexOutOfBoundsCode       = 1 //  Invalid indexing i.e indexing a buffere outside its range.
+	UnsupportedOperationCode   = 2 //  An operation that is not supported i.e mutating operation on an immutable data structure.
+	IndexBoundsOutOfRangeCode  = 3 //  Misconfigured indexing i.e lower index being greater than upper index.
+	NoSuchElementCode          = 4 //  An absent element i.e next on iterator without has next guard.
+	IllegalArgumentCode        = 5 //  An invalid argument i.e a non positive chunk size.
+	ConcurrentModificationCode = 6 //  A collection modified while it is being iterated over.
+	IllegalStateCode           = 7 //  An operation invoked at an inappropriate time i.e remove on an iterator before a call to next.
+	ClosedCode                 = 8 //  An operation that requires an open container i.e adding to a closed blocking queue.
 )
 
 var (
-	indexOutOfBoundsTemplate, _      = template.New("IndexOutOfBounds").Parse("ErrorIndexOutOfBounds: Index {{.index}} out of bounds for length {{.length}}.")
-	unsupportedOperationTemplate, _  = template.New("UnsupportedOperation").Parse("ErrorUnsupportedOperation: Unsupported operation {{.operation}} on [{{.type}}].")
-	indexBoundsOutOfRangeTemplate, _ = template.New("IndexBoundsOutOfRange").Parse("ErrorIndexBoundsOutOfRange: Index bounds [{{.start}}:{{.end}}] out of range.")
-	noSuchElementTemplate, _         = template.New("NoSuchElement").Parse("NoSuchElement: No such element to access.")
+	indexOutOfBoundsTemplate, _       = template.New("IndexOutOfBounds").Parse("ErrorIndexOutOfBounds: Index {{.index}} out of bounds for length {{.length}}.")
+	unsupportedOperationTemplate, _   = template.New("UnsupportedOperation").Parse("ErrorUnsupportedOperation: Unsupported operation {{.operation}} on [{{.type}}].")
+	indexBoundsOutOfRangeTemplate, _  = template.New("IndexBoundsOutOfRange").Parse("ErrorIndexBoundsOutOfRange: Index bounds [{{.start}}:{{.end}}] out of range.")
+	noSuchElementTemplate, _          = template.New("NoSuchElement").Parse("NoSuchElement: No such element to access.")
+	illegalArgumentTemplate, _        = template.New("IllegalArgument").Parse("ErrorIllegalArgument: Illegal argument {{.argument}} = {{.value}}.")
+	concurrentModificationTemplate, _ = template.New("ConcurrentModification").Parse("ErrorConcurrentModification: [{{.type}}] modified during iteration.")
+	illegalStateTemplate, _           = template.New("IllegalState").Parse("ErrorIllegalState: Illegal call to {{.operation}} on [{{.type}}].")
+	closedTemplate, _                 = template.New("Closed").Parse("ErrorClosed: [{{.type}}] is closed.")
 )
 
 // Error custom error type for collections.
@@ -32,6 +40,11 @@ func New(code int, err error) Error {
 	return Error{code: code, error: err}
 }
 
+// Code returns the code of the error.
+func (err Error) Code() int {
+	return err.code
+}
+
 // IndexOutOfBounds returns an error indicating that a container has been indexed outside of its bounds.
 func IndexOutOfBounds(index int, length int) Error {
 	var buffer bytes.Buffer
@@ -59,3 +72,33 @@ func NoSuchElement() Error {
 	noSuchElementTemplate.Execute(&buffer, nil)
 	return New(NoSuchElementCode, errors.New(buffer.String()))
 }
+
+// IllegalArgument returns an error indicating that a function has been given an invalid value for an argument.
+func IllegalArgument(argument string, value any) Error {
+	var buffer bytes.Buffer
+	illegalArgumentTemplate.Execute(&buffer, map[string]any{"argument": argument, "value": value})
+	return New(IllegalArgumentCode, errors.New(buffer.String()))
+}
+
+// ConcurrentModification returns an error indicating that a collection of the given type was structurally modified while an iterator
+// over it was in use.
+func ConcurrentModification(_type string) Error {
+	var buffer bytes.Buffer
+	concurrentModificationTemplate.Execute(&buffer, map[string]string{"type": _type})
+	return New(ConcurrentModificationCode, errors.New(buffer.String()))
+}
+
+// IllegalState returns an error indicating that a given operation was invoked on a given type at a time it is not permitted, i.e
+// removing an element through an iterator before calling Next.
+func IllegalState(operation string, _type string) Error {
+	var buffer bytes.Buffer
+	illegalStateTemplate.Execute(&buffer, map[string]string{"operation": operation, "type": _type})
+	return New(IllegalStateCode, errors.New(buffer.String()))
+}
+
+// Closed returns an error indicating that an operation was invoked on a container of the given type after it was closed.
+func Closed(_type string) Error {
+	var buffer bytes.Buffer
+	closedTemplate.Execute(&buffer, map[string]string{"type": _type})
+	return New(ClosedCode, errors.New(buffer.String()))
+}
@@ -3,12 +3,19 @@
 package function
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
 	"github.com/phantom820/collections/iterable"
 	"github.com/phantom820/collections/iterator"
 	"github.com/phantom820/collections/lists/forwardlist"
 	"github.com/phantom820/collections/lists/linkedlist"
 	"github.com/phantom820/collections/lists/vector"
 	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/maps/treemap"
+	"github.com/phantom820/collections/multimap"
 	"github.com/phantom820/collections/sets/hashset"
 	"github.com/phantom820/collections/sets/linkedhashset"
 	"github.com/phantom820/collections/sets/treeset"
@@ -19,64 +26,167 @@ import (
 // is materialized when it is converted to a specific collection.
 type View[T comparable] interface {
 	iterable.Iterable[T]
-	ForEach(f func(T))                                          // Performs the given action on each element in the view.
-	Filter(f func(T) bool) View[T]                              // Returns a view with all elements that satisfy the given predicate.
-	Reduce(f func(x, y T) T) optional.Optional[T]               // Reduces the elements of the view using the associative binary function and returns result as an option.
-	Map(f func(T) T) View[T]                                    // Returns the view obtained from applying the transformation function to every element of the view.
-	ToVector() *vector.Vector[T]                                // Materializes the view to a [Vector].
-	ToSlice() []T                                               // Materializes the view to a slice.
-	ToHashSet() *hashset.HashSet[T]                             // Materializes the view to a [HashSet].
-	ToLinkedHashSet() *linkedhashset.LinkedHashSet[T]           // Materializes the view to a [LinkedHashSet].
-	ToTreeSet(lessThan func(k1, k2 T) bool) *treeset.TreeSet[T] // Materializes the view to a [TreeSet].
-	ToLinkedList() *linkedlist.LinkedList[T]                    // Materializes the view to a [LinkedList].
-	ToForwardList() *forwardlist.ForwardList[T]                 // Materializes the view to a [ForwardList].
+	ForEach(f func(T))                                             // Performs the given action on each element in the view.
+	Filter(f func(T) bool) View[T]                                 // Returns a view with all elements that satisfy the given predicate.
+	Reduce(f func(x, y T) T) optional.Optional[T]                  // Reduces the elements of the view using the associative binary function and returns result as an option.
+	Map(f func(T) T) View[T]                                       // Returns the view obtained from applying the transformation function to every element of the view.
+	ToVector() *vector.Vector[T]                                   // Materializes the view to a [Vector].
+	ToSlice() []T                                                  // Materializes the view to a slice.
+	ToHashSet() *hashset.HashSet[T]                                // Materializes the view to a [HashSet].
+	ToLinkedHashSet() *linkedhashset.LinkedHashSet[T]              // Materializes the view to a [LinkedHashSet].
+	ToTreeSet(lessThan func(k1, k2 T) bool) *treeset.TreeSet[T]    // Materializes the view to a [TreeSet].
+	ToLinkedList() *linkedlist.LinkedList[T]                       // Materializes the view to a [LinkedList].
+	ToForwardList() *forwardlist.ForwardList[T]                    // Materializes the view to a [ForwardList].
+	Count() int                                                    // Returns the number of elements in the view.
+	AnyMatch(f func(T) bool) bool                                  // Returns true if any element of the view satisfies the given predicate.
+	AllMatch(f func(T) bool) bool                                  // Returns true if all the elements of the view satisfy the given predicate.
+	NoneMatch(f func(T) bool) bool                                 // Returns true if no element of the view satisfies the given predicate.
+	First() optional.Optional[T]                                   // Returns the first element of the view as an option.
+	Last() optional.Optional[T]                                    // Returns the last element of the view as an option.
+	Min(less func(a, b T) bool) optional.Optional[T]               // Returns the smallest element of the view according to the given less function as an option.
+	Max(less func(a, b T) bool) optional.Optional[T]               // Returns the largest element of the view according to the given less function as an option.
+	Partition(f func(T) bool) ([]T, []T)                           // Splits the view into the elements that satisfy the given predicate and those that do not.
+	Join(separator string) string                                  // Returns the string representations of the elements of the view joined by the given separator.
+	Collect(c collections.Collection[T]) collections.Collection[T] // Adds the elements of the view to the given collection and returns it.
+	Parallel(workers int) View[T]                                  // Returns a view whose stages are evaluated concurrently by at most the given number of workers.
+	Ordered() View[T]                                              // Returns a view that keeps encounter order when evaluated in parallel.
 }
 
 // view represents a proxy of a collection that is being transformed.
 type view[T comparable] struct {
-	iterator func() iterator.Iterator[T]
+	iterator   func() iterator.Iterator[T]        // Evaluates the view sequentially.
+	partitions func(n int) []iterator.Iterator[T] // Evaluates the view over at most n partitions of its source.
+	workers    int                                // The number of workers used to evaluate the view, the view is sequential if less than 2.
+	ordered    bool                               // Whether parallel evaluation of the view keeps encounter order.
+}
+
+// newView returns a sequential view over the given iterable. If the iterable is already a view it is returned as is.
+func newView[T comparable](source iterable.Iterable[T]) *view[T] {
+	if sourceView, ok := source.(*view[T]); ok {
+		return sourceView
+	}
+	return &view[T]{
+		iterator: source.Iterator,
+		partitions: func(n int) []iterator.Iterator[T] {
+			splittable, ok := source.(iterable.Splittable[T])
+			if !ok {
+				return []iterator.Iterator[T]{source.Iterator()}
+			}
+			spliterators := iterator.Split(splittable.Spliterator(), n)
+			partitions := make([]iterator.Iterator[T], len(spliterators))
+			for i := range spliterators {
+				partitions[i] = spliterators[i]
+			}
+			return partitions
+		},
+	}
+}
+
+// stage returns the view obtained from applying the transformation to the iterators of the source view. The transformation is applied
+// to each partition when the view is evaluated in parallel.
+func stage[T comparable, U comparable](source *view[T], transformation func(iterator.Iterator[T]) iterator.Iterator[U]) *view[U] {
+	return &view[U]{
+		iterator: func() iterator.Iterator[U] {
+			return transformation(source.iterator())
+		},
+		partitions: func(n int) []iterator.Iterator[U] {
+			partitions := source.partitions(n)
+			transformed := make([]iterator.Iterator[U], len(partitions))
+			for i := range partitions {
+				transformed[i] = transformation(partitions[i])
+			}
+			return transformed
+		},
+		workers: source.workers,
+		ordered: source.ordered,
+	}
+}
+
+// parallel returns true if the view is evaluated in parallel.
+func (view *view[T]) parallel() bool {
+	return view.workers > 1
 }
 
-// Iterator returns an iterator over the view elements.
+// Iterator returns an iterator over the view elements. If the view is parallel its stages are evaluated concurrently and the
+// iterator yields elements as partitions complete.
 func (view *view[T]) Iterator() iterator.Iterator[T] {
+	if view.parallel() {
+		partitions := view.partitions(view.workers)
+		return &parallelIterator[T]{
+			results:    evaluate(partitions, view.workers, iterator.ToSlice[T]),
+			partitions: len(partitions),
+			ordered:    view.ordered,
+			pending:    make(map[int][]T),
+		}
+	}
 	return view.iterator()
 }
 
+// Parallel returns a view whose stages are evaluated concurrently by at most the given number of workers. The source of the view
+// is partitioned without copying if it is [iterable.Splittable], otherwise the view is evaluated sequentially. Elements are
+// produced in no particular order unless [View.Ordered] is used.
+func (_view *view[T]) Parallel(workers int) View[T] {
+	if workers < 1 {
+		panic(errors.IllegalArgument("workers", workers))
+	}
+	return &view[T]{iterator: _view.iterator, partitions: _view.partitions, workers: workers, ordered: _view.ordered}
+}
+
+// Ordered returns a view that keeps the encounter order of elements when it is evaluated in parallel.
+func (_view *view[T]) Ordered() View[T] {
+	return &view[T]{iterator: _view.iterator, partitions: _view.partitions, workers: _view.workers, ordered: true}
+}
+
 // ForEach performs the given action for each element of the view.
 func (view *view[T]) ForEach(f func(T)) {
-	it := view.iterator()
+	it := view.Iterator()
 	for it.HasNext() {
 		f(it.Next())
 	}
 }
 
-// Map returns the view obtained from applying the transformation function to every element of the view.
+// Map returns the view obtained from applying the transformation function to every element of the view. Use the package level [Map]
+// with the view as its argument to transform elements to a different type.
 func (_view *view[T]) Map(f func(T) T) View[T] {
-	return &view[T]{
-		iterator: func() iterator.Iterator[T] {
-			return iterator.Map(_view.Iterator(), f)
-		},
-	}
+	return stage(_view, func(it iterator.Iterator[T]) iterator.Iterator[T] {
+		return iterator.Map(it, f)
+	})
 }
 
 // Filter returns a view with all elements that satisfy the given predicate.
 func (_view *view[T]) Filter(f func(T) bool) View[T] {
-	return &view[T]{
-		iterator: func() iterator.Iterator[T] {
-			return iterator.Filter(_view.Iterator(), f)
-		},
-	}
+	return stage(_view, func(it iterator.Iterator[T]) iterator.Iterator[T] {
+		return iterator.Filter(it, f)
+	})
 }
 
-// Reduce reduces the elements of the view using the associative binary function and returns result as an option.
+// Reduce reduces the elements of the view using the associative binary function and returns result as an option. If the view
+// is parallel each partition is reduced concurrently and the partial results are merged in encounter order.
 func (_view *view[T]) Reduce(f func(T, T) T) optional.Optional[T] {
-	return iterator.Reduce(_view.iterator(), f)
+	if !_view.parallel() {
+		return iterator.Reduce(_view.iterator(), f)
+	}
+	partitions := _view.partitions(_view.workers)
+	partials := gather(evaluate(partitions, _view.workers, func(it iterator.Iterator[T]) optional.Optional[T] {
+		return iterator.Reduce(it, f)
+	}), len(partitions))
+	result := optional.Empty[T]()
+	for _, partial := range partials {
+		if partial.Empty() {
+			continue
+		} else if result.Empty() {
+			result = partial
+		} else {
+			result = optional.Of(f(result.Value(), partial.Value()))
+		}
+	}
+	return result
 }
 
 // ToVector materializes the view to a [Vector].
 func (view *view[T]) ToVector() *vector.Vector[T] {
 	vector := vector.New[T]()
-	it := view.iterator()
+	it := view.Iterator()
 	for it.HasNext() {
 		vector.Add(it.Next())
 	}
@@ -85,7 +195,7 @@ func (view *view[T]) ToVector() *vector.Vector[T] {
 
 // ToSlice materializes the view to a slice.
 func (view *view[T]) ToSlice() []T {
-	it := view.iterator()
+	it := view.Iterator()
 	slice := make([]T, 0)
 	for it.HasNext() {
 		slice = append(slice, it.Next())
@@ -95,7 +205,7 @@ func (view *view[T]) ToSlice() []T {
 
 // ToLinkedList materializes the view to a [LinkedList].
 func (view *view[T]) ToLinkedList() *linkedlist.LinkedList[T] {
-	it := view.iterator()
+	it := view.Iterator()
 	list := linkedlist.New[T]()
 	for it.HasNext() {
 		list.Add(it.Next())
@@ -105,7 +215,7 @@ func (view *view[T]) ToLinkedList() *linkedlist.LinkedList[T] {
 
 // ToForwardList materializes the view to a [ForwardList].
 func (view *view[T]) ToForwardList() *forwardlist.ForwardList[T] {
-	it := view.iterator()
+	it := view.Iterator()
 	list := forwardlist.New[T]()
 	for it.HasNext() {
 		list.Add(it.Next())
@@ -115,7 +225,7 @@ func (view *view[T]) ToForwardList() *forwardlist.ForwardList[T] {
 
 // ToHashSet materializes the view to a [HashSet].
 func (view *view[T]) ToHashSet() *hashset.HashSet[T] {
-	it := view.iterator()
+	it := view.Iterator()
 	set := hashset.New[T]()
 	for it.HasNext() {
 		set.Add(it.Next())
@@ -125,7 +235,7 @@ func (view *view[T]) ToHashSet() *hashset.HashSet[T] {
 
 // ToHashSet materializes the view to a [LinkedHashSet].
 func (view *view[T]) ToLinkedHashSet() *linkedhashset.LinkedHashSet[T] {
-	it := view.iterator()
+	it := view.Iterator()
 	set := linkedhashset.New[T]()
 	for it.HasNext() {
 		set.Add(it.Next())
@@ -135,7 +245,7 @@ func (view *view[T]) ToLinkedHashSet() *linkedhashset.LinkedHashSet[T] {
 
 // ToTreeSet materializes the view to a [TreeSet].
 func (view *view[T]) ToTreeSet(lessThan func(k1, k2 T) bool) *treeset.TreeSet[T] {
-	it := view.iterator()
+	it := view.Iterator()
 	set := treeset.New(lessThan)
 	for it.HasNext() {
 		set.Add(it.Next())
@@ -143,31 +253,123 @@ func (view *view[T]) ToTreeSet(lessThan func(k1, k2 T) bool) *treeset.TreeSet[T]
 	return set
 }
 
+// Count returns the number of elements in the view.
+func (view *view[T]) Count() int {
+	count := 0
+	it := view.Iterator()
+	for it.HasNext() {
+		it.Next()
+		count++
+	}
+	return count
+}
+
+// AnyMatch returns true if any element of the view satisfies the given predicate. Evaluation stops at the first matching element.
+func (view *view[T]) AnyMatch(f func(T) bool) bool {
+	it := view.Iterator()
+	for it.HasNext() {
+		if f(it.Next()) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllMatch returns true if all the elements of the view satisfy the given predicate, an empty view always yields true. Evaluation
+// stops at the first element that does not match.
+func (view *view[T]) AllMatch(f func(T) bool) bool {
+	return !view.AnyMatch(func(e T) bool { return !f(e) })
+}
+
+// NoneMatch returns true if no element of the view satisfies the given predicate.
+func (view *view[T]) NoneMatch(f func(T) bool) bool {
+	return !view.AnyMatch(f)
+}
+
+// First returns the first element of the view as an option.
+func (view *view[T]) First() optional.Optional[T] {
+	it := view.Iterator()
+	if !it.HasNext() {
+		return optional.Empty[T]()
+	}
+	return optional.Of(it.Next())
+}
+
+// Last returns the last element of the view as an option.
+func (view *view[T]) Last() optional.Optional[T] {
+	return view.Reduce(func(_, y T) T { return y })
+}
+
+// Min returns the smallest element of the view according to the given less function as an option.
+func (view *view[T]) Min(less func(a, b T) bool) optional.Optional[T] {
+	return view.Reduce(func(x, y T) T {
+		if less(y, x) {
+			return y
+		}
+		return x
+	})
+}
+
+// Max returns the largest element of the view according to the given less function as an option.
+func (view *view[T]) Max(less func(a, b T) bool) optional.Optional[T] {
+	return view.Reduce(func(x, y T) T {
+		if less(x, y) {
+			return y
+		}
+		return x
+	})
+}
+
+// Partition splits the view into the elements that satisfy the given predicate and those that do not, encounter order is kept.
+func (view *view[T]) Partition(f func(T) bool) ([]T, []T) {
+	matched, unmatched := make([]T, 0), make([]T, 0)
+	it := view.Iterator()
+	for it.HasNext() {
+		e := it.Next()
+		if f(e) {
+			matched = append(matched, e)
+		} else {
+			unmatched = append(unmatched, e)
+		}
+	}
+	return matched, unmatched
+}
+
+// Join returns the string representations of the elements of the view joined by the given separator.
+func (view *view[T]) Join(separator string) string {
+	var sb strings.Builder
+	it := view.Iterator()
+	for i := 0; it.HasNext(); i++ {
+		if i > 0 {
+			sb.WriteString(separator)
+		}
+		sb.WriteString(fmt.Sprint(it.Next()))
+	}
+	return sb.String()
+}
+
+// Collect adds the elements of the view to the given collection and returns the collection.
+func (view *view[T]) Collect(c collections.Collection[T]) collections.Collection[T] {
+	return Collect(view, c)
+}
+
 // Filter returns a view with all elements that satisfy the given predicate.
 func Filter[T comparable](iterable iterable.Iterable[T], f func(T) bool) View[T] {
-	return &view[T]{
-		iterator: func() iterator.Iterator[T] {
-			return iterator.Filter(iterable.Iterator(), f)
-		},
-	}
+	return stage(newView(iterable), func(it iterator.Iterator[T]) iterator.Iterator[T] {
+		return iterator.Filter(it, f)
+	})
 }
 
 // Identity returns a view that is identical to the given iterable.
 func Identity[T comparable](iterable iterable.Iterable[T]) View[T] {
-	return &view[T]{
-		iterator: func() iterator.Iterator[T] {
-			return iterable.Iterator()
-		},
-	}
+	return newView(iterable)
 }
 
 // Map returns a view obtained from applying the transformation function to every element on the given iterable.
 func Map[T comparable, U comparable](iterable iterable.Iterable[T], f func(T) U) View[U] {
-	return &view[U]{
-		iterator: func() iterator.Iterator[U] {
-			return iterator.Map(iterable.Iterator(), f)
-		},
-	}
+	return stage(newView(iterable), func(it iterator.Iterator[T]) iterator.Iterator[U] {
+		return iterator.Map(it, f)
+	})
 }
 
 // Reduce reduces the elements of the iterable using the associative binary function and returns result as an option.
@@ -175,9 +377,27 @@ func Reduce[T comparable](iterable iterable.Iterable[T], f func(x, y T) T) optio
 	return iterator.Reduce(iterable.Iterator(), f)
 }
 
-// GroupBy returns a grouping of elements from the iterable using the given discriminator function.
+// GroupBy returns a grouping of elements from the iterable using the given discriminator function. If the iterable is a parallel
+// view each partition is grouped concurrently and the partial groupings are merged so that each group keeps encounter order.
 func GroupBy[T comparable, U comparable](iterable iterable.Iterable[T], f func(T) U) hashmap.HashMap[U, []T] {
-	it := iterable.Iterator()
+	if source, ok := iterable.(*view[T]); ok && source.parallel() {
+		partitions := source.partitions(source.workers)
+		partials := gather(evaluate(partitions, source.workers, func(it iterator.Iterator[T]) hashmap.HashMap[U, []T] {
+			return groupBy(it, f)
+		}), len(partitions))
+		groups := hashmap.New[U, []T]()
+		for _, partial := range partials {
+			for key, group := range partial {
+				groups[key] = append(groups[key], group...)
+			}
+		}
+		return groups
+	}
+	return groupBy(iterable.Iterator(), f)
+}
+
+// groupBy groups the elements of the iterator using the given discriminator function.
+func groupBy[T comparable, U comparable](it iterator.Iterator[T], f func(T) U) hashmap.HashMap[U, []T] {
 	groups := hashmap.New[U, []T]()
 	for it.HasNext() {
 		element := it.Next()
@@ -192,3 +412,73 @@ func GroupBy[T comparable, U comparable](iterable iterable.Iterable[T], f func(T
 	}
 	return groups
 }
+
+// GroupByInto adds an entry f(e), e to the given multimap for each element e of the iterable in encounter order and returns the
+// multimap, the kind of multimap decides how keys and the elements of each group are ordered and whether duplicates are kept.
+func GroupByInto[T comparable, U comparable, M multimap.Multimap[U, T]](iterable iterable.Iterable[T], f func(T) U, groups M) M {
+	it := iterable.Iterator()
+	for it.HasNext() {
+		element := it.Next()
+		groups.Put(f(element), element)
+	}
+	return groups
+}
+
+// Number a constraint for types that support addition.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr | ~float32 | ~float64
+}
+
+// Sum returns the sum of the elements of the iterable, an empty iterable yields zero.
+func Sum[T Number](iterable iterable.Iterable[T]) T {
+	var sum T
+	it := iterable.Iterator()
+	for it.HasNext() {
+		sum += it.Next()
+	}
+	return sum
+}
+
+// Fold accumulates the elements of the iterable into a value of a possibly different type. The accumulation starts from the
+// initial value and applies f to the accumulated value and each element in turn.
+func Fold[T any, A any](iterable iterable.Iterable[T], initial A, f func(A, T) A) A {
+	accumulator := initial
+	it := iterable.Iterator()
+	for it.HasNext() {
+		accumulator = f(accumulator, it.Next())
+	}
+	return accumulator
+}
+
+// Collect adds the elements of the iterable to the given collection and returns the collection.
+func Collect[T comparable, C collections.Collection[T]](iterable iterable.Iterable[T], c C) C {
+	it := iterable.Iterator()
+	for it.HasNext() {
+		c.Add(it.Next())
+	}
+	return c
+}
+
+// ToHashMap returns a [HashMap] with a key, value mapping derived from each element of the iterable. If multiple elements
+// map to the same key the value of the last element is kept.
+func ToHashMap[T any, K comparable, V any](iterable iterable.Iterable[T], key func(T) K, value func(T) V) hashmap.HashMap[K, V] {
+	hashMap := hashmap.New[K, V]()
+	it := iterable.Iterator()
+	for it.HasNext() {
+		e := it.Next()
+		hashMap.Put(key(e), value(e))
+	}
+	return hashMap
+}
+
+// ToTreeMap returns a [TreeMap] with a key, value mapping derived from each element of the iterable. Keys are ordered using the
+// lessThan function. If multiple elements map to the same key the value of the last element is kept.
+func ToTreeMap[T any, K comparable, V any](iterable iterable.Iterable[T], lessThan func(k1, k2 K) bool, key func(T) K, value func(T) V) *treemap.TreeMap[K, V] {
+	treeMap := treemap.New[K, V](lessThan)
+	it := iterable.Iterator()
+	for it.HasNext() {
+		e := it.Next()
+		treeMap.Put(key(e), value(e))
+	}
+	return treeMap
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/phantom820/collections/lists/linkedlist"
 	"github.com/phantom820/collections/lists/vector"
 	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/multimap"
 	"github.com/phantom820/collections/sets/hashset"
 	"github.com/phantom820/collections/sets/linkedhashset"
 	"github.com/phantom820/collections/types/optional"
@@ -237,3 +238,126 @@ func TestGroupBy(t *testing.T) {
 	}
 
 }
+
+func TestGroupByInto(t *testing.T) {
+
+	length := func(s string) int { return len(s) }
+
+	groups := GroupByInto(vector.Of("a", "bb", "c", "dd", "a"), length, multimap.NewLinkedListMultimap[int, string]())
+	assert.Equal(t, "{1=[a c a], 2=[bb dd]}", groups.String())
+	assert.Equal(t, 5, groups.Len())
+
+	distinct := GroupByInto(vector.Of("a", "bb", "c", "dd", "a"), length, multimap.NewLinkedSetMultimap[int, string]())
+	assert.Equal(t, "{1={a, c}, 2={bb, dd}}", distinct.String())
+	assert.Equal(t, 4, distinct.Len())
+
+	assert.True(t, GroupByInto(iterable.Of[string](), length, multimap.NewHashSetMultimap[int, string]()).Empty())
+}
+
+func TestTypeChangingMap(t *testing.T) {
+
+	view := Map(Filter(vector.New(1, 2, 3, 4, 5), func(i int) bool { return i%2 == 1 }), func(i int) string { return fmt.Sprint(i) })
+	assert.Equal(t, "1-3-5", view.Join("-"))
+}
+
+func TestCount(t *testing.T) {
+
+	assert.Equal(t, 0, Identity(vector.New[int]()).Count())
+	assert.Equal(t, 2, Filter(vector.New(1, 2, 3, 4, 5), func(i int) bool { return i%2 == 0 }).Count())
+}
+
+func TestMatch(t *testing.T) {
+
+	even := func(i int) bool { return i%2 == 0 }
+
+	type matchTest struct {
+		input     View[int]
+		anyMatch  bool
+		allMatch  bool
+		noneMatch bool
+	}
+
+	matchTests := []matchTest{
+		{input: Identity(vector.New[int]()), anyMatch: false, allMatch: true, noneMatch: true},
+		{input: Identity(vector.New(1, 3)), anyMatch: false, allMatch: false, noneMatch: true},
+		{input: Identity(vector.New(1, 2)), anyMatch: true, allMatch: false, noneMatch: false},
+		{input: Identity(vector.New(2, 4)), anyMatch: true, allMatch: true, noneMatch: false},
+	}
+
+	for _, test := range matchTests {
+		assert.Equal(t, test.anyMatch, test.input.AnyMatch(even))
+		assert.Equal(t, test.allMatch, test.input.AllMatch(even))
+		assert.Equal(t, test.noneMatch, test.input.NoneMatch(even))
+	}
+}
+
+func TestFirstAndLast(t *testing.T) {
+
+	assert.Equal(t, optional.Empty[int](), Identity(vector.New[int]()).First())
+	assert.Equal(t, optional.Empty[int](), Identity(vector.New[int]()).Last())
+	assert.Equal(t, optional.Of(1), Identity(vector.New(1, 2, 3)).First())
+	assert.Equal(t, optional.Of(3), Identity(vector.New(1, 2, 3)).Last())
+}
+
+func TestMinAndMax(t *testing.T) {
+
+	less := func(a, b int) bool { return a < b }
+	assert.Equal(t, optional.Empty[int](), Identity(vector.New[int]()).Min(less))
+	assert.Equal(t, optional.Empty[int](), Identity(vector.New[int]()).Max(less))
+	assert.Equal(t, optional.Of(1), Identity(vector.New(3, 1, 4, 1, 5)).Min(less))
+	assert.Equal(t, optional.Of(5), Identity(vector.New(3, 1, 4, 1, 5)).Max(less))
+}
+
+func TestPartition(t *testing.T) {
+
+	matched, unmatched := Identity(vector.New[int]()).Partition(func(i int) bool { return i%2 == 0 })
+	assert.Equal(t, []int{}, matched)
+	assert.Equal(t, []int{}, unmatched)
+
+	matched, unmatched = Identity(vector.New(1, 2, 3, 4, 5)).Partition(func(i int) bool { return i%2 == 0 })
+	assert.Equal(t, []int{2, 4}, matched)
+	assert.Equal(t, []int{1, 3, 5}, unmatched)
+}
+
+func TestJoin(t *testing.T) {
+
+	assert.Equal(t, "", Identity(vector.New[int]()).Join(", "))
+	assert.Equal(t, "1", Identity(vector.New(1)).Join(", "))
+	assert.Equal(t, "1, 2, 3", Identity(vector.New(1, 2, 3)).Join(", "))
+}
+
+func TestCollect(t *testing.T) {
+
+	set := Collect(Identity(vector.New(1, 2, 2, 3)), hashset.New[int]())
+	assert.True(t, set.Equals(hashset.New(1, 2, 3)))
+
+	list := Identity(vector.New(1, 2, 3)).Collect(linkedlist.New(0))
+	assert.Equal(t, []int{0, 1, 2, 3}, list.ToSlice())
+}
+
+func TestSum(t *testing.T) {
+
+	assert.Equal(t, 0, Sum(vector.New[int]()))
+	assert.Equal(t, 15, Sum(vector.New(1, 2, 3, 4, 5)))
+	assert.Equal(t, 4.5, Sum[float64](Map(vector.New(1, 2, 3), func(i int) float64 { return float64(i) * 0.75 })))
+}
+
+func TestFold(t *testing.T) {
+
+	assert.Equal(t, "", Fold(vector.New[int](), "", func(acc string, i int) string { return acc + fmt.Sprint(i) }))
+	assert.Equal(t, "123", Fold(vector.New(1, 2, 3), "", func(acc string, i int) string { return acc + fmt.Sprint(i) }))
+	assert.Equal(t, 6, Fold(vector.New("a", "bb", "ccc"), 0, func(acc int, s string) int { return acc + len(s) }))
+}
+
+func TestToHashMap(t *testing.T) {
+
+	hashMap := ToHashMap(vector.New("a", "bb", "cc"), func(s string) int { return len(s) }, func(s string) string { return s })
+	assert.Equal(t, hashmap.HashMap[int, string]{1: "a", 2: "cc"}, hashMap)
+}
+
+func TestToTreeMap(t *testing.T) {
+
+	treeMap := ToTreeMap(vector.New("ccc", "a", "bb"), func(k1, k2 int) bool { return k1 < k2 }, func(s string) int { return len(s) }, func(s string) string { return s })
+	assert.Equal(t, []int{1, 2, 3}, treeMap.Keys())
+	assert.Equal(t, []string{"a", "bb", "ccc"}, treeMap.Values())
+}
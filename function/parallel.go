@@ -0,0 +1,100 @@
+package function
+
+import (
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+)
+
+// result the outcome of evaluating a single partition of a parallel view.
+type result[R any] struct {
+	index int // The index of the partition in encounter order.
+	value R   // The value computed for the partition.
+	panic any // The value recovered if evaluating the partition panicked.
+}
+
+// evaluate applies f to each partition on a pool of at most the given number of workers. Results are delivered as partitions complete,
+// the results channel is buffered so that workers never block when the caller stops consuming early.
+func evaluate[T any, R any](partitions []iterator.Iterator[T], workers int, f func(iterator.Iterator[T]) R) <-chan result[R] {
+	results := make(chan result[R], len(partitions))
+	indices := make(chan int, len(partitions))
+	for i := range partitions {
+		indices <- i
+	}
+	close(indices)
+	for w := 0; w < min(workers, len(partitions)); w++ {
+		go func() {
+			for i := range indices {
+				results <- evaluatePartition(i, partitions[i], f)
+			}
+		}()
+	}
+	return results
+}
+
+// evaluatePartition applies f to the partition, recovering from any panic so that it can be raised on the caller's goroutine.
+func evaluatePartition[T any, R any](index int, partition iterator.Iterator[T], f func(iterator.Iterator[T]) R) (r result[R]) {
+	defer func() {
+		if p := recover(); p != nil {
+			r = result[R]{index: index, panic: p}
+		}
+	}()
+	return result[R]{index: index, value: f(partition)}
+}
+
+// gather waits for the results of n partitions and returns them in encounter order.
+func gather[R any](results <-chan result[R], n int) []R {
+	values := make([]R, n)
+	for i := 0; i < n; i++ {
+		r := <-results
+		if r.panic != nil {
+			panic(r.panic)
+		}
+		values[r.index] = r.value
+	}
+	return values
+}
+
+// parallelIterator iterator over the elements of partitions that are evaluated in parallel.
+type parallelIterator[T any] struct {
+	results    <-chan result[[]T]
+	partitions int         // The number of partitions being evaluated.
+	received   int         // The number of partition results received so far.
+	ordered    bool        // Whether elements are yielded in encounter order.
+	pending    map[int][]T // Results of partitions that completed out of order.
+	next       int         // The index of the next partition to yield when ordered.
+	chunk      []T         // The remaining elements of the current partition.
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *parallelIterator[T]) HasNext() bool {
+	for len(it.chunk) == 0 {
+		if chunk, ok := it.pending[it.next]; it.ordered && ok {
+			delete(it.pending, it.next)
+			it.chunk = chunk
+			it.next++
+			continue
+		} else if it.received == it.partitions {
+			return false
+		}
+		r := <-it.results
+		it.received++
+		if r.panic != nil {
+			panic(r.panic)
+		} else if it.ordered {
+			it.pending[r.index] = r.value
+		} else {
+			it.chunk = r.value
+		}
+	}
+	return true
+}
+
+// Next returns the next element in the iterator.
+func (it *parallelIterator[T]) Next() T {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	}
+	element := it.chunk[0]
+	it.chunk = it.chunk[1:]
+	return element
+}
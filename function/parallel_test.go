@@ -0,0 +1,100 @@
+package function
+
+import (
+	"testing"
+
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/lists/linkedlist"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/stretchr/testify/assert"
+)
+
+func naturals(n int) *vector.Vector[int] {
+	list := vector.New[int]()
+	for i := 1; i <= n; i++ {
+		list.Add(i)
+	}
+	return list
+}
+
+func TestParallel(t *testing.T) {
+
+	type parallelTest struct {
+		input   iterable.Iterable[int]
+		workers int
+	}
+
+	parallelTests := []parallelTest{
+		{input: vector.New[int](), workers: 4},
+		{input: vector.New(1), workers: 4},
+		{input: naturals(1000), workers: 1},
+		{input: naturals(1000), workers: 3},
+		{input: naturals(1000), workers: 8},
+		{input: naturals(1000).ImmutableCopy(), workers: 4},
+		{input: hashset.New(naturals(1000).ToSlice()...), workers: 4},
+		{input: linkedlist.New(naturals(1000).ToSlice()...), workers: 4},
+	}
+
+	even := func(i int) bool { return i%2 == 0 }
+	square := func(i int) int { return i * i }
+
+	for _, test := range parallelTests {
+		expected := Filter(test.input, even).Map(square).ToSlice()
+		assert.ElementsMatch(t, expected, Filter(test.input, even).Map(square).Parallel(test.workers).ToSlice())
+		assert.ElementsMatch(t, expected, Filter(Identity(test.input).Parallel(test.workers), even).Map(square).ToSlice())
+		assert.Equal(t, len(expected), Filter(test.input, even).Map(square).Parallel(test.workers).Count())
+	}
+}
+
+func TestParallelOrdered(t *testing.T) {
+
+	even := func(i int) bool { return i%2 == 0 }
+	expected := Filter(naturals(1000), even).ToSlice()
+
+	assert.Equal(t, expected, Filter(naturals(1000), even).Parallel(4).Ordered().ToSlice())
+	assert.Equal(t, expected, Filter(Identity(naturals(1000)).Ordered().Parallel(4), even).ToVector().ToSlice())
+	assert.Equal(t, []string{"2", "4", "6"}, Map(Filter(naturals(7), even).Parallel(3).Ordered(), func(i int) string {
+		return string(rune('0' + i))
+	}).ToSlice())
+	assert.Equal(t, 2, Filter(naturals(1000), even).Parallel(4).Ordered().First().Value())
+}
+
+func TestParallelReduce(t *testing.T) {
+
+	sum := func(x, y int) int { return x + y }
+	assert.True(t, Identity(vector.New[int]()).Parallel(4).Reduce(sum).Empty())
+	assert.Equal(t, 500500, Identity(naturals(1000)).Parallel(4).Reduce(sum).Value())
+
+	// the merge of partial results keeps encounter order for associative but non commutative functions.
+	concat := func(x, y string) string { return x + y }
+	letters := vector.New("a", "b", "c", "d", "e", "f", "g", "h", "i", "j")
+	assert.Equal(t, "abcdefghij", Identity(letters).Parallel(4).Reduce(concat).Value())
+}
+
+func TestParallelGroupBy(t *testing.T) {
+
+	discriminator := func(i int) string {
+		if i%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	expected := GroupBy(naturals(1000), discriminator)
+	assert.Equal(t, expected, GroupBy(Identity(naturals(1000)).Parallel(4), discriminator))
+	assert.Equal(t, expected, GroupBy(Identity(naturals(1000)).Parallel(4).Ordered(), discriminator))
+}
+
+func TestParallelPanics(t *testing.T) {
+
+	assert.Panics(t, func() { Identity(naturals(10)).Parallel(0) })
+	assert.Panics(t, func() {
+		Identity(naturals(100)).Parallel(4).Map(func(i int) int {
+			if i == 50 {
+				panic("failed")
+			}
+			return i
+		}).ToSlice()
+	})
+}
@@ -0,0 +1,137 @@
+// package hashing defines the default hash function used by the hash based containers that can not rely on the built in map, such as
+// the segments of a ConcurrentHashMap and the trie of a PersistentHashMap.
+package hashing
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+var (
+	seed = maphash.MakeSeed()
+)
+
+// Hash a general purpose hash function for comparable keys, equal keys have equal hashes within a process but hashes differ between
+// processes. Strings, booleans and numbers are hashed directly, other keys are hashed the way the == operator compares them, structs and
+// arrays element by element, pointers and channels by identity (not by what they point to) and interfaces by their dynamic type and
+// value. This walks the key through reflection, supply a custom hash for such keys when performance matters.
+func Hash[K comparable](key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return maphash.String(seed, k)
+	case int:
+		return mix(uint64(k))
+	case int8:
+		return mix(uint64(k))
+	case int16:
+		return mix(uint64(k))
+	case int32:
+		return mix(uint64(k))
+	case int64:
+		return mix(uint64(k))
+	case uint:
+		return mix(uint64(k))
+	case uint8:
+		return mix(uint64(k))
+	case uint16:
+		return mix(uint64(k))
+	case uint32:
+		return mix(uint64(k))
+	case uint64:
+		return mix(k)
+	case uintptr:
+		return mix(uint64(k))
+	case bool:
+		if k {
+			return 1
+		}
+		return 0
+	case float32:
+		return hashFloat(float64(k))
+	case float64:
+		return hashFloat(k)
+	}
+	var h maphash.Hash
+	h.SetSeed(seed)
+	write(&h, reflect.ValueOf(&key).Elem())
+	return h.Sum64()
+}
+
+// write writes the value to the hash so that values that are equal according to == write the same bytes.
+func write(h *maphash.Hash, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			h.WriteByte(1)
+		} else {
+			h.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(h, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(h, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(h, v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeFloat(h, real(v.Complex()))
+		writeFloat(h, imag(v.Complex()))
+	case reflect.String:
+		writeUint64(h, uint64(v.Len()))
+		h.WriteString(v.String())
+	case reflect.Pointer, reflect.UnsafePointer, reflect.Chan:
+		writeUint64(h, uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			write(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).Name != "_" { // Blank fields are ignored by ==.
+				write(h, v.Field(i))
+			}
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			h.WriteByte(0)
+			return
+		}
+		h.WriteByte(1)
+		h.WriteString(v.Elem().Type().String())
+		write(h, v.Elem())
+	}
+}
+
+// writeUint64 writes the bytes of an integer to the hash.
+func writeUint64(h *maphash.Hash, x uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], x)
+	h.Write(b[:])
+}
+
+// writeFloat writes a float to the hash so that 0 and -0, which are equal, write the same bytes.
+func writeFloat(h *maphash.Hash, f float64) {
+	if f == 0 {
+		f = 0
+	}
+	writeUint64(h, math.Float64bits(f))
+}
+
+// hashFloat hashes a float so that 0 and -0, which are equal, hash the same.
+func hashFloat(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+	return mix(math.Float64bits(f))
+}
+
+// mix scrambles the bits of an integer so that sequential keys spread across buckets (the finalizer of splitmix64).
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
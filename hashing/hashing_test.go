@@ -0,0 +1,54 @@
+package hashing
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHash(t *testing.T) {
+
+	type point struct{ x, y int }
+
+	assert.Equal(t, Hash("a"), Hash("a"))
+	assert.NotEqual(t, Hash(1), Hash(2))
+	assert.Equal(t, Hash(0.0), Hash(math.Copysign(0, -1)))
+	assert.Equal(t, Hash(point{1, 2}), Hash(point{1, 2}))
+	assert.NotEqual(t, Hash(point{1, 2}), Hash(point{2, 1}))
+	assert.NotEqual(t, Hash(true), Hash(false))
+}
+
+func TestHashComposite(t *testing.T) {
+
+	type node struct {
+		value int
+		next  *node
+	}
+	type measure struct {
+		name  string
+		value float64
+	}
+	type tagged struct {
+		tag   any
+		parts [2]string
+	}
+
+	a, b := &node{value: 1}, &node{value: 1}
+	hash := Hash(a)
+	a.value, a.next = 2, b
+	assert.Equal(t, hash, Hash(a))
+	assert.Equal(t, Hash(node{value: 1, next: a}), Hash(node{value: 1, next: a}))
+	assert.NotEqual(t, Hash(node{value: 1, next: a}), Hash(node{value: 1, next: b}))
+
+	assert.Equal(t, Hash(measure{"x", 0.0}), Hash(measure{"x", math.Copysign(0, -1)}))
+	assert.Equal(t, Hash([2]float64{0, 1}), Hash([2]float64{math.Copysign(0, -1), 1}))
+	assert.Equal(t, Hash(complex(0, 1)), Hash(complex(math.Copysign(0, -1), 1)))
+	assert.NotEqual(t, Hash(measure{"x", 1}), Hash(measure{"y", 1}))
+
+	assert.Equal(t, Hash(tagged{tag: 1, parts: [2]string{"a", "b"}}), Hash(tagged{tag: 1, parts: [2]string{"a", "b"}}))
+	assert.NotEqual(t, Hash(tagged{tag: 1}), Hash(tagged{tag: int64(1)}))
+	assert.NotEqual(t, Hash(tagged{tag: nil}), Hash(tagged{tag: 0}))
+	assert.NotEqual(t, Hash(tagged{parts: [2]string{"ab", ""}}), Hash(tagged{parts: [2]string{"a", "b"}}))
+	assert.Equal(t, Hash[any](a), Hash[any](a))
+}
@@ -11,6 +11,12 @@ type Iterable[T any] interface {
 	Iterator() iterator.Iterator[T] // Returns a new iterator over all elements contained in the iterable.
 }
 
+// Splittable an iterable whose elements can be partitioned without copying so that they can be processed in parallel.
+type Splittable[T any] interface {
+	Iterable[T]
+	Spliterator() iterator.Spliterator[T] // Returns a new spliterator over all elements contained in the iterable.
+}
+
 // Of returns an iterable of the given elements.
 func Of[T any](elements ...T) Iterable[T] {
 	return &iterable[T]{iterator: iterator.Of(elements...)}
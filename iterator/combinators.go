@@ -0,0 +1,211 @@
+package iterator
+
+import (
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// Map returns an iterator that applies the transformation function to each element of the given iterator as it is accessed.
+func Map[T, U any](it Iterator[T], f func(T) U) Iterator[U] {
+	return &lazyIterator[U]{advance: func() (U, bool) {
+		if !it.HasNext() {
+			var zero U
+			return zero, false
+		}
+		return f(it.Next()), true
+	}}
+}
+
+// Filter returns an iterator over the elements of the given iterator that satisfy the given predicate.
+func Filter[T any](it Iterator[T], f func(T) bool) Iterator[T] {
+	return &lazyIterator[T]{advance: func() (T, bool) {
+		for it.HasNext() {
+			if element := it.Next(); f(element) {
+				return element, true
+			}
+		}
+		var zero T
+		return zero, false
+	}}
+}
+
+// Take returns an iterator over at most the first n elements of the given iterator.
+func Take[T any](it Iterator[T], n int) Iterator[T] {
+	taken := 0
+	return &lazyIterator[T]{advance: func() (T, bool) {
+		if taken >= n || !it.HasNext() {
+			var zero T
+			return zero, false
+		}
+		taken++
+		return it.Next(), true
+	}}
+}
+
+// Skip returns an iterator over the elements of the given iterator after discarding the first n elements.
+func Skip[T any](it Iterator[T], n int) Iterator[T] {
+	skipped := false
+	return &lazyIterator[T]{advance: func() (T, bool) {
+		if !skipped {
+			skipped = true
+			for i := 0; i < n && it.HasNext(); i++ {
+				it.Next()
+			}
+		}
+		if !it.HasNext() {
+			var zero T
+			return zero, false
+		}
+		return it.Next(), true
+	}}
+}
+
+// TakeWhile returns an iterator over the longest prefix of elements of the given iterator that satisfy the given predicate.
+func TakeWhile[T any](it Iterator[T], f func(T) bool) Iterator[T] {
+	return &lazyIterator[T]{advance: func() (T, bool) {
+		if it.HasNext() {
+			if element := it.Next(); f(element) {
+				return element, true
+			}
+		}
+		var zero T
+		return zero, false
+	}}
+}
+
+// DropWhile returns an iterator over the elements of the given iterator that remain after dropping the longest prefix of
+// elements that satisfy the given predicate.
+func DropWhile[T any](it Iterator[T], f func(T) bool) Iterator[T] {
+	dropped := false
+	return &lazyIterator[T]{advance: func() (T, bool) {
+		for it.HasNext() {
+			element := it.Next()
+			if dropped || !f(element) {
+				dropped = true
+				return element, true
+			}
+		}
+		var zero T
+		return zero, false
+	}}
+}
+
+// Zip returns an iterator over pairs of corresponding elements from the given iterators. The iterator stops once either of
+// the given iterators has no more elements.
+func Zip[T, U any](a Iterator[T], b Iterator[U]) Iterator[pair.Pair[T, U]] {
+	return &lazyIterator[pair.Pair[T, U]]{advance: func() (pair.Pair[T, U], bool) {
+		if !a.HasNext() || !b.HasNext() {
+			return pair.Pair[T, U]{}, false
+		}
+		return pair.Of(a.Next(), b.Next()), true
+	}}
+}
+
+// Chain returns an iterator over the elements of each of the given iterators one after the other.
+func Chain[T any](iterators ...Iterator[T]) Iterator[T] {
+	i := 0
+	return &lazyIterator[T]{advance: func() (T, bool) {
+		for ; i < len(iterators); i++ {
+			if iterators[i].HasNext() {
+				return iterators[i].Next(), true
+			}
+		}
+		var zero T
+		return zero, false
+	}}
+}
+
+// Enumerate returns an iterator over pairs of the index and element for each element of the given iterator.
+func Enumerate[T any](it Iterator[T]) Iterator[pair.Pair[int, T]] {
+	index := 0
+	return &lazyIterator[pair.Pair[int, T]]{advance: func() (pair.Pair[int, T], bool) {
+		if !it.HasNext() {
+			return pair.Pair[int, T]{}, false
+		}
+		entry := pair.Of(index, it.Next())
+		index++
+		return entry, true
+	}}
+}
+
+// FlatMap returns an iterator over the elements of the iterators obtained from applying the given function to each element
+// of the given iterator.
+func FlatMap[T, U any](it Iterator[T], f func(T) Iterator[U]) Iterator[U] {
+	var current Iterator[U]
+	return &lazyIterator[U]{advance: func() (U, bool) {
+		for current == nil || !current.HasNext() {
+			if !it.HasNext() {
+				var zero U
+				return zero, false
+			}
+			current = f(it.Next())
+		}
+		return current.Next(), true
+	}}
+}
+
+// Chunk returns an iterator over consecutive non overlapping slices of size elements from the given iterator. The last chunk
+// may have fewer elements. A size less than 1 will result in a panic.
+func Chunk[T any](it Iterator[T], size int) Iterator[[]T] {
+	if size < 1 {
+		panic(errors.IllegalArgument("size", size))
+	}
+	return &lazyIterator[[]T]{advance: func() ([]T, bool) {
+		if !it.HasNext() {
+			return nil, false
+		}
+		chunk := make([]T, 0, size)
+		for len(chunk) < size && it.HasNext() {
+			chunk = append(chunk, it.Next())
+		}
+		return chunk, true
+	}}
+}
+
+// Window returns an iterator over sliding windows of size consecutive elements from the given iterator, each window is advanced
+// by a single element. No window is produced if the iterator has fewer than size elements. A size less than 1 will result in a panic.
+func Window[T any](it Iterator[T], size int) Iterator[[]T] {
+	if size < 1 {
+		panic(errors.IllegalArgument("size", size))
+	}
+	var window []T
+	return &lazyIterator[[]T]{advance: func() ([]T, bool) {
+		if window == nil {
+			window = make([]T, 0, size)
+			for len(window) < size && it.HasNext() {
+				window = append(window, it.Next())
+			}
+			if len(window) < size {
+				return nil, false
+			}
+		} else if !it.HasNext() {
+			return nil, false
+		} else {
+			next := make([]T, 0, size)
+			next = append(next, window[1:]...)
+			window = append(next, it.Next())
+		}
+		return window, true
+	}}
+}
+
+// Distinct returns an iterator over the elements of the given iterator with duplicates removed. The first occurrence of each
+// element is kept.
+func Distinct[T comparable](it Iterator[T]) Iterator[T] {
+	seen := make(map[T]struct{})
+	return Filter(it, func(element T) bool {
+		if _, ok := seen[element]; ok {
+			return false
+		}
+		seen[element] = struct{}{}
+		return true
+	})
+}
+
+// Peek returns an iterator over the elements of the given iterator that performs the given action on each element as it is accessed.
+func Peek[T any](it Iterator[T], f func(T)) Iterator[T] {
+	return Map(it, func(element T) T {
+		f(element)
+		return element
+	})
+}
@@ -0,0 +1,179 @@
+package iterator
+
+import (
+	"testing"
+
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+// naturals returns an unbounded iterator over the natural numbers 1, 2, 3, ...
+func naturals() Iterator[int] {
+	i := 0
+	return &lazyIterator[int]{advance: func() (int, bool) {
+		i++
+		return i, true
+	}}
+}
+
+func TestLaziness(t *testing.T) {
+
+	pulled := 0
+	it := Map(Peek(naturals(), func(int) { pulled++ }), func(i int) int { return i * 2 })
+	assert.Equal(t, 0, pulled)
+	assert.Equal(t, []int{2, 4, 6}, ToSlice(Take(it, 3)))
+	assert.Equal(t, 3, pulled)
+
+	evens := Filter(naturals(), func(i int) bool { return i%2 == 0 })
+	assert.Equal(t, []int{2, 4, 6, 8}, ToSlice(Take(evens, 4)))
+}
+
+func TestTake(t *testing.T) {
+
+	type takeTest struct {
+		input    Iterator[int]
+		n        int
+		expected []int
+	}
+
+	takeTests := []takeTest{
+		{input: Of[int](), n: 2, expected: []int{}},
+		{input: Of(1, 2, 3), n: 0, expected: []int{}},
+		{input: Of(1, 2, 3), n: 2, expected: []int{1, 2}},
+		{input: Of(1, 2, 3), n: 5, expected: []int{1, 2, 3}},
+	}
+
+	for _, test := range takeTests {
+		assert.Equal(t, test.expected, ToSlice(Take(test.input, test.n)))
+	}
+}
+
+func TestSkip(t *testing.T) {
+
+	type skipTest struct {
+		input    Iterator[int]
+		n        int
+		expected []int
+	}
+
+	skipTests := []skipTest{
+		{input: Of[int](), n: 2, expected: []int{}},
+		{input: Of(1, 2, 3), n: 0, expected: []int{1, 2, 3}},
+		{input: Of(1, 2, 3), n: 2, expected: []int{3}},
+		{input: Of(1, 2, 3), n: 5, expected: []int{}},
+	}
+
+	for _, test := range skipTests {
+		assert.Equal(t, test.expected, ToSlice(Skip(test.input, test.n)))
+	}
+}
+
+func TestTakeWhile(t *testing.T) {
+
+	lessThanThree := func(i int) bool { return i < 3 }
+	assert.Equal(t, []int{}, ToSlice(TakeWhile(Of[int](), lessThanThree)))
+	assert.Equal(t, []int{1, 2}, ToSlice(TakeWhile(Of(1, 2, 3, 1, 2), lessThanThree)))
+	assert.Equal(t, []int{1, 2}, ToSlice(TakeWhile(naturals(), lessThanThree)))
+}
+
+func TestDropWhile(t *testing.T) {
+
+	lessThanThree := func(i int) bool { return i < 3 }
+	assert.Equal(t, []int{}, ToSlice(DropWhile(Of[int](), lessThanThree)))
+	assert.Equal(t, []int{3, 1, 2}, ToSlice(DropWhile(Of(1, 2, 3, 1, 2), lessThanThree)))
+	assert.Equal(t, []int{}, ToSlice(DropWhile(Of(1, 2), lessThanThree)))
+}
+
+func TestZip(t *testing.T) {
+
+	assert.Equal(t, []pair.Pair[int, string]{}, ToSlice(Zip(Of[int](), Of("a"))))
+	assert.Equal(t, []pair.Pair[int, string]{pair.Of(1, "a"), pair.Of(2, "b")}, ToSlice(Zip(Of(1, 2, 3), Of("a", "b"))))
+	assert.Equal(t, []pair.Pair[int, string]{pair.Of(1, "a"), pair.Of(2, "b")}, ToSlice(Zip(naturals(), Of("a", "b"))))
+}
+
+func TestChain(t *testing.T) {
+
+	assert.Equal(t, []int{}, ToSlice(Chain[int]()))
+	assert.Equal(t, []int{1, 2, 3, 4}, ToSlice(Chain(Of(1, 2), Of[int](), Of(3), Of(4))))
+	assert.Equal(t, []int{1, 2, 1}, ToSlice(Take(Chain(Of(1, 2), naturals()), 3)))
+}
+
+func TestEnumerate(t *testing.T) {
+
+	assert.Equal(t, []pair.Pair[int, string]{}, ToSlice(Enumerate(Of[string]())))
+	assert.Equal(t, []pair.Pair[int, string]{pair.Of(0, "a"), pair.Of(1, "b")}, ToSlice(Enumerate(Of("a", "b"))))
+}
+
+func TestFlatMap(t *testing.T) {
+
+	repeat := func(i int) Iterator[int] {
+		elements := make([]int, i)
+		for j := range elements {
+			elements[j] = i
+		}
+		return Of(elements...)
+	}
+	assert.Equal(t, []int{}, ToSlice(FlatMap(Of[int](), repeat)))
+	assert.Equal(t, []int{1, 2, 2, 3, 3, 3}, ToSlice(FlatMap(Of(0, 1, 2, 0, 3), repeat)))
+	assert.Equal(t, []int{1, 2, 2, 3}, ToSlice(Take(FlatMap(naturals(), repeat), 4)))
+}
+
+func TestChunk(t *testing.T) {
+
+	type chunkTest struct {
+		input    Iterator[int]
+		size     int
+		expected [][]int
+	}
+
+	chunkTests := []chunkTest{
+		{input: Of[int](), size: 2, expected: [][]int{}},
+		{input: Of(1, 2, 3, 4), size: 2, expected: [][]int{{1, 2}, {3, 4}}},
+		{input: Of(1, 2, 3, 4, 5), size: 2, expected: [][]int{{1, 2}, {3, 4}, {5}}},
+		{input: Of(1, 2), size: 3, expected: [][]int{{1, 2}}},
+	}
+
+	for _, test := range chunkTests {
+		assert.Equal(t, test.expected, ToSlice(Chunk(test.input, test.size)))
+	}
+
+	assert.Panics(t, func() { Chunk(Of(1), 0) })
+}
+
+func TestWindow(t *testing.T) {
+
+	type windowTest struct {
+		input    Iterator[int]
+		size     int
+		expected [][]int
+	}
+
+	windowTests := []windowTest{
+		{input: Of[int](), size: 2, expected: [][]int{}},
+		{input: Of(1), size: 2, expected: [][]int{}},
+		{input: Of(1, 2), size: 2, expected: [][]int{{1, 2}}},
+		{input: Of(1, 2, 3, 4), size: 2, expected: [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{input: Of(1, 2, 3, 4), size: 3, expected: [][]int{{1, 2, 3}, {2, 3, 4}}},
+	}
+
+	for _, test := range windowTests {
+		assert.Equal(t, test.expected, ToSlice(Window(test.input, test.size)))
+	}
+
+	assert.Panics(t, func() { Window(Of(1), -1) })
+}
+
+func TestDistinct(t *testing.T) {
+
+	assert.Equal(t, []int{}, ToSlice(Distinct(Of[int]())))
+	assert.Equal(t, []int{1, 2, 3}, ToSlice(Distinct(Of(1, 2, 1, 3, 2, 3))))
+}
+
+func TestPeek(t *testing.T) {
+
+	peeked := make([]int, 0)
+	it := Peek(Of(1, 2, 3), func(i int) { peeked = append(peeked, i) })
+	assert.Equal(t, []int{}, peeked)
+	assert.Equal(t, []int{1, 2, 3}, ToSlice(it))
+	assert.Equal(t, []int{1, 2, 3}, peeked)
+}
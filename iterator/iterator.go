@@ -1,9 +1,13 @@
 // package iterator defines a way to access the elements of a collection one by one. The two basic operations on an iterator
 // are Next and HasNext. A call to Next() will return the next element of the iterator and advance the state of the iterator.
 // A call to Next() should always be preceded by a call to HasNext(), otherwise a NoSuchElement panic may occur if the iterator has no next elements.
+// Combinators such as Map, Filter and Take are lazy, elements are only pulled from the source iterator as they are accessed.
+// A RemovableIterator, ForwardIterator or ListIterator additionally allows modifying the underlying collection while iterating over it.
 package iterator
 
 import (
+	"iter"
+
 	"github.com/phantom820/collections/errors"
 	"github.com/phantom820/collections/types/optional"
 )
@@ -14,6 +18,28 @@ type Iterator[T any] interface {
 	Next() T       // Returns the next element in the iterator.
 }
 
+// RemovableIterator an iterator that can remove elements from the underlying collection while iterating over it.
+type RemovableIterator[T any] interface {
+	Iterator[T]
+	Remove() // Removes the last element returned by Next from the underlying collection.
+}
+
+// ForwardIterator a forward only iterator over a list that supports modifying the list while iterating over it.
+type ForwardIterator[T any] interface {
+	RemovableIterator[T]
+	NextIndex() int // Returns the index of the element that would be returned by a subsequent call to Next.
+	Set(e T)        // Replaces the last element returned by the iterator with the given element.
+	Add(e T)        // Inserts the given element into the list immediately before the element that would be returned by Next.
+}
+
+// ListIterator a bidirectional iterator over a list that supports modifying the list while iterating over it.
+type ListIterator[T any] interface {
+	ForwardIterator[T]
+	HasPrevious() bool  // Returns true if the iterator has more elements when traversing the list in reverse.
+	Previous() T        // Returns the previous element in the list and moves the cursor backwards.
+	PreviousIndex() int // Returns the index of the element that would be returned by a subsequent call to Previous.
+}
+
 // Of returns an iterator over the given elements.
 func Of[T any](elements ...T) Iterator[T] {
 	return &iterator[T]{elements: elements, index: 0}
@@ -43,27 +69,6 @@ func (it *iterator[T]) Next() T {
 	return element
 }
 
-// Map return the iterator obtained from applying the transformation function to every element on the given iterator.
-func Map[T, U comparable](it Iterator[T], f func(T) U) Iterator[U] {
-	elements := make([]U, 0, 8)
-	for it.HasNext() {
-		elements = append(elements, f(it.Next()))
-	}
-	return Of(elements...)
-}
-
-// Filter returns an iterator with all elements that satisfy the given predicate.
-func Filter[T comparable](it Iterator[T], f func(T) bool) Iterator[T] {
-	elements := make([]T, 0, 8)
-	for it.HasNext() {
-		element := it.Next()
-		if f(element) {
-			elements = append(elements, element)
-		}
-	}
-	return Of(elements...)
-}
-
 // Reduce reduces the elements of the iterator using the associative binary function and returns result as an option.
 func Reduce[T comparable](it Iterator[T], f func(x, y T) T) optional.Optional[T] {
 	if !it.HasNext() {
@@ -77,6 +82,7 @@ func Reduce[T comparable](it Iterator[T], f func(x, y T) T) optional.Optional[T]
 	return optional.Of(x)
 }
 
+// ToSlice returns a slice containing the remaining elements of the iterator.
 func ToSlice[T any](it Iterator[T]) []T {
 	data := make([]T, 0)
 	for it.HasNext() {
@@ -85,6 +91,66 @@ func ToSlice[T any](it Iterator[T]) []T {
 	return data
 }
 
+// Seq returns a sequence over the remaining elements of the iterator. The sequence is single use since ranging over it advances
+// the underlying iterator.
+func Seq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.HasNext() {
+			if !yield(it.Next()) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq returns an iterator over the elements of the given sequence. The sequence is pulled one element at a time, resources held by the
+// sequence are released once the iterator has been exhausted.
+func FromSeq[T any](seq iter.Seq[T]) Iterator[T] {
+	next, stop := iter.Pull(seq)
+	return &lazyIterator[T]{advance: next, release: stop}
+}
+
+// lazyIterator iterator implementation that computes its elements on demand. The advance function yields the next element and false
+// once there are no more elements, after which the optional release function is called.
+type lazyIterator[T any] struct {
+	advance func() (T, bool)
+	release func()
+	fetched bool
+	done    bool
+	element T
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *lazyIterator[T]) HasNext() bool {
+	if it.done {
+		return false
+	} else if !it.fetched {
+		element, ok := it.advance()
+		if !ok {
+			it.done = true
+			if it.release != nil {
+				it.release()
+			}
+			return false
+		}
+		it.element = element
+		it.fetched = true
+	}
+	return true
+}
+
+// Next returns the next element in the iterator.
+func (it *lazyIterator[T]) Next() T {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	}
+	it.fetched = false
+	element := it.element
+	var zero T
+	it.element = zero
+	return element
+}
+
 // func Partition[T any](it Iterator[T], n int) [][]T {
 
 // 	partitions := make([][]T, 0, n)
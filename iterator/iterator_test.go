@@ -1,6 +1,7 @@
 package iterator
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/phantom820/collections/types/optional"
@@ -89,3 +90,31 @@ func TestReduce(t *testing.T) {
 	}
 
 }
+
+func TestSeq(t *testing.T) {
+
+	assert.Nil(t, slices.Collect(Seq(Of[int]())))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(Seq(Of(1, 2, 3))))
+
+	// the sequence advances the underlying iterator.
+	it := Of(1, 2, 3, 4)
+	for e := range Seq(it) {
+		if e == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{3, 4}, ToSlice(it))
+}
+
+func TestFromSeq(t *testing.T) {
+
+	assert.Equal(t, []int{}, ToSlice(FromSeq(slices.Values([]int{}))))
+	assert.Equal(t, []int{1, 2, 3}, ToSlice(FromSeq(slices.Values([]int{1, 2, 3}))))
+
+	it := FromSeq(slices.Values([]int{1}))
+	assert.True(t, it.HasNext())
+	assert.True(t, it.HasNext())
+	assert.Equal(t, 1, it.Next())
+	assert.False(t, it.HasNext())
+	assert.Panics(t, func() { it.Next() })
+}
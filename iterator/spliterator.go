@@ -0,0 +1,77 @@
+package iterator
+
+import (
+	"slices"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// Spliterator an iterator that can partition its remaining elements so that they can be processed in parallel.
+type Spliterator[T any] interface {
+	Iterator[T]
+	TrySplit() optional.Optional[Spliterator[T]] // Splits off a prefix of the remaining elements into a new spliterator if possible.
+	EstimateSize() int                           // Returns an estimate of the number of remaining elements.
+}
+
+// SpliteratorOf returns a spliterator over the given elements. Splitting shares the backing slice and does not copy any elements.
+func SpliteratorOf[T any](elements ...T) Spliterator[T] {
+	return &sliceSpliterator[T]{elements: elements, start: 0, end: len(elements)}
+}
+
+// sliceSpliterator slice based spliterator over the index range [start, end).
+type sliceSpliterator[T any] struct {
+	elements []T
+	start    int
+	end      int
+}
+
+// HasNext returns true if the spliterator has more elements.
+func (it *sliceSpliterator[T]) HasNext() bool {
+	return it.start < it.end
+}
+
+// Next returns the next element in the spliterator.
+func (it *sliceSpliterator[T]) Next() T {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	}
+	element := it.elements[it.start]
+	it.start++
+	return element
+}
+
+// TrySplit splits off the first half of the remaining elements into a new spliterator. No split is made if fewer than 2 elements remain.
+func (it *sliceSpliterator[T]) TrySplit() optional.Optional[Spliterator[T]] {
+	if it.end-it.start < 2 {
+		return optional.Empty[Spliterator[T]]()
+	}
+	mid := it.start + (it.end-it.start)/2
+	prefix := &sliceSpliterator[T]{elements: it.elements, start: it.start, end: mid}
+	it.start = mid
+	return optional.Of[Spliterator[T]](prefix)
+}
+
+// EstimateSize returns the number of remaining elements.
+func (it *sliceSpliterator[T]) EstimateSize() int {
+	return it.end - it.start
+}
+
+// Split partitions the spliterator into at most n spliterators in encounter order by repeatedly splitting the largest partition.
+func Split[T any](spliterator Spliterator[T], n int) []Spliterator[T] {
+	partitions := []Spliterator[T]{spliterator}
+	for len(partitions) < n {
+		largest := 0
+		for i := range partitions {
+			if partitions[i].EstimateSize() > partitions[largest].EstimateSize() {
+				largest = i
+			}
+		}
+		prefix := partitions[largest].TrySplit()
+		if prefix.Empty() {
+			break
+		}
+		partitions = slices.Insert(partitions, largest, prefix.Value())
+	}
+	return partitions
+}
@@ -0,0 +1,47 @@
+package iterator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpliterator(t *testing.T) {
+
+	it := SpliteratorOf(1, 2, 3, 4, 5)
+	assert.Equal(t, 5, it.EstimateSize())
+
+	prefix := it.TrySplit()
+	assert.False(t, prefix.Empty())
+	assert.Equal(t, []int{1, 2}, ToSlice[int](prefix.Value()))
+	assert.Equal(t, 3, it.EstimateSize())
+	assert.Equal(t, []int{3, 4, 5}, ToSlice[int](it))
+	assert.True(t, it.TrySplit().Empty())
+	assert.Panics(t, func() { it.Next() })
+}
+
+func TestSplit(t *testing.T) {
+
+	type splitTest struct {
+		input    Spliterator[int]
+		n        int
+		expected [][]int
+	}
+
+	splitTests := []splitTest{
+		{input: SpliteratorOf[int](), n: 4, expected: [][]int{{}}},
+		{input: SpliteratorOf(1, 2, 3), n: 1, expected: [][]int{{1, 2, 3}}},
+		{input: SpliteratorOf(1, 2, 3), n: 8, expected: [][]int{{1}, {2}, {3}}},
+		{input: SpliteratorOf(1, 2, 3, 4, 5, 6, 7, 8), n: 4, expected: [][]int{{1, 2}, {3, 4}, {5, 6}, {7, 8}}},
+		{input: SpliteratorOf(1, 2, 3, 4, 5, 6, 7), n: 3, expected: [][]int{{1, 2, 3}, {4, 5}, {6, 7}}},
+	}
+
+	for _, test := range splitTests {
+		partitions := Split(test.input, test.n)
+		actual := make([][]int, len(partitions))
+		for i := range partitions {
+			actual[i] = ToSlice[int](partitions[i])
+		}
+		assert.Equal(t, test.expected, actual)
+	}
+}
@@ -3,6 +3,7 @@ package forwardlist
 
 import (
 	"fmt"
+	"iter"
 	"math"
 	"strings"
 
@@ -21,9 +22,10 @@ type node[T comparable] struct {
 
 // ForwardList represents a mutable disperse ordered collection of elements of type T.
 type ForwardList[T comparable] struct {
-	head *node[T]
-	len  int
-	tail *node[T]
+	head     *node[T]
+	len      int
+	tail     *node[T]
+	modCount int // The number of structural modifications, used to detect modifications during iteration.
 }
 
 // New creates a mutable list with the given elements.
@@ -72,12 +74,14 @@ func (list *ForwardList[T]) addFront(e T) {
 		list.head = &node[T]{element: e}
 		list.tail = list.head
 		list.len++
+		list.modCount++
 		return
 	}
 	temp := list.head
 	list.head = &node[T]{element: e}
 	list.head.next = temp
 	list.len++
+	list.modCount++
 }
 
 // addBack adds the given element to the end of the list.
@@ -90,6 +94,7 @@ func (list *ForwardList[T]) addBack(e T) {
 	list.tail.next = temp
 	list.tail = temp
 	list.len++
+	list.modCount++
 }
 
 // Add appends the specified element to the end of the list.
@@ -134,6 +139,7 @@ func (list *ForwardList[T]) AddAt(i int, e T) {
 	prev.next = &node
 	node.next = curr
 	list.len++
+	list.modCount++
 }
 
 // at returns the node at the given index.
@@ -191,6 +197,7 @@ func (list *ForwardList[T]) Clear() {
 	list.head = nil
 	list.tail = nil
 	list.len = 0
+	list.modCount++
 }
 
 // Contains returns true if the list contains the specified element.
@@ -239,6 +246,7 @@ func (list *ForwardList[T]) removeFront() T {
 		temp.next = nil
 		temp = nil
 		list.len = int(math.Max(0, float64(list.len-1)))
+		list.modCount++
 		return e
 	}
 	e := list.head.element
@@ -246,6 +254,7 @@ func (list *ForwardList[T]) removeFront() T {
 	list.head = nil
 	list.tail = nil
 	list.len = int(math.Max(0, float64(list.len-1)))
+	list.modCount++
 	return e
 }
 
@@ -256,6 +265,7 @@ func (list *ForwardList[T]) removeBack(prev *node[T]) T {
 	prev.next = nil
 	list.tail = prev
 	list.len = int(math.Max(0, float64(list.len-1)))
+	list.modCount++
 	return e
 }
 
@@ -272,6 +282,7 @@ func (list *ForwardList[T]) remove(prev *node[T], curr *node[T]) {
 	curr.next = nil
 	curr = nil
 	list.len = int(math.Max(0, float64(list.len-1)))
+	list.modCount++
 }
 
 // Remove removes the first occurrence of the specified element from this list.
@@ -465,15 +476,31 @@ func (list *ForwardList[T]) Equals(other collections.List[T]) bool {
 
 }
 
-// Iterator returns an iterator over the elements in the list.
+// Iterator returns an iterator over the elements in the list. The iterator is fail fast, Next panics if the list is structurally
+// modified after iteration has started.
 func (list *ForwardList[T]) Iterator() iterator.Iterator[T] {
-	return &listIterator[T]{initialized: false, initialize: func() (*node[T], int) { return list.head, list.len }}
+	return &listIterator[T]{initialized: false, list: list}
+}
+
+// All returns a sequence over the elements in the list. The sequence panics if the list is structurally modified while ranging over it.
+func (list *ForwardList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		modCount := list.modCount
+		for curr := list.head; curr != nil; curr = curr.next {
+			if !yield(curr.element) {
+				return
+			} else if modCount != list.modCount {
+				panic(errors.ConcurrentModification("ForwardList"))
+			}
+		}
+	}
 }
 
 // listIterator iterator implememantation for [ForwardList].
 type listIterator[T comparable] struct {
 	initialized bool
-	initialize  func() (*node[T], int)
+	list        *ForwardList[T]
+	modCount    int // The expected modification count of the list.
 	node        *node[T]
 	len         int
 	index       int
@@ -482,7 +509,7 @@ type listIterator[T comparable] struct {
 // HasNext returns true if the iterator has more elements.
 func (it *listIterator[T]) HasNext() bool {
 	if !it.initialized {
-		it.node, it.len = it.initialize()
+		it.node, it.len, it.modCount = it.list.head, it.list.len, it.list.modCount
 		it.initialized = true
 	} else if it.node == nil {
 		return false
@@ -494,6 +521,8 @@ func (it *listIterator[T]) HasNext() bool {
 func (it *listIterator[T]) Next() T {
 	if !it.HasNext() {
 		panic(errors.NoSuchElement())
+	} else if it.modCount != it.list.modCount {
+		panic(errors.ConcurrentModification("ForwardList"))
 	}
 	e := it.node.element
 	it.node = it.node.next
@@ -501,6 +530,98 @@ func (it *listIterator[T]) Next() T {
 	return e
 }
 
+// ListIterator returns a forward only list iterator over the elements in the list starting at the front. The list may be modified
+// through the iterator in constant time, any other structural modification of the list after the iterator is created causes it to panic.
+func (list *ForwardList[T]) ListIterator() iterator.ForwardIterator[T] {
+	return &mutableIterator[T]{list: list, modCount: list.modCount, next: list.head, index: 0}
+}
+
+// mutableIterator forward only list iterator implementation for [ForwardList].
+type mutableIterator[T comparable] struct {
+	list     *ForwardList[T]
+	modCount int      // The expected modification count of the list.
+	prev     *node[T] // The node immediately before next, nil at the front of the list.
+	next     *node[T] // The node that would be returned by Next, nil at the end of the list.
+	last     *node[T] // The last node returned by Next, nil if there is none.
+	lastPrev *node[T] // The node immediately before last, required to unlink last since nodes have no back pointers.
+	index    int      // The index of the node that would be returned by Next.
+}
+
+// checkModification panics if the list has been structurally modified other than through the iterator.
+func (it *mutableIterator[T]) checkModification() {
+	if it.modCount != it.list.modCount {
+		panic(errors.ConcurrentModification("ForwardList"))
+	}
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *mutableIterator[T]) HasNext() bool {
+	return it.index < it.list.len
+}
+
+// Next returns the next element in the list and moves the cursor forward.
+func (it *mutableIterator[T]) Next() T {
+	it.checkModification()
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	}
+	it.lastPrev = it.prev
+	it.last = it.next
+	it.prev = it.next
+	it.next = it.next.next
+	it.index++
+	return it.last.element
+}
+
+// NextIndex returns the index of the element that would be returned by a subsequent call to Next.
+func (it *mutableIterator[T]) NextIndex() int {
+	return it.index
+}
+
+// Remove removes the last element returned by Next from the list. Remove can only be called once per call to Next and not after
+// a call to Add.
+func (it *mutableIterator[T]) Remove() {
+	it.checkModification()
+	if it.last == nil {
+		panic(errors.IllegalState("Remove", "ForwardList"))
+	}
+	it.list.remove(it.lastPrev, it.last)
+	it.modCount = it.list.modCount
+	it.prev = it.lastPrev
+	it.last = nil
+	it.index--
+}
+
+// Set replaces the last element returned by Next with the given element.
+func (it *mutableIterator[T]) Set(e T) {
+	it.checkModification()
+	if it.last == nil {
+		panic(errors.IllegalState("Set", "ForwardList"))
+	}
+	it.last.element = e
+}
+
+// Add inserts the given element immediately before the element that would be returned by Next.
+func (it *mutableIterator[T]) Add(e T) {
+	it.checkModification()
+	if it.prev == nil {
+		it.list.addFront(e)
+		it.prev = it.list.head
+	} else if it.prev == it.list.tail {
+		it.list.addBack(e)
+		it.prev = it.list.tail
+	} else {
+		node := &node[T]{element: e, next: it.next}
+		it.prev.next = node
+		it.prev = node
+		it.list.len++
+		it.list.modCount++
+	}
+	it.modCount = it.list.modCount
+	it.index++
+	it.last = nil
+}
+
 // String returns the string representation of the list.
 func (list ForwardList[T]) String() string {
 	var sb strings.Builder
@@ -583,4 +704,5 @@ func (list *ForwardList[T]) Sort(less func(a, b T) bool) {
 	head, tail := sort(list.head, less)
 	list.head = head
 	list.tail = tail
+	list.modCount++
 }
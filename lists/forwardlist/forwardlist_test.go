@@ -1,7 +1,9 @@
 package forwardlist
 
 import (
+	"github.com/phantom820/collections/errors"
 	"math/rand"
+	"slices"
 	"testing"
 	"time"
 
@@ -846,3 +848,134 @@ func TestCopy(t *testing.T) {
 		assert.Equal(t, test.expected, test.input.ImmutableCopy().ToSlice())
 	}
 }
+
+func TestAll(t *testing.T) {
+
+	type allTest struct {
+		input    *ForwardList[int]
+		expected []int
+	}
+
+	allTests := []allTest{
+		{
+			input:    New[int](),
+			expected: nil,
+		},
+		{
+			input:    New(1, 2, 3, 4),
+			expected: []int{1, 2, 3, 4},
+		},
+	}
+
+	for _, test := range allTests {
+		assert.Equal(t, test.expected, slices.Collect(test.input.All()))
+	}
+
+	// breaking out of the loop early stops the sequence.
+	data := make([]int, 0)
+	for e := range New(1, 2, 3, 4).All() {
+		if e == 3 {
+			break
+		}
+		data = append(data, e)
+	}
+	assert.Equal(t, []int{1, 2}, data)
+}
+
+func TestConcurrentModification(t *testing.T) {
+
+	type concurrentModificationTest struct {
+		modify func(list *ForwardList[int])
+	}
+
+	concurrentModificationTests := []concurrentModificationTest{
+		{modify: func(list *ForwardList[int]) { list.Add(5) }},
+		{modify: func(list *ForwardList[int]) { list.AddAt(1, 5) }},
+		{modify: func(list *ForwardList[int]) { list.Remove(3) }},
+		{modify: func(list *ForwardList[int]) { list.RemoveAt(0) }},
+		{modify: func(list *ForwardList[int]) { list.RemoveIf(func(e int) bool { return e%2 == 0 }) }},
+		{modify: func(list *ForwardList[int]) { list.Clear() }},
+		{modify: func(list *ForwardList[int]) { list.Sort(func(a, b int) bool { return a > b }) }},
+	}
+
+	for _, test := range concurrentModificationTests {
+		list := New(1, 2, 3, 4)
+		it := list.Iterator()
+		it.Next()
+		test.modify(list)
+		assert.PanicsWithError(t, errors.ConcurrentModification("ForwardList").Error(), func() { it.Next() })
+		assert.PanicsWithError(t, errors.ConcurrentModification("ForwardList").Error(), func() {
+			list := New(1, 2, 3, 4)
+			for range list.All() {
+				test.modify(list)
+			}
+		})
+	}
+
+	// modifications made before iteration starts and non structural modifications are not detected.
+	list := New(1, 2, 3, 4)
+	it := list.Iterator()
+	list.Add(5)
+	list.Set(0, 6)
+	assert.Equal(t, []int{6, 2, 3, 4, 5}, iterator.ToSlice(it))
+}
+
+func TestListIterator(t *testing.T) {
+
+	// remove the even elements and double the odd elements in place.
+	list := New(1, 2, 3, 4, 5, 6)
+	it := list.ListIterator()
+	for it.HasNext() {
+		if e := it.Next(); e%2 == 0 {
+			it.Remove()
+		} else {
+			it.Set(e * 2)
+		}
+	}
+	assert.Equal(t, []int{2, 6, 10}, list.ToSlice())
+	assert.Equal(t, 3, it.NextIndex())
+
+	// insert an element after each element.
+	it = list.ListIterator()
+	for it.HasNext() {
+		it.Add(it.Next() + 1)
+	}
+	assert.Equal(t, []int{2, 3, 6, 7, 10, 11}, list.ToSlice())
+	assert.Equal(t, 6, list.Len())
+	list.Add(12)
+	assert.Equal(t, []int{2, 3, 6, 7, 10, 11, 12}, list.ToSlice())
+
+	// add at the front and back of the list.
+	list = New(2)
+	it = list.ListIterator()
+	it.Add(1)
+	assert.Equal(t, 2, it.Next())
+	it.Add(3)
+	assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+	assert.False(t, it.HasNext())
+
+	// remove the head and the tail.
+	list = New(1, 2, 3)
+	it = list.ListIterator()
+	it.Next()
+	it.Remove()
+	it.Next()
+	it.Next()
+	it.Remove()
+	assert.Equal(t, []int{2}, list.ToSlice())
+	list.Add(4)
+	assert.Equal(t, []int{2, 4}, list.ToSlice())
+
+	// illegal calls and modifications not made through the iterator.
+	it = New(1, 2).ListIterator()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "ForwardList").Error(), func() { it.Remove() })
+	assert.PanicsWithError(t, errors.IllegalState("Set", "ForwardList").Error(), func() { it.Set(1) })
+	it.Next()
+	it.Remove()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "ForwardList").Error(), func() { it.Remove() })
+
+	list = New(1, 2)
+	it = list.ListIterator()
+	list.Add(3)
+	assert.PanicsWithError(t, errors.ConcurrentModification("ForwardList").Error(), func() { it.Next() })
+}
@@ -2,6 +2,7 @@ package forwardlist
 
 import (
 	"fmt"
+	"iter"
 
 	"github.com/phantom820/collections"
 	"github.com/phantom820/collections/errors"
@@ -136,6 +137,11 @@ func (list ImmutableForwadList[T]) Iterator() iterator.Iterator[T] {
 	return list.list.Iterator()
 }
 
+// All returns a sequence over the elements in the list.
+func (list ImmutableForwadList[T]) All() iter.Seq[T] {
+	return list.list.All()
+}
+
 // String returns the string representation of the list.
 func (list ImmutableForwadList[T]) String() string {
 	return fmt.Sprint(list.ToSlice())
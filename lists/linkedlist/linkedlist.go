@@ -3,6 +3,7 @@ package linkedlist
 
 import (
 	"fmt"
+	"iter"
 	"math"
 	"reflect"
 	"strings"
@@ -25,9 +26,10 @@ type node[T comparable] struct {
 
 // LinkedList a doubly linked list.
 type LinkedList[T comparable] struct {
-	head *node[T]
-	len  int
-	tail *node[T]
+	head     *node[T]
+	len      int
+	tail     *node[T]
+	modCount int // The number of structural modifications, used to detect modifications during iteration.
 }
 
 // New creates a mutable list with the given elements.
@@ -72,6 +74,7 @@ func (list *LinkedList[T]) addFront(e T) {
 		list.head = &node[T]{element: e}
 		list.tail = list.head
 		list.len++
+		list.modCount++
 		return
 	}
 	temp := list.head
@@ -79,6 +82,7 @@ func (list *LinkedList[T]) addFront(e T) {
 	list.head.next = temp
 	temp.prev = list.head
 	list.len++
+	list.modCount++
 }
 
 // addBack appends the given element to the end of the list.
@@ -92,6 +96,7 @@ func (list *LinkedList[T]) addBack(e T) {
 	newTail.prev = list.tail
 	list.tail = newTail
 	list.len++
+	list.modCount++
 }
 
 // Add appends the specified element to the end of the list.
@@ -137,6 +142,7 @@ func (list *LinkedList[T]) AddAt(i int, e T) {
 	node.next = curr
 	curr.prev = &node
 	list.len++
+	list.modCount++
 }
 
 // at returns the node at the given index.
@@ -192,11 +198,15 @@ func (list *LinkedList[T]) Len() int {
 
 // Clear removes all of the elements from the list.
 func (list *LinkedList[T]) Clear() {
+	if list.Empty() {
+		return
+	}
 	list.head.next = nil
 	list.head = nil
 	list.tail.prev = nil
 	list.tail = nil
 	list.len = 0
+	list.modCount++
 }
 
 // Contains returns true if the list contains the specified element.
@@ -227,9 +237,11 @@ func (list *LinkedList[T]) removeFront() T {
 		temp := list.head
 		e := temp.element
 		list.head = list.head.next
+		list.head.prev = nil
 		temp.next = nil
 		temp = nil
 		list.len = int(math.Max(0, float64(list.len-1)))
+		list.modCount++
 		return e
 	}
 	e := list.head.element
@@ -237,6 +249,7 @@ func (list *LinkedList[T]) removeFront() T {
 	list.head = nil
 	list.tail = nil
 	list.len = int(math.Max(0, float64(list.len-1)))
+	list.modCount++
 	return e
 }
 
@@ -247,6 +260,7 @@ func (list *LinkedList[T]) removeBack() T {
 	prev.next = nil
 	list.tail = prev
 	list.len = int(math.Max(0, float64(list.len-1)))
+	list.modCount++
 	return e
 }
 
@@ -265,6 +279,7 @@ func (list *LinkedList[T]) remove(curr *node[T]) {
 	next.prev = prev
 	curr = nil
 	list.len = int(math.Max(0, float64(list.len-1)))
+	list.modCount++
 }
 
 // Remove removes the first occurrence of the specified element from this list, if it is present.
@@ -479,15 +494,31 @@ func (list *LinkedList[T]) IndexOf(e T) optional.Optional[int] {
 	return optional.Empty[int]()
 }
 
-// Iterator returns an iterator over the elements in the list.
+// Iterator returns an iterator over the elements in the list. The iterator is fail fast, Next panics if the list is structurally
+// modified after iteration has started.
 func (list *LinkedList[T]) Iterator() iterator.Iterator[T] {
-	return &listIterator[T]{initialized: false, initialize: func() (*node[T], int) { return list.head, list.len }}
+	return &listIterator[T]{initialized: false, list: list}
+}
+
+// All returns a sequence over the elements in the list. The sequence panics if the list is structurally modified while ranging over it.
+func (list *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		modCount := list.modCount
+		for curr := list.head; curr != nil; curr = curr.next {
+			if !yield(curr.element) {
+				return
+			} else if modCount != list.modCount {
+				panic(errors.ConcurrentModification("LinkedList"))
+			}
+		}
+	}
 }
 
 // listIterator implememantation for [LinkedList].
 type listIterator[T comparable] struct {
 	initialized bool
-	initialize  func() (*node[T], int)
+	list        *LinkedList[T]
+	modCount    int // The expected modification count of the list.
 	node        *node[T]
 	len         int
 	index       int
@@ -496,7 +527,7 @@ type listIterator[T comparable] struct {
 // HasNext returns true if the iterator has more elements.
 func (it *listIterator[T]) HasNext() bool {
 	if !it.initialized {
-		it.node, it.len = it.initialize()
+		it.node, it.len, it.modCount = it.list.head, it.list.len, it.list.modCount
 		it.initialized = true
 	} else if it.node == nil {
 		return false
@@ -508,6 +539,8 @@ func (it *listIterator[T]) HasNext() bool {
 func (it *listIterator[T]) Next() T {
 	if !it.HasNext() {
 		panic(errors.NoSuchElement())
+	} else if it.modCount != it.list.modCount {
+		panic(errors.ConcurrentModification("LinkedList"))
 	}
 	e := it.node.element
 	it.node = it.node.next
@@ -515,6 +548,122 @@ func (it *listIterator[T]) Next() T {
 	return e
 }
 
+// ListIterator returns a list iterator over the elements in the list starting at the front. The list may be modified through the
+// iterator in constant time, any other structural modification of the list after the iterator is created causes it to panic.
+func (list *LinkedList[T]) ListIterator() iterator.ListIterator[T] {
+	return &mutableIterator[T]{list: list, modCount: list.modCount, next: list.head, index: 0}
+}
+
+// mutableIterator list iterator implementation for [LinkedList].
+type mutableIterator[T comparable] struct {
+	list     *LinkedList[T]
+	modCount int      // The expected modification count of the list.
+	next     *node[T] // The node that would be returned by Next, nil at the end of the list.
+	last     *node[T] // The last node returned by Next or Previous, nil if there is none.
+	index    int      // The index of the node that would be returned by Next.
+}
+
+// checkModification panics if the list has been structurally modified other than through the iterator.
+func (it *mutableIterator[T]) checkModification() {
+	if it.modCount != it.list.modCount {
+		panic(errors.ConcurrentModification("LinkedList"))
+	}
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *mutableIterator[T]) HasNext() bool {
+	return it.index < it.list.len
+}
+
+// Next returns the next element in the list and moves the cursor forward.
+func (it *mutableIterator[T]) Next() T {
+	it.checkModification()
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	}
+	it.last = it.next
+	it.next = it.next.next
+	it.index++
+	return it.last.element
+}
+
+// HasPrevious returns true if the iterator has more elements when traversing the list in reverse.
+func (it *mutableIterator[T]) HasPrevious() bool {
+	return it.index > 0
+}
+
+// Previous returns the previous element in the list and moves the cursor backwards.
+func (it *mutableIterator[T]) Previous() T {
+	it.checkModification()
+	if !it.HasPrevious() {
+		panic(errors.NoSuchElement())
+	}
+	if it.next == nil {
+		it.next = it.list.tail
+	} else {
+		it.next = it.next.prev
+	}
+	it.last = it.next
+	it.index--
+	return it.last.element
+}
+
+// NextIndex returns the index of the element that would be returned by a subsequent call to Next.
+func (it *mutableIterator[T]) NextIndex() int {
+	return it.index
+}
+
+// PreviousIndex returns the index of the element that would be returned by a subsequent call to Previous.
+func (it *mutableIterator[T]) PreviousIndex() int {
+	return it.index - 1
+}
+
+// Remove removes the last element returned by Next or Previous from the list. Remove can only be called once per call to Next or
+// Previous and not after a call to Add.
+func (it *mutableIterator[T]) Remove() {
+	it.checkModification()
+	if it.last == nil {
+		panic(errors.IllegalState("Remove", "LinkedList"))
+	}
+	next := it.last.next
+	it.list.remove(it.last)
+	if it.next == it.last { // the last call was to Previous.
+		it.next = next
+	} else {
+		it.index--
+	}
+	it.modCount = it.list.modCount
+	it.last = nil
+}
+
+// Set replaces the last element returned by Next or Previous with the given element.
+func (it *mutableIterator[T]) Set(e T) {
+	it.checkModification()
+	if it.last == nil {
+		panic(errors.IllegalState("Set", "LinkedList"))
+	}
+	it.last.element = e
+}
+
+// Add inserts the given element immediately before the element that would be returned by Next.
+func (it *mutableIterator[T]) Add(e T) {
+	it.checkModification()
+	if it.next == nil {
+		it.list.addBack(e)
+	} else if it.next == it.list.head {
+		it.list.addFront(e)
+	} else {
+		node := &node[T]{element: e, prev: it.next.prev, next: it.next}
+		it.next.prev.next = node
+		it.next.prev = node
+		it.list.len++
+		it.list.modCount++
+	}
+	it.modCount = it.list.modCount
+	it.index++
+	it.last = nil
+}
+
 // String returns the string representation of the list.
 func (list LinkedList[T]) String() string {
 	var sb strings.Builder
@@ -604,4 +753,5 @@ func (list *LinkedList[T]) Sort(less func(a, b T) bool) {
 	head, tail := sort(list.head, less)
 	list.head = head
 	list.tail = tail
+	list.modCount++
 }
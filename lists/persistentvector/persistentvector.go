@@ -0,0 +1,360 @@
+// package persistentvector defines an immutable vector in which every modification produces a new version of the vector that shares
+// all of its unchanged parts with the version it was derived from.
+package persistentvector
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+)
+
+const (
+	bits  = 5         // The number of bits of an index consumed by each level of the trie.
+	width = 1 << bits // The number of children of a node.
+	mask  = width - 1
+)
+
+// node a node of the trie of a [PersistentVector], internal nodes have children and leaves have values. Nodes are never modified once
+// they are part of a vector.
+type node[T comparable] struct {
+	children []*node[T]
+	values   []T
+}
+
+// PersistentVector an immutable list backed by a bit partitioned trie with 32 way branching. The bits of an index select the path from
+// the root to the leaf that holds the element, 5 bits per level, so At, Assoc, With and Without take O(log32 n) time, which is at most
+// 7 levels for any vector that fits in memory. The last (up to 32) elements are kept in a tail outside the trie which makes appending
+// and removing at the end O(1) most of the time. Modifications copy only the nodes on the path they change and leave the receiver
+// unchanged, versions can be kept cheaply and used from multiple goroutines. The zero value is an empty vector. The mutating methods of
+// [collections.List] are unsupported and panic.
+type PersistentVector[T comparable] struct {
+	len   int
+	shift int // The number of index bits below the root.
+	root  *node[T]
+	tail  []T
+}
+
+// New creates a vector with the given elements.
+func New[T comparable](elements ...T) PersistentVector[T] {
+	vector := PersistentVector[T]{}
+	for _, e := range elements {
+		vector = vector.With(e)
+	}
+	return vector
+}
+
+// tailOffset returns the index of the first element in the tail.
+func (vector PersistentVector[T]) tailOffset() int {
+	if vector.len < width {
+		return 0
+	}
+	return ((vector.len - 1) >> bits) << bits
+}
+
+// leaf returns the values of the leaf or tail that holds the element at the given index.
+func (vector PersistentVector[T]) leaf(i int) []T {
+	if i >= vector.tailOffset() {
+		return vector.tail
+	}
+	node := vector.root
+	for level := vector.shift; level > 0; level -= bits {
+		node = node.children[(i>>level)&mask]
+	}
+	return node.values
+}
+
+// At returns the element at the specified index in the vector. Will panic if the index is out of bounds.
+func (vector PersistentVector[T]) At(i int) T {
+	if i < 0 || i >= vector.len {
+		panic(errors.IndexOutOfBounds(i, vector.len))
+	}
+	return vector.leaf(i)[i&mask]
+}
+
+// With returns a new vector with the given element appended to the end of this vector.
+func (vector PersistentVector[T]) With(e T) PersistentVector[T] {
+	if vector.len-vector.tailOffset() < width {
+		tail := make([]T, len(vector.tail), len(vector.tail)+1)
+		copy(tail, vector.tail)
+		return PersistentVector[T]{len: vector.len + 1, shift: vector.shift, root: vector.root, tail: append(tail, e)}
+	}
+	// The tail is full, it moves into the trie which grows a level when the root is full.
+	leaf := &node[T]{values: vector.tail}
+	root, shift := vector.root, vector.shift
+	if root == nil {
+		root, shift = &node[T]{children: []*node[T]{leaf}}, bits
+	} else if (vector.len >> bits) > (1 << shift) {
+		root = &node[T]{children: []*node[T]{root, newPath(shift, leaf)}}
+		shift += bits
+	} else {
+		root = vector.pushTail(shift, root, leaf)
+	}
+	return PersistentVector[T]{len: vector.len + 1, shift: shift, root: root, tail: []T{e}}
+}
+
+// newPath returns a chain of nodes from the given level down to the leaf.
+func newPath[T comparable](level int, leaf *node[T]) *node[T] {
+	if level == 0 {
+		return leaf
+	}
+	return &node[T]{children: []*node[T]{newPath(level-bits, leaf)}}
+}
+
+// pushTail returns a copy of the given node with the leaf added as the rightmost leaf below it.
+func (vector PersistentVector[T]) pushTail(level int, parent *node[T], leaf *node[T]) *node[T] {
+	i := ((vector.len - 1) >> level) & mask
+	child := leaf
+	if level > bits {
+		if i < len(parent.children) {
+			child = vector.pushTail(level-bits, parent.children[i], leaf)
+		} else {
+			child = newPath(level-bits, leaf)
+		}
+	}
+	children := slices.Clone(parent.children)
+	if i < len(children) {
+		children[i] = child
+	} else {
+		children = append(children, child)
+	}
+	return &node[T]{children: children}
+}
+
+// Assoc returns a new vector with the element at the specified index replaced by the given element. Will panic if the index is out of
+// bounds.
+func (vector PersistentVector[T]) Assoc(i int, e T) PersistentVector[T] {
+	if i < 0 || i >= vector.len {
+		panic(errors.IndexOutOfBounds(i, vector.len))
+	} else if i >= vector.tailOffset() {
+		tail := slices.Clone(vector.tail)
+		tail[i&mask] = e
+		return PersistentVector[T]{len: vector.len, shift: vector.shift, root: vector.root, tail: tail}
+	}
+	return PersistentVector[T]{len: vector.len, shift: vector.shift, root: assoc(vector.shift, vector.root, i, e), tail: vector.tail}
+}
+
+// assoc returns a copy of the given node with the element at the given index replaced.
+func assoc[T comparable](level int, n *node[T], i int, e T) *node[T] {
+	if level == 0 {
+		values := slices.Clone(n.values)
+		values[i&mask] = e
+		return &node[T]{values: values}
+	}
+	children := slices.Clone(n.children)
+	j := (i >> level) & mask
+	children[j] = assoc(level-bits, children[j], i, e)
+	return &node[T]{children: children}
+}
+
+// Without returns a new vector with the last element of this vector removed, an empty vector is returned as is.
+func (vector PersistentVector[T]) Without() PersistentVector[T] {
+	if vector.len <= 1 {
+		return PersistentVector[T]{}
+	} else if vector.len-vector.tailOffset() > 1 {
+		return PersistentVector[T]{len: vector.len - 1, shift: vector.shift, root: vector.root, tail: vector.tail[:len(vector.tail)-1]}
+	}
+	// The tail becomes empty, the rightmost leaf of the trie becomes the new tail.
+	tail := vector.leaf(vector.len - 2)
+	root, shift := vector.popTail(vector.shift, vector.root), vector.shift
+	if root != nil && shift > bits && len(root.children) == 1 {
+		root, shift = root.children[0], shift-bits
+	}
+	return PersistentVector[T]{len: vector.len - 1, shift: shift, root: root, tail: tail}
+}
+
+// popTail returns a copy of the given node without its rightmost leaf, or nil if nothing is left below it.
+func (vector PersistentVector[T]) popTail(level int, n *node[T]) *node[T] {
+	i := ((vector.len - 2) >> level) & mask
+	if level > bits {
+		child := vector.popTail(level-bits, n.children[i])
+		if child == nil && i == 0 {
+			return nil
+		}
+		children := slices.Clone(n.children[:i+1])
+		if child == nil {
+			children = children[:i]
+		} else {
+			children[i] = child
+		}
+		return &node[T]{children: children}
+	} else if i == 0 {
+		return nil
+	}
+	return &node[T]{children: slices.Clone(n.children[:i])}
+}
+
+// Contains returns true if the vector contains the specified element.
+func (vector PersistentVector[T]) Contains(e T) bool {
+	return !vector.IndexOf(e).Empty()
+}
+
+// IndexOf returns the index of the first occurrence of the specified element in the vector as an option.
+func (vector PersistentVector[T]) IndexOf(e T) optional.Optional[int] {
+	i := 0
+	for x := range vector.All() {
+		if x == e {
+			return optional.Of(i)
+		}
+		i++
+	}
+	return optional.Empty[int]()
+}
+
+// Len returns the number of elements in the vector.
+func (vector PersistentVector[T]) Len() int {
+	return vector.len
+}
+
+// Empty returns true if the vector contains no elements.
+func (vector PersistentVector[T]) Empty() bool {
+	return vector.len == 0
+}
+
+// ForEach performs the given action for each element of the vector.
+func (vector PersistentVector[T]) ForEach(f func(T)) {
+	for e := range vector.All() {
+		f(e)
+	}
+}
+
+// ToSlice returns a slice containing the elements of the vector.
+func (vector PersistentVector[T]) ToSlice() []T {
+	slice := make([]T, 0, vector.len)
+	for i := 0; i < vector.len; i += width {
+		slice = append(slice, vector.leaf(i)...)
+	}
+	return slice
+}
+
+// Equals returns true if the vector is equivalent to the given list. Two lists are equal if they have the same size and contain the
+// same elements in the same order.
+func (vector PersistentVector[T]) Equals(other collections.List[T]) bool {
+	if vector.len != other.Len() {
+		return false
+	}
+	it := other.Iterator()
+	for e := range vector.All() {
+		if e != it.Next() {
+			return false
+		}
+	}
+	return true
+}
+
+// Iterator returns an iterator over the elements of the vector.
+func (vector PersistentVector[T]) Iterator() iterator.Iterator[T] {
+	return &vectorIterator[T]{vector: vector}
+}
+
+// All returns a sequence over the elements of the vector.
+func (vector PersistentVector[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < vector.len; i += width {
+			for _, e := range vector.leaf(i) {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// String returns the string representation of the vector.
+func (vector PersistentVector[T]) String() string {
+	return fmt.Sprint(vector.ToSlice())
+}
+
+// vectorIterator iterator implementation for [PersistentVector], it walks the vector one leaf at a time.
+type vectorIterator[T comparable] struct {
+	vector PersistentVector[T]
+	index  int
+	leaf   []T
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *vectorIterator[T]) HasNext() bool {
+	return it.index < it.vector.len
+}
+
+// Next returns the next element in the iterator.
+func (it *vectorIterator[T]) Next() T {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	} else if it.index&mask == 0 {
+		it.leaf = it.vector.leaf(it.index)
+	}
+	e := it.leaf[it.index&mask]
+	it.index++
+	return e
+}
+
+// Add unsupported operation.
+func (vector PersistentVector[T]) Add(e T) bool {
+	panic(errors.UnsupportedOperation("Add", "PersistentVector"))
+}
+
+// AddAt unsupported operation.
+func (vector PersistentVector[T]) AddAt(i int, e T) {
+	panic(errors.UnsupportedOperation("AddAt", "PersistentVector"))
+}
+
+// AddAll unsupported operation.
+func (vector PersistentVector[T]) AddAll(iterable iterable.Iterable[T]) bool {
+	panic(errors.UnsupportedOperation("AddAll", "PersistentVector"))
+}
+
+// AddSlice unsupported operation.
+func (vector PersistentVector[T]) AddSlice(s []T) bool {
+	panic(errors.UnsupportedOperation("AddSlice", "PersistentVector"))
+}
+
+// Clear unsupported operation.
+func (vector PersistentVector[T]) Clear() {
+	panic(errors.UnsupportedOperation("Clear", "PersistentVector"))
+}
+
+// Remove unsupported operation.
+func (vector PersistentVector[T]) Remove(e T) bool {
+	panic(errors.UnsupportedOperation("Remove", "PersistentVector"))
+}
+
+// RemoveAt unsupported operation.
+func (vector PersistentVector[T]) RemoveAt(i int) T {
+	panic(errors.UnsupportedOperation("RemoveAt", "PersistentVector"))
+}
+
+// RemoveIf unsupported operation.
+func (vector PersistentVector[T]) RemoveIf(f func(T) bool) bool {
+	panic(errors.UnsupportedOperation("RemoveIf", "PersistentVector"))
+}
+
+// RemoveAll unsupported operation.
+func (vector PersistentVector[T]) RemoveAll(iterable iterable.Iterable[T]) bool {
+	panic(errors.UnsupportedOperation("RemoveAll", "PersistentVector"))
+}
+
+// RemoveSlice unsupported operation.
+func (vector PersistentVector[T]) RemoveSlice(s []T) bool {
+	panic(errors.UnsupportedOperation("RemoveSlice", "PersistentVector"))
+}
+
+// RetainAll unsupported operation.
+func (vector PersistentVector[T]) RetainAll(c collections.Collection[T]) bool {
+	panic(errors.UnsupportedOperation("RetainAll", "PersistentVector"))
+}
+
+// Set unsupported operation, see Assoc.
+func (vector PersistentVector[T]) Set(i int, e T) T {
+	panic(errors.UnsupportedOperation("Set", "PersistentVector"))
+}
+
+// Sort unsupported operation.
+func (vector PersistentVector[T]) Sort(less func(a, b T) bool) {
+	panic(errors.UnsupportedOperation("Sort", "PersistentVector"))
+}
@@ -0,0 +1,263 @@
+package persistentvector
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+func data(n int) []int {
+	data := make([]int, n)
+	for i := range data {
+		data[i] = i + 1
+	}
+	return data
+}
+
+func TestNew(t *testing.T) {
+
+	var zero PersistentVector[int]
+	assert.True(t, zero.Empty())
+	assert.Equal(t, 0, zero.Len())
+	assert.Equal(t, []int{}, zero.ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, New(1, 2, 3).ToSlice())
+}
+
+func TestWith(t *testing.T) {
+
+	// Sizes around the boundaries of the tail and of each level of the trie.
+	for _, n := range []int{0, 1, 31, 32, 33, 64, 65, 1024, 1056, 1057, 33824, 33825, 40000} {
+		elements := data(n)
+		vector := New(elements...)
+		assert.Equal(t, n, vector.Len())
+		assert.Equal(t, elements, vector.ToSlice())
+		for i, e := range elements {
+			assert.Equal(t, e, vector.At(i))
+		}
+	}
+}
+
+func TestWithout(t *testing.T) {
+
+	elements := data(40000)
+	vector := New(elements...)
+	for i := len(elements); i > 0; i-- {
+		assert.Equal(t, i, vector.Len())
+		assert.Equal(t, elements[i-1], vector.At(i-1))
+		if i%977 == 0 {
+			assert.Equal(t, elements[:i], vector.ToSlice())
+		}
+		vector = vector.Without()
+	}
+	assert.True(t, vector.Empty())
+	assert.True(t, vector.Without().Empty())
+
+	// A vector that shrank grows back correctly.
+	for _, e := range elements[:2000] {
+		vector = vector.With(e)
+	}
+	assert.Equal(t, elements[:2000], vector.ToSlice())
+}
+
+func TestAssoc(t *testing.T) {
+
+	elements := data(2000)
+	vector := New(elements...)
+	for i := range elements {
+		vector = vector.Assoc(i, -elements[i])
+	}
+	for i, e := range elements {
+		assert.Equal(t, -e, vector.At(i))
+	}
+
+	assert.PanicsWithError(t, errors.IndexOutOfBounds(2000, 2000).Error(), func() { vector.Assoc(2000, 1) })
+	assert.PanicsWithError(t, errors.IndexOutOfBounds(-1, 2000).Error(), func() { vector.Assoc(-1, 1) })
+	assert.PanicsWithError(t, errors.IndexOutOfBounds(2000, 2000).Error(), func() { vector.At(2000) })
+}
+
+func TestStructuralSharing(t *testing.T) {
+
+	base := New(data(100)...)
+	appended := base.With(101)
+	assigned := base.Assoc(0, 0)
+	assignedTail := base.Assoc(99, 0)
+	removed := base.Without()
+
+	// Every version is unaffected by the versions derived from it.
+	assert.Equal(t, data(100), base.ToSlice())
+	assert.Equal(t, append(data(100), 101), appended.ToSlice())
+	assert.Equal(t, 0, assigned.At(0))
+	assert.Equal(t, 0, assignedTail.At(99))
+	assert.Equal(t, data(99), removed.ToSlice())
+	assert.Equal(t, append(data(99), 1000, 1001), removed.With(1000).With(1001).ToSlice())
+	assert.Equal(t, data(100), base.ToSlice())
+
+	// Untouched leaves are shared.
+	assert.Same(t, base.root.children[1], assigned.root.children[1])
+	assert.Same(t, base.root.children[0], assignedTail.root.children[0])
+	assert.NotSame(t, base.root.children[0], assigned.root.children[0])
+}
+
+func TestRandomOperations(t *testing.T) {
+
+	random := rand.New(rand.NewSource(7))
+	vector := New[int]()
+	expected := make([]int, 0)
+	versions := make(map[int][]int)
+	snapshots := make(map[int]PersistentVector[int])
+
+	for i := 0; i < 20000; i++ {
+		switch r := random.Intn(10); {
+		case r < 6:
+			vector = vector.With(i)
+			expected = append(expected, i)
+		case r < 8 && len(expected) > 0:
+			j := random.Intn(len(expected))
+			vector = vector.Assoc(j, -i)
+			expected = slices.Clone(expected)
+			expected[j] = -i
+		default:
+			vector = vector.Without()
+			if len(expected) > 0 {
+				expected = expected[:len(expected)-1]
+			}
+		}
+		if i%1000 == 0 {
+			versions[i] = slices.Clone(expected)
+			snapshots[i] = vector
+		}
+	}
+	assert.Equal(t, expected, vector.ToSlice())
+	for i, version := range versions {
+		assert.Equal(t, version, snapshots[i].ToSlice())
+	}
+}
+
+func TestContains(t *testing.T) {
+
+	containsTests := []struct {
+		input    PersistentVector[int]
+		element  int
+		expected bool
+		index    optional.Optional[int]
+	}{
+		{
+			input:    New[int](),
+			element:  1,
+			expected: false,
+			index:    optional.Empty[int](),
+		},
+		{
+			input:    New(data(100)...),
+			element:  50,
+			expected: true,
+			index:    optional.Of(49),
+		},
+		{
+			input:    New(data(100)...),
+			element:  101,
+			expected: false,
+			index:    optional.Empty[int](),
+		},
+	}
+
+	for _, test := range containsTests {
+		assert.Equal(t, test.expected, test.input.Contains(test.element))
+		assert.Equal(t, test.index, test.input.IndexOf(test.element))
+	}
+}
+
+func TestEquals(t *testing.T) {
+
+	a := New(data(100)...)
+	assert.True(t, a.Equals(a))
+	assert.True(t, a.Equals(New(data(100)...)))
+	assert.True(t, a.Equals(vector.Of(data(100)...)))
+	assert.False(t, a.Equals(New(data(99)...)))
+	assert.False(t, a.Equals(a.Assoc(50, 0)))
+}
+
+func TestIteration(t *testing.T) {
+
+	elements := data(100)
+	vector := New(elements...)
+
+	it := vector.Iterator()
+	assert.Equal(t, elements, iterator.ToSlice(it))
+	assert.PanicsWithError(t, errors.NoSuchElement().Error(), func() { it.Next() })
+
+	slice := make([]int, 0)
+	for e := range vector.All() {
+		slice = append(slice, e)
+	}
+	assert.Equal(t, elements, slice)
+
+	for range vector.All() {
+		break
+	}
+
+	slice = make([]int, 0)
+	vector.ForEach(func(e int) { slice = append(slice, e) })
+	assert.Equal(t, elements, slice)
+}
+
+func TestString(t *testing.T) {
+
+	assert.Equal(t, "[]", New[int]().String())
+	assert.Equal(t, "[1 2 3]", New(1, 2, 3).String())
+}
+
+func TestConcurrentVersions(t *testing.T) {
+
+	base := New(data(1000)...)
+
+	// Versions can be derived from a shared vector by many goroutines without synchronization.
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			vector := base
+			for i := 0; i < 100; i++ {
+				vector = vector.With(g).Assoc(i, g)
+			}
+			assert.Equal(t, g, vector.At(99))
+			assert.Equal(t, 1100, vector.Len())
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, data(1000), base.ToSlice())
+}
+
+func TestUnsupportedOperations(t *testing.T) {
+
+	vector := New(1, 2, 3)
+	operations := map[string]func(){
+		"Add":         func() { vector.Add(4) },
+		"AddAt":       func() { vector.AddAt(0, 4) },
+		"AddAll":      func() { vector.AddAll(New(4)) },
+		"AddSlice":    func() { vector.AddSlice([]int{4}) },
+		"Clear":       func() { vector.Clear() },
+		"Remove":      func() { vector.Remove(1) },
+		"RemoveAt":    func() { vector.RemoveAt(0) },
+		"RemoveIf":    func() { vector.RemoveIf(func(int) bool { return true }) },
+		"RemoveAll":   func() { vector.RemoveAll(New(1)) },
+		"RemoveSlice": func() { vector.RemoveSlice([]int{1}) },
+		"RetainAll":   func() { vector.RetainAll(hashset.New(1)) },
+		"Set":         func() { vector.Set(0, 4) },
+		"Sort":        func() { vector.Sort(func(a, b int) bool { return a < b }) },
+	}
+
+	for operation, f := range operations {
+		assert.PanicsWithError(t, errors.UnsupportedOperation(operation, "PersistentVector").Error(), f)
+	}
+	assert.Equal(t, []int{1, 2, 3}, vector.ToSlice())
+}
@@ -2,6 +2,7 @@ package vector
 
 import (
 	"fmt"
+	"iter"
 
 	"github.com/phantom820/collections"
 	"github.com/phantom820/collections/errors"
@@ -50,6 +51,16 @@ func (list ImmutableVector[T]) Iterator() iterator.Iterator[T] {
 	return list.vector.Iterator()
 }
 
+// All returns a sequence over the elements in the list.
+func (list ImmutableVector[T]) All() iter.Seq[T] {
+	return list.vector.All()
+}
+
+// Spliterator returns a spliterator over the elements in the list. Partitions share the backing slice of the list.
+func (list ImmutableVector[T]) Spliterator() iterator.Spliterator[T] {
+	return list.vector.Spliterator()
+}
+
 // Equals returns true if the list is equivalent to the given list. Two lists are equal if they are the same reference or have the same size and contain
 // the same elements in the same order.
 func (list ImmutableVector[T]) Equals(otherList collections.List[T]) bool {
@@ -3,6 +3,8 @@ package vector
 
 import (
 	"fmt"
+	"iter"
+	"slices"
 	"sort"
 
 	"github.com/phantom820/collections"
@@ -15,7 +17,8 @@ import (
 
 // Vector a wrapper around a slice []T.
 type Vector[T comparable] struct {
-	data []T
+	data     []T
+	modCount int // The number of structural modifications, used to detect modifications during iteration.
 }
 
 // New creates a mutable list with the given elements.
@@ -37,6 +40,7 @@ func Of[T comparable](elements ...T) ImmutableVector[T] {
 // Add appends the specified element to the end of the list.
 func (list *Vector[T]) Add(e T) bool {
 	list.data = append(list.data, e)
+	list.modCount++
 	return true
 }
 
@@ -51,12 +55,14 @@ func (list *Vector[T]) AddAll(iterable iterable.Iterable[T]) bool {
 	for it.HasNext() {
 		list.data = append(list.data, it.Next())
 	}
+	list.modCount++
 	return true
 }
 
 // AddSlice adds all the elements in the slice to the list.
 func (list *Vector[T]) AddSlice(s []T) bool {
 	list.data = append(list.data, s...)
+	list.modCount++
 	return true
 }
 
@@ -68,6 +74,7 @@ func (list *Vector[T]) AddAt(i int, e T) {
 		data := make([]T, 0, list.Len()+1)
 		data = append(data, e)
 		list.data = append(data, list.data...)
+		list.modCount++
 		return
 	} else if i == list.Len()-1 {
 		list.Add(e)
@@ -80,6 +87,7 @@ func (list *Vector[T]) AddAt(i int, e T) {
 	data = append(data, e)
 	data = append(data, right...)
 	list.data = data
+	list.modCount++
 }
 
 // At returns the element at the specified index in the list.
@@ -104,6 +112,7 @@ func (list *Vector[T]) Contains(e T) bool {
 func (list *Vector[T]) Clear() {
 	list.data = nil
 	list.data = make([]T, 0)
+	list.modCount++
 }
 
 // Empty returns true if the list contains no elements.
@@ -157,6 +166,7 @@ func (list *Vector[T]) Remove(e T) bool {
 		return false
 	}
 	list.data = removeAt(list.data, index)
+	list.modCount++
 	return true
 }
 
@@ -204,7 +214,11 @@ func (list *Vector[T]) RemoveIf(f func(T) bool) bool {
 
 	n := len(list.data)
 	list.data = list.data[:tail]
-	return n != len(list.data)
+	if n != len(list.data) {
+		list.modCount++
+		return true
+	}
+	return false
 }
 
 // RemoveAll removes from the list all of its elements that are contained in the specified collection.
@@ -333,7 +347,9 @@ func (list *Vector[T]) Equals(other collections.List[T]) bool {
 func (list *Vector[T]) RemoveAt(i int) T {
 	if i < 0 || i >= list.Len() {
 		panic(errors.IndexOutOfBounds(i, list.Len()))
-	} else if i == 0 {
+	}
+	list.modCount++
+	if i == 0 {
 		temp := list.data[i]
 		list.data = list.data[1:]
 		return temp
@@ -347,15 +363,36 @@ func (list *Vector[T]) RemoveAt(i int) T {
 	return temp
 }
 
-// Iterator returns an iterator over the elements in the list.
+// Iterator returns an iterator over the elements in the list. The iterator is fail fast, Next panics if the list is structurally
+// modified after iteration has started.
 func (list *Vector[T]) Iterator() iterator.Iterator[T] {
-	return &listIterator[T]{initialized: false, initialize: func() []T { return list.data }, index: 0, data: nil}
+	return &listIterator[T]{initialized: false, list: list, index: 0, data: nil}
+}
+
+// All returns a sequence over the elements in the list. The sequence panics if the list is structurally modified while ranging over it.
+func (list *Vector[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		modCount := list.modCount
+		for _, e := range list.data {
+			if !yield(e) {
+				return
+			} else if modCount != list.modCount {
+				panic(errors.ConcurrentModification("Vector"))
+			}
+		}
+	}
+}
+
+// Spliterator returns a spliterator over the elements in the list. Partitions share the backing slice of the list.
+func (list *Vector[T]) Spliterator() iterator.Spliterator[T] {
+	return iterator.SpliteratorOf(list.data...)
 }
 
 // iterator implememantation for [Vector].
 type listIterator[T comparable] struct {
 	initialized bool
-	initialize  func() []T
+	list        *Vector[T]
+	modCount    int // The expected modification count of the list.
 	index       int
 	data        []T
 }
@@ -363,7 +400,8 @@ type listIterator[T comparable] struct {
 // HasNext returns true if the iterator has more elements.
 func (it *listIterator[T]) HasNext() bool {
 	if !it.initialized {
-		it.data = it.initialize()
+		it.data = it.list.data
+		it.modCount = it.list.modCount
 		it.initialized = true
 	} else if it.data == nil {
 		return false
@@ -375,12 +413,110 @@ func (it *listIterator[T]) HasNext() bool {
 func (it *listIterator[T]) Next() T {
 	if !it.HasNext() {
 		panic(errors.NoSuchElement())
+	} else if it.modCount != it.list.modCount {
+		panic(errors.ConcurrentModification("Vector"))
 	}
 	index := it.index
 	it.index++
 	return it.data[index]
 }
 
+// ListIterator returns a list iterator over the elements in the list starting at the front. The list may be modified through the
+// iterator, any other structural modification of the list after the iterator is created causes it to panic.
+func (list *Vector[T]) ListIterator() iterator.ListIterator[T] {
+	return &mutableIterator[T]{list: list, modCount: list.modCount, cursor: 0, last: -1}
+}
+
+// mutableIterator list iterator implementation for [Vector].
+type mutableIterator[T comparable] struct {
+	list     *Vector[T]
+	modCount int // The expected modification count of the list.
+	cursor   int // The index of the element that would be returned by Next.
+	last     int // The index of the last element returned by Next or Previous, -1 if there is none.
+}
+
+// checkModification panics if the list has been structurally modified other than through the iterator.
+func (it *mutableIterator[T]) checkModification() {
+	if it.modCount != it.list.modCount {
+		panic(errors.ConcurrentModification("Vector"))
+	}
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *mutableIterator[T]) HasNext() bool {
+	return it.cursor < len(it.list.data)
+}
+
+// Next returns the next element in the list and moves the cursor forward.
+func (it *mutableIterator[T]) Next() T {
+	it.checkModification()
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	}
+	it.last = it.cursor
+	it.cursor++
+	return it.list.data[it.last]
+}
+
+// HasPrevious returns true if the iterator has more elements when traversing the list in reverse.
+func (it *mutableIterator[T]) HasPrevious() bool {
+	return it.cursor > 0
+}
+
+// Previous returns the previous element in the list and moves the cursor backwards.
+func (it *mutableIterator[T]) Previous() T {
+	it.checkModification()
+	if !it.HasPrevious() {
+		panic(errors.NoSuchElement())
+	}
+	it.cursor--
+	it.last = it.cursor
+	return it.list.data[it.last]
+}
+
+// NextIndex returns the index of the element that would be returned by a subsequent call to Next.
+func (it *mutableIterator[T]) NextIndex() int {
+	return it.cursor
+}
+
+// PreviousIndex returns the index of the element that would be returned by a subsequent call to Previous.
+func (it *mutableIterator[T]) PreviousIndex() int {
+	return it.cursor - 1
+}
+
+// Remove removes the last element returned by Next or Previous from the list. Remove can only be called once per call to Next or
+// Previous and not after a call to Add.
+func (it *mutableIterator[T]) Remove() {
+	it.checkModification()
+	if it.last < 0 {
+		panic(errors.IllegalState("Remove", "Vector"))
+	}
+	it.list.data = slices.Delete(it.list.data, it.last, it.last+1)
+	it.list.modCount++
+	it.modCount = it.list.modCount
+	it.cursor = it.last
+	it.last = -1
+}
+
+// Set replaces the last element returned by Next or Previous with the given element.
+func (it *mutableIterator[T]) Set(e T) {
+	it.checkModification()
+	if it.last < 0 {
+		panic(errors.IllegalState("Set", "Vector"))
+	}
+	it.list.data[it.last] = e
+}
+
+// Add inserts the given element immediately before the element that would be returned by Next.
+func (it *mutableIterator[T]) Add(e T) {
+	it.checkModification()
+	it.list.data = slices.Insert(it.list.data, it.cursor, e)
+	it.list.modCount++
+	it.modCount = it.list.modCount
+	it.cursor++
+	it.last = -1
+}
+
 // String returns the string representation of the list.
 func (list Vector[T]) String() string {
 	return fmt.Sprint(list.data)
@@ -391,4 +527,5 @@ func (list *Vector[T]) Sort(less func(a, b T) bool) {
 	sort.Slice(list.data, func(i, j int) bool {
 		return less(list.data[i], list.data[j])
 	})
+	list.modCount++
 }
@@ -1,7 +1,9 @@
 package vector
 
 import (
+	"github.com/phantom820/collections/errors"
 	"math/rand"
+	"slices"
 	"testing"
 	"time"
 
@@ -844,3 +846,146 @@ func TestCopy(t *testing.T) {
 		assert.Equal(t, test.expected, test.input.ImmutableCopy().ToSlice())
 	}
 }
+
+func TestAll(t *testing.T) {
+
+	type allTest struct {
+		input    *Vector[int]
+		expected []int
+	}
+
+	allTests := []allTest{
+		{
+			input:    New[int](),
+			expected: nil,
+		},
+		{
+			input:    New(1, 2, 3, 4),
+			expected: []int{1, 2, 3, 4},
+		},
+	}
+
+	for _, test := range allTests {
+		assert.Equal(t, test.expected, slices.Collect(test.input.All()))
+	}
+
+	// breaking out of the loop early stops the sequence.
+	data := make([]int, 0)
+	for e := range New(1, 2, 3, 4).All() {
+		if e == 3 {
+			break
+		}
+		data = append(data, e)
+	}
+	assert.Equal(t, []int{1, 2}, data)
+}
+
+func TestSpliterator(t *testing.T) {
+
+	list := New(1, 2, 3, 4)
+	it := list.Spliterator()
+	prefix := it.TrySplit()
+	assert.Equal(t, []int{1, 2}, iterator.ToSlice[int](prefix.Value()))
+	assert.Equal(t, []int{3, 4}, iterator.ToSlice[int](it))
+	assert.Equal(t, []int{1, 2, 3, 4}, iterator.ToSlice[int](list.ImmutableCopy().Spliterator()))
+}
+
+func TestConcurrentModification(t *testing.T) {
+
+	type concurrentModificationTest struct {
+		modify func(list *Vector[int])
+	}
+
+	concurrentModificationTests := []concurrentModificationTest{
+		{modify: func(list *Vector[int]) { list.Add(5) }},
+		{modify: func(list *Vector[int]) { list.AddAt(1, 5) }},
+		{modify: func(list *Vector[int]) { list.Remove(3) }},
+		{modify: func(list *Vector[int]) { list.RemoveAt(0) }},
+		{modify: func(list *Vector[int]) { list.RemoveIf(func(e int) bool { return e%2 == 0 }) }},
+		{modify: func(list *Vector[int]) { list.Clear() }},
+		{modify: func(list *Vector[int]) { list.Sort(func(a, b int) bool { return a > b }) }},
+	}
+
+	for _, test := range concurrentModificationTests {
+		list := New(1, 2, 3, 4)
+		it := list.Iterator()
+		it.Next()
+		test.modify(list)
+		assert.PanicsWithError(t, errors.ConcurrentModification("Vector").Error(), func() { it.Next() })
+		assert.PanicsWithError(t, errors.ConcurrentModification("Vector").Error(), func() {
+			list := New(1, 2, 3, 4)
+			for range list.All() {
+				test.modify(list)
+			}
+		})
+	}
+
+	// modifications made before iteration starts and non structural modifications are not detected.
+	list := New(1, 2, 3, 4)
+	it := list.Iterator()
+	list.Add(5)
+	list.Set(0, 6)
+	assert.Equal(t, []int{6, 2, 3, 4, 5}, iterator.ToSlice(it))
+}
+
+func TestListIterator(t *testing.T) {
+
+	// remove the even elements and double the odd elements in place.
+	list := New(1, 2, 3, 4, 5)
+	it := list.ListIterator()
+	for it.HasNext() {
+		if e := it.Next(); e%2 == 0 {
+			it.Remove()
+		} else {
+			it.Set(e * 2)
+		}
+	}
+	assert.Equal(t, []int{2, 6, 10}, list.ToSlice())
+
+	// traverse backwards inserting an element before each element.
+	assert.False(t, it.HasNext())
+	assert.Equal(t, 3, it.NextIndex())
+	for it.HasPrevious() {
+		assert.Equal(t, it.NextIndex()-1, it.PreviousIndex())
+		e := it.Previous()
+		it.Add(e - 1)
+		it.Previous()
+	}
+	assert.Equal(t, []int{1, 2, 5, 6, 9, 10}, list.ToSlice())
+	assert.Equal(t, 0, it.NextIndex())
+	assert.Equal(t, 6, list.Len())
+
+	// removal after a call to previous.
+	it = list.ListIterator()
+	it.Next()
+	it.Next()
+	it.Previous()
+	it.Remove()
+	assert.Equal(t, []int{1, 5, 6, 9, 10}, list.ToSlice())
+	assert.Equal(t, 5, it.Next())
+
+	// add at the front and back of the list.
+	list = New[int]()
+	it = list.ListIterator()
+	it.Add(2)
+	it.Add(3)
+	it.Previous()
+	it.Previous()
+	it.Add(1)
+	assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+	assert.Equal(t, 2, it.Next())
+
+	// illegal calls and modifications not made through the iterator.
+	it = New(1, 2).ListIterator()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "Vector").Error(), func() { it.Remove() })
+	assert.PanicsWithError(t, errors.IllegalState("Set", "Vector").Error(), func() { it.Set(1) })
+	it.Next()
+	it.Remove()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "Vector").Error(), func() { it.Remove() })
+	assert.Panics(t, func() { it.Previous() })
+
+	list = New(1, 2)
+	it = list.ListIterator()
+	list.Add(3)
+	assert.PanicsWithError(t, errors.ConcurrentModification("Vector").Error(), func() { it.Next() })
+}
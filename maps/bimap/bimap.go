@@ -0,0 +1,251 @@
+// package bimap defines maps that preserve the uniqueness of their values as well as their keys, so that a value can be looked up by its
+// key and a key by its value. Each bimap keeps a map in each direction and both are updated on every modification.
+//
+//  1. HashBiMap[K, V] : Both directions are kept in a [hashmap.HashMap] with no particular ordering.
+//  2. LinkedBiMap[K, V] : Both directions are kept in a [linkedhashmap.LinkedHashMap] and entries are iterated on in the order in which
+//     they were inserted.
+package bimap
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// BiMap a [collections.Map] in which no two keys are mapped to the same value. Put panics if the value is already bound to a different
+// key, ForcePut replaces that binding instead.
+type BiMap[K comparable, V comparable] interface {
+	collections.Map[K, V]
+	ForcePut(k K, v V) optional.Optional[V]                         // Adds a new key/value pair, removing any existing binding of the value, and optionally returns the previously bound value.
+	Inverse() BiMap[V, K]                                           // Returns a view of the bimap that maps each value to its key, changes to the view are reflected in the bimap and vice versa.
+	RemovableIterator() iterator.RemovableIterator[pair.Pair[K, V]] // Returns an iterator over the bimap that can remove the last returned entry.
+}
+
+// removableMap a map that can remove entries while it is iterated on, the maps kept in each direction of a bimap.
+type removableMap[K comparable, V any] interface {
+	collections.Map[K, V]
+	RemovableIterator() iterator.RemovableIterator[pair.Pair[K, V]]
+}
+
+// biMap the implementation of [BiMap] shared by the hash and linked bimaps. A bimap and its inverse share the same pair of maps with
+// the directions swapped.
+type biMap[K comparable, V comparable] struct {
+	forward  removableMap[K, V]
+	backward removableMap[V, K]
+	inverse  BiMap[V, K]
+	name     string // The name of the bimap type used in errors.
+	modCount *int   // The number of structural modifications shared with the inverse, used to detect modifications during iteration.
+}
+
+// Put adds a new key/value pair to the bimap and optionally returns the previously bound value. Panics if the value is already bound to
+// a different key.
+func (biMap *biMap[K, V]) Put(k K, v V) optional.Optional[V] {
+	if key := biMap.backward.Get(v); !key.Empty() && key.Value() != k {
+		panic(errors.IllegalArgument("value", v))
+	}
+	return biMap.put(k, v)
+}
+
+// ForcePut adds a new key/value pair to the bimap and optionally returns the previously bound value. If the value is already bound to a
+// different key that binding is removed first.
+func (biMap *biMap[K, V]) ForcePut(k K, v V) optional.Optional[V] {
+	if key := biMap.backward.Get(v); !key.Empty() && key.Value() != k {
+		biMap.Remove(key.Value())
+	}
+	return biMap.put(k, v)
+}
+
+// put binds the key to the value in both directions, the value must not be bound to a different key.
+func (biMap *biMap[K, V]) put(k K, v V) optional.Optional[V] {
+	previous := biMap.forward.Put(k, v)
+	if previous.Empty() {
+		*biMap.modCount++
+	} else if previous.Value() != v {
+		// The previous value is unbound in the inverse, which is a structural modification of the inverse.
+		biMap.backward.Remove(previous.Value())
+		*biMap.modCount++
+	}
+	biMap.backward.Put(v, k)
+	return previous
+}
+
+// PutIfAbsent adds a new key/value pair to the bimap if the key is not already bound and optionally returns the bound value. Panics if
+// the key is absent and the value is already bound to a different key.
+func (biMap *biMap[K, V]) PutIfAbsent(k K, v V) optional.Optional[V] {
+	if value := biMap.forward.Get(k); !value.Empty() {
+		return value
+	}
+	return biMap.Put(k, v)
+}
+
+// Get optionally returns the value associated with a key.
+func (biMap *biMap[K, V]) Get(k K) optional.Optional[V] {
+	return biMap.forward.Get(k)
+}
+
+// GetIf returns the values mapped by keys that match the given predicate.
+func (biMap *biMap[K, V]) GetIf(f func(K) bool) []V {
+	return biMap.forward.GetIf(f)
+}
+
+// Remove removes a key from the bimap, returning the value associated previously with that key as an option.
+func (biMap *biMap[K, V]) Remove(k K) optional.Optional[V] {
+	value := biMap.forward.Remove(k)
+	if !value.Empty() {
+		biMap.backward.Remove(value.Value())
+		*biMap.modCount++
+	}
+	return value
+}
+
+// RemoveIf removes all the key, value mappings in which the key matches the given predicate.
+func (biMap *biMap[K, V]) RemoveIf(f func(K) bool) bool {
+	removed := false
+	for _, k := range biMap.forward.Keys() {
+		if f(k) {
+			biMap.Remove(k)
+			removed = true
+		}
+	}
+	return removed
+}
+
+// ContainsKey returns true if the bimap contains a mapping for the specified key.
+func (biMap *biMap[K, V]) ContainsKey(k K) bool {
+	return biMap.forward.ContainsKey(k)
+}
+
+// ContainsValue returns true if the bimap maps a key to the specified value.
+func (biMap *biMap[K, V]) ContainsValue(v V, equals func(v1, v2 V) bool) bool {
+	return biMap.forward.ContainsValue(v, equals)
+}
+
+// Clear removes all of the mappings from the bimap.
+func (biMap *biMap[K, V]) Clear() {
+	biMap.forward.Clear()
+	biMap.backward.Clear()
+	*biMap.modCount++
+}
+
+// Keys returns a slice containing the keys in the bimap.
+func (biMap *biMap[K, V]) Keys() []K {
+	return biMap.forward.Keys()
+}
+
+// Values returns a slice containing the values in the bimap.
+func (biMap *biMap[K, V]) Values() []V {
+	return biMap.forward.Values()
+}
+
+// Len returns the size of the bimap.
+func (biMap *biMap[K, V]) Len() int {
+	return biMap.forward.Len()
+}
+
+// Empty returns true if the bimap has no elements.
+func (biMap *biMap[K, V]) Empty() bool {
+	return biMap.forward.Empty()
+}
+
+// ForEach performs the given action for each key, value mapping in the bimap.
+func (biMap *biMap[K, V]) ForEach(f func(K, V)) {
+	biMap.forward.ForEach(f)
+}
+
+// All returns a sequence over the key, value pairs in the bimap.
+func (biMap *biMap[K, V]) All() iter.Seq2[K, V] {
+	return biMap.forward.All()
+}
+
+// AllKeys returns a sequence over the keys in the bimap.
+func (biMap *biMap[K, V]) AllKeys() iter.Seq[K] {
+	return biMap.forward.AllKeys()
+}
+
+// AllValues returns a sequence over the values in the bimap.
+func (biMap *biMap[K, V]) AllValues() iter.Seq[V] {
+	return biMap.forward.AllValues()
+}
+
+// Iterator returns an iterator over the bimap. The iterator is fail fast, Next panics if the bimap or its inverse is structurally
+// modified after the iterator is created.
+func (biMap *biMap[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
+	return biMap.RemovableIterator()
+}
+
+// RemovableIterator returns an iterator over the bimap that can remove the last returned entry from the bimap and its inverse. The
+// iterator is fail fast in the same way as the one returned by Iterator.
+func (biMap *biMap[K, V]) RemovableIterator() iterator.RemovableIterator[pair.Pair[K, V]] {
+	return &biMapIterator[K, V]{biMap: biMap, entries: biMap.forward.RemovableIterator(), modCount: *biMap.modCount}
+}
+
+// Inverse returns a view of the bimap that maps each value to its key. The view is backed by the bimap, changes to the view are
+// reflected in the bimap and vice versa.
+func (biMap *biMap[K, V]) Inverse() BiMap[V, K] {
+	return biMap.inverse
+}
+
+// Equals return true if the bimap is equal to the given map. Two maps are equal if they contain the same key, value pairs.
+func (biMap *biMap[K, V]) Equals(other collections.Map[K, V], equals func(V, V) bool) bool {
+	return biMap.forward.Equals(other, equals)
+}
+
+// String returns the string representation of the bimap.
+func (biMap *biMap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for k, v := range biMap.forward.All() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", k, v))
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// biMapIterator iterator implementation for the bimaps.
+type biMapIterator[K comparable, V comparable] struct {
+	biMap     *biMap[K, V]
+	entries   iterator.RemovableIterator[pair.Pair[K, V]]
+	modCount  int             // The expected modification count of the bimap.
+	last      pair.Pair[K, V] // The entry last returned by Next.
+	removable bool            // Whether the last returned entry can be removed.
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *biMapIterator[K, V]) HasNext() bool {
+	return it.entries.HasNext()
+}
+
+// Next returns the next element in the iterator.
+func (it *biMapIterator[K, V]) Next() pair.Pair[K, V] {
+	if it.modCount != *it.biMap.modCount {
+		panic(errors.ConcurrentModification(it.biMap.name))
+	}
+	it.last = it.entries.Next()
+	it.removable = true
+	return it.last
+}
+
+// Remove removes the last entry returned by Next from the bimap and its inverse. Remove can only be called once per call to Next.
+func (it *biMapIterator[K, V]) Remove() {
+	if !it.removable {
+		panic(errors.IllegalState("Remove", it.biMap.name))
+	} else if it.modCount != *it.biMap.modCount {
+		panic(errors.ConcurrentModification(it.biMap.name))
+	}
+	it.entries.Remove()
+	it.biMap.backward.Remove(it.last.Value())
+	*it.biMap.modCount++
+	it.modCount = *it.biMap.modCount
+	it.removable = false
+}
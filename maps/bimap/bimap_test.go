@@ -0,0 +1,738 @@
+package bimap
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+
+	type newTest struct {
+		input    BiMap[string, int]
+		expected hashmap.HashMap[string, int]
+	}
+
+	newTests := []newTest{
+		{input: NewHashBiMap[string, int](), expected: hashmap.HashMap[string, int]{}},
+		{input: NewLinkedBiMap[string, int](), expected: hashmap.HashMap[string, int]{}},
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)), expected: hashmap.HashMap[string, int]{"A": 1, "B": 2}},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)), expected: hashmap.HashMap[string, int]{"A": 1, "B": 2}},
+	}
+
+	for _, test := range newTests {
+		assert.Equal(t, len(test.expected), test.input.Len())
+		assert.True(t, test.input.Equals(test.expected, equals))
+		assert.True(t, test.input.Inverse().Equals(invert(test.expected), equalsString))
+	}
+	assert.PanicsWithError(t, errors.IllegalArgument("value", 1).Error(), func() { NewHashBiMap(pair.Of("A", 1), pair.Of("B", 1)) })
+	assert.PanicsWithError(t, errors.IllegalArgument("value", 1).Error(), func() { NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 1)) })
+}
+
+func TestPut(t *testing.T) {
+
+	type putTest struct {
+		input    BiMap[string, int]
+		action   func(BiMap[string, int])
+		expected hashmap.HashMap[string, int]
+	}
+
+	putTests := []putTest{
+		{input: NewHashBiMap[string, int](),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Empty[int](), biMap.Put("A", 1))
+			},
+			expected: hashmap.HashMap[string, int]{"A": 1},
+		},
+		{input: NewLinkedBiMap[string, int](),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Empty[int](), biMap.Put("A", 1))
+			},
+			expected: hashmap.HashMap[string, int]{"A": 1},
+		},
+		{input: NewHashBiMap(pair.Of("A", 1)),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Of(1), biMap.Put("A", 1))
+				assert.Equal(t, optional.Of(1), biMap.Put("A", 2))
+				assert.Equal(t, optional.Empty[int](), biMap.Put("B", 1))
+			},
+			expected: hashmap.HashMap[string, int]{"A": 2, "B": 1},
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1)),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Of(1), biMap.Put("A", 1))
+				assert.Equal(t, optional.Of(1), biMap.Put("A", 2))
+				assert.Equal(t, optional.Empty[int](), biMap.Put("B", 1))
+			},
+			expected: hashmap.HashMap[string, int]{"A": 2, "B": 1},
+		},
+		// A value can only be bound to one key.
+		{input: NewHashBiMap(pair.Of("A", 2), pair.Of("B", 1)),
+			action: func(biMap BiMap[string, int]) {
+				assert.PanicsWithError(t, errors.IllegalArgument("value", 1).Error(), func() { biMap.Put("C", 1) })
+				assert.PanicsWithError(t, errors.IllegalArgument("value", 1).Error(), func() { biMap.Put("A", 1) })
+			},
+			expected: hashmap.HashMap[string, int]{"A": 2, "B": 1},
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 2), pair.Of("B", 1)),
+			action: func(biMap BiMap[string, int]) {
+				assert.PanicsWithError(t, errors.IllegalArgument("value", 1).Error(), func() { biMap.Put("C", 1) })
+				assert.PanicsWithError(t, errors.IllegalArgument("value", 1).Error(), func() { biMap.Put("A", 1) })
+			},
+			expected: hashmap.HashMap[string, int]{"A": 2, "B": 1},
+		},
+	}
+
+	for _, test := range putTests {
+		test.action(test.input)
+		assert.True(t, test.input.Equals(test.expected, equals))
+		assert.True(t, test.input.Inverse().Equals(invert(test.expected), equalsString))
+	}
+}
+
+func TestForcePut(t *testing.T) {
+
+	type forcePutTest struct {
+		input    BiMap[string, int]
+		action   func(BiMap[string, int])
+		expected hashmap.HashMap[string, int]
+	}
+
+	forcePutTests := []forcePutTest{
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Empty[int](), biMap.ForcePut("C", 1))
+			},
+			expected: hashmap.HashMap[string, int]{"B": 2, "C": 1},
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Empty[int](), biMap.ForcePut("C", 1))
+			},
+			expected: hashmap.HashMap[string, int]{"B": 2, "C": 1},
+		},
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Of(2), biMap.ForcePut("B", 1))
+				assert.Equal(t, optional.Of(1), biMap.ForcePut("B", 1))
+			},
+			expected: hashmap.HashMap[string, int]{"B": 1},
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Of(2), biMap.ForcePut("B", 1))
+				assert.Equal(t, optional.Of(1), biMap.ForcePut("B", 1))
+			},
+			expected: hashmap.HashMap[string, int]{"B": 1},
+		},
+	}
+
+	for _, test := range forcePutTests {
+		test.action(test.input)
+		assert.True(t, test.input.Equals(test.expected, equals))
+		assert.True(t, test.input.Inverse().Equals(invert(test.expected), equalsString))
+	}
+}
+
+func TestPutIfAbsent(t *testing.T) {
+
+	type putIfAbsentTest struct {
+		input    BiMap[string, int]
+		action   func(BiMap[string, int])
+		expected hashmap.HashMap[string, int]
+	}
+
+	putIfAbsentTests := []putIfAbsentTest{
+		{input: NewHashBiMap[string, int](),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Empty[int](), biMap.PutIfAbsent("A", 1))
+				assert.Equal(t, optional.Of(1), biMap.PutIfAbsent("A", 2))
+				assert.Equal(t, optional.Of(1), biMap.PutIfAbsent("A", 1))
+			},
+			expected: hashmap.HashMap[string, int]{"A": 1},
+		},
+		{input: NewLinkedBiMap[string, int](),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Empty[int](), biMap.PutIfAbsent("A", 1))
+				assert.Equal(t, optional.Of(1), biMap.PutIfAbsent("A", 2))
+				assert.Equal(t, optional.Of(1), biMap.PutIfAbsent("A", 1))
+			},
+			expected: hashmap.HashMap[string, int]{"A": 1},
+		},
+		{input: NewHashBiMap(pair.Of("A", 1)),
+			action: func(biMap BiMap[string, int]) {
+				assert.PanicsWithError(t, errors.IllegalArgument("value", 1).Error(), func() { biMap.PutIfAbsent("B", 1) })
+			},
+			expected: hashmap.HashMap[string, int]{"A": 1},
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1)),
+			action: func(biMap BiMap[string, int]) {
+				assert.PanicsWithError(t, errors.IllegalArgument("value", 1).Error(), func() { biMap.PutIfAbsent("B", 1) })
+			},
+			expected: hashmap.HashMap[string, int]{"A": 1},
+		},
+	}
+
+	for _, test := range putIfAbsentTests {
+		test.action(test.input)
+		assert.True(t, test.input.Equals(test.expected, equals))
+		assert.True(t, test.input.Inverse().Equals(invert(test.expected), equalsString))
+	}
+}
+
+func TestGet(t *testing.T) {
+
+	type getTest struct {
+		input           BiMap[string, int]
+		key             string
+		expected        optional.Optional[int]
+		value           int
+		expectedInverse optional.Optional[string]
+	}
+
+	getTests := []getTest{
+		{input: NewHashBiMap[string, int](), key: "A", expected: optional.Empty[int](), value: 1, expectedInverse: optional.Empty[string]()},
+		{input: NewLinkedBiMap[string, int](), key: "A", expected: optional.Empty[int](), value: 1, expectedInverse: optional.Empty[string]()},
+		{input: NewHashBiMap(pair.Of("A", 1)), key: "A", expected: optional.Of(1), value: 1, expectedInverse: optional.Of("A")},
+		{input: NewLinkedBiMap(pair.Of("A", 1)), key: "A", expected: optional.Of(1), value: 1, expectedInverse: optional.Of("A")},
+		{input: NewHashBiMap(pair.Of("A", 1)), key: "B", expected: optional.Empty[int](), value: 2, expectedInverse: optional.Empty[string]()},
+		{input: NewLinkedBiMap(pair.Of("A", 1)), key: "B", expected: optional.Empty[int](), value: 2, expectedInverse: optional.Empty[string]()},
+	}
+
+	for _, test := range getTests {
+		assert.Equal(t, test.expected, test.input.Get(test.key))
+		assert.Equal(t, test.expectedInverse, test.input.Inverse().Get(test.value))
+	}
+}
+
+func TestGetIf(t *testing.T) {
+
+	type getIfTest struct {
+		input    BiMap[string, int]
+		f        func(string) bool
+		expected []int
+	}
+
+	getIfTests := []getIfTest{
+		{input: NewHashBiMap[string, int](),
+			f:        func(s string) bool { return s == "" },
+			expected: []int{},
+		},
+		{input: NewLinkedBiMap[string, int](),
+			f:        func(s string) bool { return s == "" },
+			expected: []int{},
+		},
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2), pair.Of("C", 3)),
+			f:        func(s string) bool { return s == "A" || s == "B" },
+			expected: []int{1, 2},
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2), pair.Of("C", 3)),
+			f:        func(s string) bool { return s == "A" || s == "B" },
+			expected: []int{1, 2},
+		},
+		{input: NewHashBiMap(pair.Of("A", 1)),
+			f:        func(s string) bool { return false },
+			expected: []int{},
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1)),
+			f:        func(s string) bool { return false },
+			expected: []int{},
+		},
+	}
+
+	for _, test := range getIfTests {
+		assert.ElementsMatch(t, test.expected, test.input.GetIf(test.f))
+	}
+	assert.ElementsMatch(t, []string{"C"}, NewHashBiMap(pair.Of("A", 1), pair.Of("C", 3)).Inverse().GetIf(func(i int) bool { return i > 2 }))
+}
+
+func TestRemove(t *testing.T) {
+
+	type removeTest struct {
+		input    BiMap[string, int]
+		action   func(BiMap[string, int])
+		expected hashmap.HashMap[string, int]
+	}
+
+	removeTests := []removeTest{
+		{input: NewHashBiMap[string, int](),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Empty[int](), biMap.Remove("A"))
+			},
+			expected: hashmap.HashMap[string, int]{},
+		},
+		{input: NewLinkedBiMap[string, int](),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Empty[int](), biMap.Remove("A"))
+			},
+			expected: hashmap.HashMap[string, int]{},
+		},
+		// The value is free to be bound to another key once removed.
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Of(1), biMap.Remove("A"))
+				assert.Equal(t, optional.Empty[int](), biMap.Remove("A"))
+				assert.Equal(t, optional.Empty[int](), biMap.Put("C", 1))
+			},
+			expected: hashmap.HashMap[string, int]{"B": 2, "C": 1},
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Of(1), biMap.Remove("A"))
+				assert.Equal(t, optional.Empty[int](), biMap.Remove("A"))
+				assert.Equal(t, optional.Empty[int](), biMap.Put("C", 1))
+			},
+			expected: hashmap.HashMap[string, int]{"B": 2, "C": 1},
+		},
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Of("B"), biMap.Inverse().Remove(2))
+			},
+			expected: hashmap.HashMap[string, int]{"A": 1},
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)),
+			action: func(biMap BiMap[string, int]) {
+				assert.Equal(t, optional.Of("B"), biMap.Inverse().Remove(2))
+			},
+			expected: hashmap.HashMap[string, int]{"A": 1},
+		},
+	}
+
+	for _, test := range removeTests {
+		test.action(test.input)
+		assert.True(t, test.input.Equals(test.expected, equals))
+		assert.True(t, test.input.Inverse().Equals(invert(test.expected), equalsString))
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+
+	type removeIfTest struct {
+		input    BiMap[string, int]
+		f        func(string) bool
+		removed  bool
+		expected hashmap.HashMap[string, int]
+	}
+
+	removeIfTests := []removeIfTest{
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2), pair.Of("C", 3)),
+			f:        func(s string) bool { return false },
+			removed:  false,
+			expected: hashmap.HashMap[string, int]{"A": 1, "B": 2, "C": 3},
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2), pair.Of("C", 3)),
+			f:        func(s string) bool { return false },
+			removed:  false,
+			expected: hashmap.HashMap[string, int]{"A": 1, "B": 2, "C": 3},
+		},
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2), pair.Of("C", 3)),
+			f:        func(s string) bool { return s == "A" || s == "B" },
+			removed:  true,
+			expected: hashmap.HashMap[string, int]{"C": 3},
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2), pair.Of("C", 3)),
+			f:        func(s string) bool { return s == "A" || s == "B" },
+			removed:  true,
+			expected: hashmap.HashMap[string, int]{"C": 3},
+		},
+	}
+
+	for _, test := range removeIfTests {
+		assert.Equal(t, test.removed, test.input.RemoveIf(test.f))
+		assert.True(t, test.input.Equals(test.expected, equals))
+		assert.True(t, test.input.Inverse().Equals(invert(test.expected), equalsString))
+	}
+}
+
+func TestContainsKey(t *testing.T) {
+
+	type containsKeyTest struct {
+		input    BiMap[string, int]
+		key      string
+		expected bool
+	}
+
+	containsKeyTests := []containsKeyTest{
+		{input: NewHashBiMap[string, int](), key: "A", expected: false},
+		{input: NewLinkedBiMap[string, int](), key: "A", expected: false},
+		{input: NewHashBiMap(pair.Of("A", 1)), key: "A", expected: true},
+		{input: NewLinkedBiMap(pair.Of("A", 1)), key: "A", expected: true},
+		{input: NewHashBiMap(pair.Of("A", 1)), key: "B", expected: false},
+		{input: NewLinkedBiMap(pair.Of("A", 1)), key: "B", expected: false},
+	}
+
+	for _, test := range containsKeyTests {
+		assert.Equal(t, test.expected, test.input.ContainsKey(test.key))
+		assert.Equal(t, test.expected, test.input.Inverse().ContainsValue(test.key, equalsString))
+	}
+}
+
+func TestContainsValue(t *testing.T) {
+
+	type containsValueTest struct {
+		input    BiMap[string, int]
+		value    int
+		expected bool
+	}
+
+	containsValueTests := []containsValueTest{
+		{input: NewHashBiMap[string, int](), value: 1, expected: false},
+		{input: NewLinkedBiMap[string, int](), value: 1, expected: false},
+		{input: NewHashBiMap(pair.Of("A", 1)), value: 1, expected: true},
+		{input: NewLinkedBiMap(pair.Of("A", 1)), value: 1, expected: true},
+		{input: NewHashBiMap(pair.Of("A", 1)), value: 2, expected: false},
+		{input: NewLinkedBiMap(pair.Of("A", 1)), value: 2, expected: false},
+	}
+
+	for _, test := range containsValueTests {
+		assert.Equal(t, test.expected, test.input.ContainsValue(test.value, equals))
+		assert.Equal(t, test.expected, test.input.Inverse().ContainsKey(test.value))
+	}
+}
+
+func TestClear(t *testing.T) {
+
+	type clearTest struct {
+		input BiMap[string, int]
+	}
+
+	clearTests := []clearTest{
+		{input: NewHashBiMap[string, int]()},
+		{input: NewLinkedBiMap[string, int]()},
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2))},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2))},
+	}
+
+	for _, test := range clearTests {
+		test.input.Clear()
+		assert.True(t, test.input.Empty())
+		assert.True(t, test.input.Inverse().Empty())
+		test.input.Put("C", 1)
+		test.input.Inverse().Clear()
+		assert.True(t, test.input.Empty())
+	}
+}
+
+func TestKeys(t *testing.T) {
+
+	type keysTest struct {
+		input    BiMap[string, int]
+		expected []string
+	}
+
+	keysTests := []keysTest{
+		{input: NewHashBiMap[string, int](), expected: []string{}},
+		{input: NewLinkedBiMap[string, int](), expected: []string{}},
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)), expected: []string{"A", "B"}},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)), expected: []string{"A", "B"}},
+	}
+
+	for _, test := range keysTests {
+		assert.ElementsMatch(t, test.expected, test.input.Keys())
+		assert.ElementsMatch(t, test.expected, test.input.Inverse().Values())
+	}
+}
+
+func TestValues(t *testing.T) {
+
+	type valuesTest struct {
+		input    BiMap[string, int]
+		expected []int
+	}
+
+	valuesTests := []valuesTest{
+		{input: NewHashBiMap[string, int](), expected: []int{}},
+		{input: NewLinkedBiMap[string, int](), expected: []int{}},
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)), expected: []int{1, 2}},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)), expected: []int{1, 2}},
+	}
+
+	for _, test := range valuesTests {
+		assert.ElementsMatch(t, test.expected, test.input.Values())
+		assert.ElementsMatch(t, test.expected, test.input.Inverse().Keys())
+	}
+}
+
+func TestForEach(t *testing.T) {
+
+	type forEachTest struct {
+		input    BiMap[string, int]
+		expected map[string]int
+	}
+
+	forEachTests := []forEachTest{
+		{input: NewHashBiMap[string, int](), expected: map[string]int{}},
+		{input: NewLinkedBiMap[string, int](), expected: map[string]int{}},
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)), expected: map[string]int{"A": 1, "B": 2}},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)), expected: map[string]int{"A": 1, "B": 2}},
+	}
+
+	for _, test := range forEachTests {
+		m := make(map[string]int)
+		test.input.ForEach(func(s string, i int) { m[s] = i })
+		assert.Equal(t, test.expected, m)
+	}
+}
+
+func TestIterator(t *testing.T) {
+
+	type iteratorTest struct {
+		input    BiMap[string, int]
+		expected []pair.Pair[string, int]
+	}
+
+	iteratorTests := []iteratorTest{
+		{input: NewHashBiMap[string, int](), expected: []pair.Pair[string, int]{}},
+		{input: NewLinkedBiMap[string, int](), expected: []pair.Pair[string, int]{}},
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2), pair.Of("C", 3)),
+			expected: []pair.Pair[string, int]{pair.Of("A", 1), pair.Of("B", 2), pair.Of("C", 3)},
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2), pair.Of("C", 3)),
+			expected: []pair.Pair[string, int]{pair.Of("A", 1), pair.Of("B", 2), pair.Of("C", 3)},
+		},
+	}
+
+	for _, test := range iteratorTests {
+		assert.ElementsMatch(t, test.expected, iterator.ToSlice(test.input.Iterator()))
+		inverse := make([]pair.Pair[int, string], 0)
+		for _, entry := range test.expected {
+			inverse = append(inverse, pair.Of(entry.Value(), entry.Key()))
+		}
+		assert.ElementsMatch(t, inverse, iterator.ToSlice(test.input.Inverse().Iterator()))
+	}
+	assert.Equal(t, []pair.Pair[string, int]{pair.Of("B", 2), pair.Of("A", 1)}, iterator.ToSlice(NewLinkedBiMap(pair.Of("B", 2), pair.Of("A", 1)).Iterator()))
+}
+
+func TestString(t *testing.T) {
+
+	assert.Equal(t, "{}", NewHashBiMap[string, int]().String())
+	assert.Equal(t, "{A=1}", NewHashBiMap(pair.Of("A", 1)).String())
+	assert.Equal(t, "{B=2, A=1}", NewLinkedBiMap(pair.Of("B", 2), pair.Of("A", 1)).String())
+	assert.Equal(t, "{2=B, 1=A}", NewLinkedBiMap(pair.Of("B", 2), pair.Of("A", 1)).Inverse().(*LinkedBiMap[int, string]).String())
+}
+
+func TestEquals(t *testing.T) {
+
+	type equalsTest struct {
+		a        BiMap[string, int]
+		b        BiMap[string, int]
+		expected bool
+	}
+
+	equalsTests := []equalsTest{
+		{
+			a:        NewHashBiMap[string, int](),
+			b:        NewLinkedBiMap[string, int](),
+			expected: true,
+		},
+		{
+			a:        NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)),
+			b:        NewLinkedBiMap(pair.Of("B", 2), pair.Of("A", 1)),
+			expected: true,
+		},
+		{
+			a:        NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)),
+			b:        NewHashBiMap(pair.Of("B", 2), pair.Of("A", 1)),
+			expected: true,
+		},
+		{
+			a:        NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)),
+			b:        NewHashBiMap(pair.Of("A", 2), pair.Of("B", 1)),
+			expected: false,
+		},
+		{
+			a:        NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)),
+			b:        NewLinkedBiMap(pair.Of("A", 1)),
+			expected: false,
+		},
+	}
+
+	for _, test := range equalsTests {
+		assert.Equal(t, test.expected, test.a.Equals(test.b, equals))
+		assert.Equal(t, test.expected, test.b.Equals(test.a, equals))
+		assert.Equal(t, test.expected, test.a.Inverse().Equals(test.b.Inverse(), equalsString))
+	}
+}
+
+func TestAll(t *testing.T) {
+
+	type allTest struct {
+		input    BiMap[string, int]
+		expected map[string]int
+	}
+
+	allTests := []allTest{
+		{input: NewHashBiMap[string, int](), expected: map[string]int{}},
+		{input: NewLinkedBiMap[string, int](), expected: map[string]int{}},
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2), pair.Of("C", 3)), expected: map[string]int{"A": 1, "B": 2, "C": 3}},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2), pair.Of("C", 3)), expected: map[string]int{"A": 1, "B": 2, "C": 3}},
+	}
+
+	for _, test := range allTests {
+		assert.Equal(t, test.expected, maps.Collect(test.input.All()))
+		assert.Equal(t, map[int]string(invert(test.expected)), maps.Collect(test.input.Inverse().All()))
+		assert.ElementsMatch(t, slices.Collect(maps.Keys(test.expected)), slices.Collect(test.input.AllKeys()))
+		assert.ElementsMatch(t, slices.Collect(maps.Values(test.expected)), slices.Collect(test.input.AllValues()))
+	}
+
+	count := 0
+	for range NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)).All() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestInverse(t *testing.T) {
+
+	type inverseTest struct {
+		input BiMap[string, int]
+	}
+
+	inverseTests := []inverseTest{
+		{input: NewHashBiMap(pair.Of("A", 1))},
+		{input: NewLinkedBiMap(pair.Of("A", 1))},
+	}
+
+	for _, test := range inverseTests {
+		biMap, inverse := test.input, test.input.Inverse()
+		assert.Same(t, biMap, inverse.Inverse())
+
+		// Changes through either view are reflected in the other.
+		biMap.Put("B", 2)
+		assert.Equal(t, optional.Of("B"), inverse.Get(2))
+		inverse.Put(3, "C")
+		assert.Equal(t, optional.Of(3), biMap.Get("C"))
+		inverse.Put(3, "D")
+		assert.False(t, biMap.ContainsKey("C"))
+		assert.Equal(t, optional.Of(3), biMap.Get("D"))
+		assert.PanicsWithError(t, errors.IllegalArgument("value", "A").Error(), func() { inverse.Put(4, "A") })
+		assert.Equal(t, optional.Empty[string](), inverse.ForcePut(4, "A"))
+		assert.Equal(t, optional.Of(4), biMap.Get("A"))
+		assert.False(t, inverse.ContainsKey(1))
+	}
+}
+
+func TestConcurrentModification(t *testing.T) {
+
+	type concurrentModificationTest struct {
+		input  BiMap[string, int]
+		name   string
+		action func(BiMap[string, int])
+		panics bool
+	}
+
+	concurrentModificationTests := []concurrentModificationTest{
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)), name: "HashBiMap",
+			action: func(biMap BiMap[string, int]) { biMap.Put("C", 3) },
+			panics: true,
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)), name: "LinkedBiMap",
+			action: func(biMap BiMap[string, int]) { biMap.Put("C", 3) },
+			panics: true,
+		},
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)), name: "HashBiMap",
+			action: func(biMap BiMap[string, int]) { biMap.Inverse().Put(3, "C") },
+			panics: true,
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)), name: "LinkedBiMap",
+			action: func(biMap BiMap[string, int]) { biMap.Inverse().Put(3, "C") },
+			panics: true,
+		},
+		// Binding a key to a different value replaces a key of the inverse, which is a structural modification.
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)), name: "HashBiMap",
+			action: func(biMap BiMap[string, int]) { biMap.Put("A", 4) },
+			panics: true,
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)), name: "LinkedBiMap",
+			action: func(biMap BiMap[string, int]) { biMap.Put("A", 4) },
+			panics: true,
+		},
+		// Binding a key to the value it already has is not a modification.
+		{input: NewHashBiMap(pair.Of("A", 1), pair.Of("B", 2)), name: "HashBiMap",
+			action: func(biMap BiMap[string, int]) { biMap.Put("A", 1) },
+			panics: false,
+		},
+		{input: NewLinkedBiMap(pair.Of("A", 1), pair.Of("B", 2)), name: "LinkedBiMap",
+			action: func(biMap BiMap[string, int]) { biMap.Put("A", 1) },
+			panics: false,
+		},
+	}
+
+	for _, test := range concurrentModificationTests {
+		it, inverseIt := test.input.Iterator(), test.input.Inverse().Iterator()
+		it.Next()
+		inverseIt.Next()
+		test.action(test.input)
+		if test.panics {
+			assert.PanicsWithError(t, errors.ConcurrentModification(test.name).Error(), func() { it.Next() })
+			assert.PanicsWithError(t, errors.ConcurrentModification(test.name).Error(), func() { inverseIt.Next() })
+		} else {
+			assert.NotPanics(t, func() { it.Next() })
+			assert.NotPanics(t, func() { inverseIt.Next() })
+		}
+	}
+}
+
+func TestRemovableIterator(t *testing.T) {
+
+	type removableIteratorTest struct {
+		input BiMap[int, int]
+		name  string
+	}
+
+	removableIteratorTests := []removableIteratorTest{
+		{input: NewHashBiMap(pair.Of(1, 10), pair.Of(2, 20), pair.Of(3, 30), pair.Of(4, 40)), name: "HashBiMap"},
+		{input: NewLinkedBiMap(pair.Of(1, 10), pair.Of(2, 20), pair.Of(3, 30), pair.Of(4, 40)), name: "LinkedBiMap"},
+	}
+
+	for _, test := range removableIteratorTests {
+		biMap := test.input
+		it := biMap.RemovableIterator()
+		assert.PanicsWithError(t, errors.IllegalState("Remove", test.name).Error(), func() { it.Remove() })
+		for it.HasNext() {
+			if entry := it.Next(); entry.Key()%2 == 0 {
+				it.Remove()
+			}
+		}
+		assert.ElementsMatch(t, []int{1, 3}, biMap.Keys())
+		assert.ElementsMatch(t, []int{10, 30}, biMap.Inverse().Keys())
+
+		// A removed value is free to be bound to another key.
+		biMap.Put(5, 20)
+		assert.Equal(t, optional.Of(5), biMap.Inverse().Get(20))
+
+		it = biMap.Inverse().RemovableIterator()
+		it.Next()
+		it.Remove()
+		assert.PanicsWithError(t, errors.IllegalState("Remove", test.name).Error(), func() { it.Remove() })
+		assert.Equal(t, 2, biMap.Len())
+		assert.Equal(t, 2, biMap.Inverse().Len())
+		biMap.Put(6, 60)
+		assert.PanicsWithError(t, errors.ConcurrentModification(test.name).Error(), func() { it.Next() })
+	}
+}
+
+// invert returns a map from each value of the given map to its key.
+func invert(m hashmap.HashMap[string, int]) hashmap.HashMap[int, string] {
+	inverse := hashmap.HashMap[int, string]{}
+	for k, v := range m {
+		inverse[v] = k
+	}
+	return inverse
+}
+
+func equals(i1, i2 int) bool {
+	return i1 == i2
+}
+
+func equalsString(s1, s2 string) bool {
+	return s1 == s2
+}
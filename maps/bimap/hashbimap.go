@@ -0,0 +1,44 @@
+package bimap
+
+import (
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/maps/linkedhashmap"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// HashBiMap a [BiMap] that keeps both of its directions in a [hashmap.HashMap], entries are iterated on in no particular order.
+type HashBiMap[K comparable, V comparable] struct {
+	biMap[K, V]
+}
+
+// NewHashBiMap creates a bimap with the given key, value pairs. Panics if two of the pairs have the same value and different keys.
+func NewHashBiMap[K comparable, V comparable](pairs ...pair.Pair[K, V]) *HashBiMap[K, V] {
+	forward, backward := hashmap.New[K, V](), hashmap.New[V, K]()
+	modCount := 0
+	hashBiMap := HashBiMap[K, V]{biMap[K, V]{forward: forward, backward: backward, name: "HashBiMap", modCount: &modCount}}
+	inverse := HashBiMap[V, K]{biMap[V, K]{forward: backward, backward: forward, name: "HashBiMap", modCount: &modCount}}
+	hashBiMap.inverse, inverse.inverse = &inverse, &hashBiMap
+	for _, pair := range pairs {
+		hashBiMap.Put(pair.Key(), pair.Value())
+	}
+	return &hashBiMap
+}
+
+// LinkedBiMap a [BiMap] that keeps both of its directions in a [linkedhashmap.LinkedHashMap], entries are iterated on in the order in
+// which they were inserted. The inverse is iterated on in the order in which its entries were inserted through either view.
+type LinkedBiMap[K comparable, V comparable] struct {
+	biMap[K, V]
+}
+
+// NewLinkedBiMap creates a bimap with the given key, value pairs. Panics if two of the pairs have the same value and different keys.
+func NewLinkedBiMap[K comparable, V comparable](pairs ...pair.Pair[K, V]) *LinkedBiMap[K, V] {
+	forward, backward := linkedhashmap.New[K, V](), linkedhashmap.New[V, K]()
+	modCount := 0
+	linkedBiMap := LinkedBiMap[K, V]{biMap[K, V]{forward: forward, backward: backward, name: "LinkedBiMap", modCount: &modCount}}
+	inverse := LinkedBiMap[V, K]{biMap[V, K]{forward: backward, backward: forward, name: "LinkedBiMap", modCount: &modCount}}
+	linkedBiMap.inverse, inverse.inverse = &inverse, &linkedBiMap
+	for _, pair := range pairs {
+		linkedBiMap.Put(pair.Key(), pair.Value())
+	}
+	return &linkedBiMap
+}
@@ -3,6 +3,7 @@ package hashmap
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/phantom820/collections"
@@ -150,17 +151,58 @@ func (hashMap HashMap[K, V]) ForEach(f func(K, V)) {
 	}
 }
 
-// Iterator returns an iterator over the map.
+// Iterator returns an iterator over the map. Since a map has no modification count the iterator detects structural modifications by
+// a change in the number of entries, Next panics if entries are added or removed after iteration has started.
 func (hashMap HashMap[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
 	return &mapIterator[K, V]{initialized: false, index: 0, hashMap: hashMap, entries: make([]pair.Pair[K, V], 0)}
 }
 
+// RemovableIterator returns an iterator over the map that can remove the last returned entry from the map.
+func (hashMap HashMap[K, V]) RemovableIterator() iterator.RemovableIterator[pair.Pair[K, V]] {
+	return &mapIterator[K, V]{initialized: false, index: 0, hashMap: hashMap, entries: make([]pair.Pair[K, V], 0)}
+}
+
+// All returns a sequence over the key, value pairs in the map.
+func (hashMap HashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, value := range hashMap {
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// AllKeys returns a sequence over the keys in the map.
+func (hashMap HashMap[K, V]) AllKeys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range hashMap {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// AllValues returns a sequence over the values in the map.
+func (hashMap HashMap[K, V]) AllValues() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range hashMap {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
 // mapIterator implementation of an mapIterator for [HashMap].
 type mapIterator[K comparable, V any] struct {
 	initialized bool
 	index       int
 	hashMap     map[K]V
 	entries     []pair.Pair[K, V]
+	len         int  // The expected number of entries in the map.
+	removable   bool // Whether the last returned entry can be removed.
 }
 
 // HasNext returns true if the iterator has more elements.
@@ -173,6 +215,7 @@ func (it *mapIterator[K, V]) HasNext() bool {
 		for key, value := range it.hashMap {
 			it.entries = append(it.entries, pair.Of(key, value))
 		}
+		it.len = len(it.entries)
 	}
 	return it.index < len(it.entries)
 
@@ -182,12 +225,27 @@ func (it *mapIterator[K, V]) HasNext() bool {
 func (it *mapIterator[K, V]) Next() pair.Pair[K, V] {
 	if !it.HasNext() {
 		panic(errors.NoSuchElement())
+	} else if len(it.hashMap) != it.len {
+		panic(errors.ConcurrentModification("HashMap"))
 	}
 	index := it.index
 	it.index++
+	it.removable = true
 	return it.entries[index]
 }
 
+// Remove removes the last entry returned by Next from the map. Remove can only be called once per call to Next.
+func (it *mapIterator[K, V]) Remove() {
+	if !it.removable {
+		panic(errors.IllegalState("Remove", "HashMap"))
+	} else if len(it.hashMap) != it.len {
+		panic(errors.ConcurrentModification("HashMap"))
+	}
+	delete(it.hashMap, it.entries[it.index-1].Key())
+	it.len--
+	it.removable = false
+}
+
 // String returns the string representation of the map.
 func (hashMap HashMap[K, V]) String() string {
 	var sb strings.Builder
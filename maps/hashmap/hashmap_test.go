@@ -1,6 +1,9 @@
 package hashmap
 
 import (
+	"github.com/phantom820/collections/errors"
+	"maps"
+	"slices"
 	"testing"
 
 	"github.com/phantom820/collections"
@@ -438,3 +441,56 @@ func TestEquals(t *testing.T) {
 
 	}
 }
+
+func TestAll(t *testing.T) {
+
+	hashMap := New(pair.Of("A", 1), pair.Of("B", 2), pair.Of("C", 3))
+
+	assert.Equal(t, map[string]int{}, maps.Collect(New[string, int]().All()))
+	assert.Equal(t, map[string]int{"A": 1, "B": 2, "C": 3}, maps.Collect(hashMap.All()))
+	assert.ElementsMatch(t, []string{"A", "B", "C"}, slices.Collect(hashMap.AllKeys()))
+	assert.ElementsMatch(t, []int{1, 2, 3}, slices.Collect(hashMap.AllValues()))
+
+	count := 0
+	for range hashMap.All() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestConcurrentModification(t *testing.T) {
+
+	hashMap := New[int, int](pair.Of(1, 1), pair.Of(2, 2))
+	it := hashMap.Iterator()
+	it.Next()
+	hashMap.Put(3, 3)
+	assert.PanicsWithError(t, errors.ConcurrentModification("HashMap").Error(), func() { it.Next() })
+
+	// updating the value of a mapped key is not a structural modification.
+	hashMap = New[int, int](pair.Of(1, 1), pair.Of(2, 2))
+	it = hashMap.Iterator()
+	it.Next()
+	hashMap.Put(1, 3)
+	assert.NotPanics(t, func() { it.Next() })
+}
+
+func TestRemovableIterator(t *testing.T) {
+
+	hashMap := New[int, int](pair.Of(1, 1), pair.Of(2, 2), pair.Of(3, 3), pair.Of(4, 4))
+	it := hashMap.RemovableIterator()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "HashMap").Error(), func() { it.Remove() })
+	for it.HasNext() {
+		if entry := it.Next(); entry.Key()%2 == 0 {
+			it.Remove()
+		}
+	}
+	assert.ElementsMatch(t, []int{1, 3}, hashMap.Keys())
+
+	it = hashMap.RemovableIterator()
+	it.Next()
+	it.Remove()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "HashMap").Error(), func() { it.Remove() })
+	hashMap.Put(5, 5)
+	assert.PanicsWithError(t, errors.ConcurrentModification("HashMap").Error(), func() { it.Next() })
+}
@@ -1,8 +1,10 @@
-// package linkedhashmap defines a map implementation in which entries are iterated in the order they were inserted.
+// package linkedhashmap defines a map implementation in which entries are iterated in the order they were inserted, or optionally in
+// the order they were last accessed.
 package linkedhashmap
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/phantom820/collections"
@@ -26,9 +28,12 @@ func newNode[K comparable, V any](key K, value V) *node[K, V] {
 
 // LinkedHashMap implementation of a map with a predictable order of iteration.
 type LinkedHashMap[K comparable, V any] struct {
-	head    *node[K, V]
-	hashMap hashmap.HashMap[K, *node[K, V]]
-	tail    *node[K, V]
+	head         *node[K, V]
+	hashMap      hashmap.HashMap[K, *node[K, V]]
+	tail         *node[K, V]
+	modCount     int                                        // The number of structural modifications, used to detect modifications during iteration.
+	accessOrder  bool                                       // Whether entries are ordered by access instead of insertion.
+	removeEldest func(eldest pair.Pair[K, V], len int) bool // Decides whether the eldest entry is removed after an insertion.
 }
 
 // New creates a map with the given key, value pairs.
@@ -40,6 +45,58 @@ func New[K comparable, V any](pairs ...pair.Pair[K, V]) *LinkedHashMap[K, V] {
 	return &linkedHashMap
 }
 
+// NewAccessOrder creates a map with the given key, value pairs in which entries are iterated over from the least recently accessed to the
+// most recently accessed. Get, Put and PutIfAbsent on a mapped key count as an access and move the entry to the back of the order.
+func NewAccessOrder[K comparable, V any](pairs ...pair.Pair[K, V]) *LinkedHashMap[K, V] {
+	linkedHashMap := LinkedHashMap[K, V]{hashMap: hashmap.New[K, *node[K, V]](), accessOrder: true}
+	for _, pair := range pairs {
+		linkedHashMap.Put(pair.Key(), pair.Value())
+	}
+	return &linkedHashMap
+}
+
+// SetRemoveEldest sets the predicate consulted after a new key is added to the map. The predicate is given the eldest entry, the first
+// entry in the order of iteration, and the length of the map, the eldest entry is removed if it returns true. This allows the map to act
+// as a bounded cache, i.e an access ordered map with a predicate that checks len > capacity is an LRU cache.
+func (linkedHashMap *LinkedHashMap[K, V]) SetRemoveEldest(f func(eldest pair.Pair[K, V], len int) bool) {
+	linkedHashMap.removeEldest = f
+}
+
+// AccessOrder returns true if entries are ordered by access instead of insertion.
+func (linkedHashMap *LinkedHashMap[K, V]) AccessOrder() bool {
+	return linkedHashMap.accessOrder
+}
+
+// access moves the node to the back of the order if the map is access ordered.
+func (linkedHashMap *LinkedHashMap[K, V]) access(node *node[K, V]) {
+	if !linkedHashMap.accessOrder || node == linkedHashMap.tail {
+		return
+	}
+	if node == linkedHashMap.head {
+		linkedHashMap.head = node.next
+		linkedHashMap.head.prev = nil
+	} else {
+		node.prev.next = node.next
+		node.next.prev = node.prev
+	}
+	node.prev = linkedHashMap.tail
+	node.prev.next = node
+	node.next = nil
+	linkedHashMap.tail = node
+	linkedHashMap.modCount++
+}
+
+// afterInsertion removes the eldest entry if the remove eldest predicate asks for it.
+func (linkedHashMap *LinkedHashMap[K, V]) afterInsertion() {
+	if linkedHashMap.removeEldest == nil || linkedHashMap.head == nil {
+		return
+	}
+	eldest := linkedHashMap.head
+	if linkedHashMap.removeEldest(pair.Of(eldest.key, eldest.value), linkedHashMap.Len()) {
+		linkedHashMap.Remove(eldest.key)
+	}
+}
+
 // Put adds a new key/value pair to the map and optionally returns previously bound value.
 func (linkedHashMap *LinkedHashMap[K, V]) Put(key K, value V) optional.Optional[V] {
 	if linkedHashMap.Empty() {
@@ -47,11 +104,14 @@ func (linkedHashMap *LinkedHashMap[K, V]) Put(key K, value V) optional.Optional[
 		linkedHashMap.head = node
 		linkedHashMap.tail = node
 		linkedHashMap.hashMap.Put(key, node)
+		linkedHashMap.modCount++
+		linkedHashMap.afterInsertion()
 		return optional.Empty[V]()
 	} else if storedNode, ok := linkedHashMap.hashMap[key]; ok {
 		// The key is already mapped and we swap out the value.
 		storedValue := storedNode.value
 		storedNode.value = value
+		linkedHashMap.access(storedNode)
 		return optional.Of(storedValue)
 	}
 	// Effectively inserting at the back of a linked list.
@@ -61,29 +121,41 @@ func (linkedHashMap *LinkedHashMap[K, V]) Put(key K, value V) optional.Optional[
 	node.next = nil
 	linkedHashMap.tail = node
 	linkedHashMap.hashMap.Put(key, node)
+	linkedHashMap.modCount++
+	linkedHashMap.afterInsertion()
 	return optional.Empty[V]()
 }
 
 // PutIfAbsent  adds a new key/value pair to the map if the key is not already bounded and optionally returns bound value.
 func (linkedHashMap *LinkedHashMap[K, V]) PutIfAbsent(key K, value V) optional.Optional[V] {
 	if storedValue, ok := linkedHashMap.hashMap[key]; ok {
+		linkedHashMap.access(storedValue)
 		return optional.Of(storedValue.value)
 	}
 	linkedHashMap.Put(key, value)
 	return optional.Empty[V]()
 }
 
-// Get optionally returns the value associated with a key.
+// Get optionally returns the value associated with a key. In an access ordered map Get moves the entry to the back of the order.
 func (linkedHashMap *LinkedHashMap[K, V]) Get(key K) optional.Optional[V] {
 	node := linkedHashMap.hashMap.Get(key)
 	if node.Empty() {
 		return optional.Empty[V]()
 	}
+	linkedHashMap.access(node.Value())
 	return optional.Of(node.Value().value)
 }
 
+// Peek optionally returns the value associated with a key. Unlike Get, Peek does not count as an access in an access ordered map.
+func (linkedHashMap *LinkedHashMap[K, V]) Peek(key K) optional.Optional[V] {
+	if node, ok := linkedHashMap.hashMap[key]; ok {
+		return optional.Of(node.value)
+	}
+	return optional.Empty[V]()
+}
+
 // GetIf returns the values mapped by keys that match the given predicate.
-func (linkedHashMap LinkedHashMap[K, V]) GetIf(f func(K) bool) []V {
+func (linkedHashMap *LinkedHashMap[K, V]) GetIf(f func(K) bool) []V {
 	values := make([]V, 0)
 	for curr := linkedHashMap.head; curr != nil; curr = curr.next {
 		if f(curr.key) {
@@ -100,7 +172,9 @@ func (linkedHashMap *LinkedHashMap[K, V]) Remove(key K) optional.Optional[V] {
 	delete(linkedHashMap.hashMap, key)
 	if !ok {
 		return optional.Empty[V]()
-	} else if node == linkedHashMap.head {
+	}
+	linkedHashMap.modCount++
+	if node == linkedHashMap.head {
 		linkedHashMap.head = node.next
 		node.next = nil
 		node.prev = nil
@@ -162,6 +236,7 @@ func (linkedHashMap *LinkedHashMap[K, V]) Clear() {
 	linkedHashMap.hashMap.Clear()
 	linkedHashMap.head = nil
 	linkedHashMap.tail = nil
+	linkedHashMap.modCount++
 }
 
 // Keys returns a slice containing the keys in the map.
@@ -202,23 +277,81 @@ func (linkedHashMap *LinkedHashMap[K, V]) ForEach(f func(K, V)) {
 	}
 }
 
-// Iterator returns an iterator over the map. Elements are iterated over following their insertion order.
-func (linkedHashMap LinkedHashMap[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
-	return &mapIterator[K, V]{initialized: false, initialize: func() *node[K, V] { return linkedHashMap.head }}
+// Iterator returns an iterator over the map. Elements are iterated over following their insertion order. The iterator is fail fast,
+// Next panics if the map is structurally modified after iteration has started.
+func (linkedHashMap *LinkedHashMap[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
+	return &mapIterator[K, V]{initialized: false, linkedHashMap: linkedHashMap}
+}
+
+// RemovableIterator returns an iterator over the map that can remove the last returned entry from the map. Elements are iterated
+// over following their insertion order.
+func (linkedHashMap *LinkedHashMap[K, V]) RemovableIterator() iterator.RemovableIterator[pair.Pair[K, V]] {
+	return &mapIterator[K, V]{initialized: false, linkedHashMap: linkedHashMap}
+}
+
+// nodes returns a sequence over the nodes of the map following their insertion order. The sequence panics if the map is structurally
+// modified while ranging over it.
+func (linkedHashMap *LinkedHashMap[K, V]) nodes() iter.Seq[*node[K, V]] {
+	return func(yield func(*node[K, V]) bool) {
+		modCount := linkedHashMap.modCount
+		for curr := linkedHashMap.head; curr != nil; curr = curr.next {
+			if !yield(curr) {
+				return
+			} else if modCount != linkedHashMap.modCount {
+				panic(errors.ConcurrentModification("LinkedHashMap"))
+			}
+		}
+	}
+}
+
+// All returns a sequence over the key, value pairs in the map. Entries are yielded following their insertion order.
+func (linkedHashMap *LinkedHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := range linkedHashMap.nodes() {
+			if !yield(node.key, node.value) {
+				return
+			}
+		}
+	}
+}
+
+// AllKeys returns a sequence over the keys in the map. Keys are yielded following their insertion order.
+func (linkedHashMap *LinkedHashMap[K, V]) AllKeys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for node := range linkedHashMap.nodes() {
+			if !yield(node.key) {
+				return
+			}
+		}
+	}
+}
+
+// AllValues returns a sequence over the values in the map. Values are yielded following the insertion order of their keys.
+func (linkedHashMap *LinkedHashMap[K, V]) AllValues() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for node := range linkedHashMap.nodes() {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
 }
 
 // mapIterator implementation of an mapIterator for [LinkedHashMap].
 type mapIterator[K comparable, V any] struct {
-	initialized bool
-	initialize  func() *node[K, V]
-	head        *node[K, V]
+	initialized   bool
+	linkedHashMap *LinkedHashMap[K, V]
+	modCount      int // The expected modification count of the map.
+	head          *node[K, V]
+	last          *node[K, V] // The last node returned by Next, nil if there is none.
 }
 
 // HasNext returns true if the iterator has more elements.
 func (it *mapIterator[K, V]) HasNext() bool {
 	if !it.initialized {
 		it.initialized = true
-		it.head = it.initialize()
+		it.head = it.linkedHashMap.head
+		it.modCount = it.linkedHashMap.modCount
 	}
 	if it.head != nil {
 		return true
@@ -230,12 +363,27 @@ func (it *mapIterator[K, V]) HasNext() bool {
 func (it *mapIterator[K, V]) Next() pair.Pair[K, V] {
 	if !it.HasNext() {
 		panic(errors.NoSuchElement())
+	} else if it.modCount != it.linkedHashMap.modCount {
+		panic(errors.ConcurrentModification("LinkedHashMap"))
 	}
 	entry := pair.Of(it.head.key, it.head.value)
+	it.last = it.head
 	it.head = it.head.next
 	return entry
 }
 
+// Remove removes the last entry returned by Next from the map. Remove can only be called once per call to Next.
+func (it *mapIterator[K, V]) Remove() {
+	if it.last == nil {
+		panic(errors.IllegalState("Remove", "LinkedHashMap"))
+	} else if it.modCount != it.linkedHashMap.modCount {
+		panic(errors.ConcurrentModification("LinkedHashMap"))
+	}
+	it.linkedHashMap.Remove(it.last.key)
+	it.modCount = it.linkedHashMap.modCount
+	it.last = nil
+}
+
 // String returns the string representation of the map.
 func (linkedHashMap *LinkedHashMap[K, V]) String() string {
 	var sb strings.Builder
@@ -254,13 +402,15 @@ func (linkedHashMap *LinkedHashMap[K, V]) String() string {
 // Equals return true if the map is is equal to the given map. Two maps are equal if they contain the same
 // key, value pairs.
 func (linkedHashMap *LinkedHashMap[K, V]) Equals(other collections.Map[K, V], equals func(V, V) bool) bool {
-	if linkedHashMap.Len() != other.Len() {
+	if collections.Map[K, V](linkedHashMap) == other {
+		return true
+	} else if linkedHashMap.Len() != other.Len() {
 		return false
 	}
 	it := other.Iterator()
 	for it.HasNext() {
 		pair := it.Next()
-		result := linkedHashMap.Get(pair.Key())
+		result := linkedHashMap.Peek(pair.Key())
 		if result.Empty() {
 			return false
 		} else if !equals(pair.Value(), result.Value()) {
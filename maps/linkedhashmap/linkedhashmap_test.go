@@ -2,9 +2,12 @@ package linkedhashmap
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"testing"
 
 	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
 	"github.com/phantom820/collections/iterator"
 	"github.com/phantom820/collections/maps/hashmap"
 	"github.com/phantom820/collections/types/optional"
@@ -595,3 +598,161 @@ func TestEquals(t *testing.T) {
 
 	}
 }
+
+func TestAll(t *testing.T) {
+
+	linkedHashMap := New(pair.Of("C", 3), pair.Of("A", 1), pair.Of("B", 2))
+
+	assert.Equal(t, map[string]int{}, maps.Collect(New[string, int]().All()))
+	assert.Equal(t, map[string]int{"A": 1, "B": 2, "C": 3}, maps.Collect(linkedHashMap.All()))
+	assert.Equal(t, []string{"C", "A", "B"}, slices.Collect(linkedHashMap.AllKeys()))
+	assert.Equal(t, []int{3, 1, 2}, slices.Collect(linkedHashMap.AllValues()))
+
+	keys := make([]string, 0)
+	for key := range linkedHashMap.All() {
+		if key == "B" {
+			break
+		}
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []string{"C", "A"}, keys)
+}
+
+func TestConcurrentModification(t *testing.T) {
+
+	type concurrentModificationTest struct {
+		modify func(linkedHashMap *LinkedHashMap[int, int])
+	}
+
+	concurrentModificationTests := []concurrentModificationTest{
+		{modify: func(linkedHashMap *LinkedHashMap[int, int]) { linkedHashMap.Put(4, 4) }},
+		{modify: func(linkedHashMap *LinkedHashMap[int, int]) { linkedHashMap.Remove(2) }},
+		{modify: func(linkedHashMap *LinkedHashMap[int, int]) {
+			linkedHashMap.RemoveIf(func(k int) bool { return k > 1 })
+		}},
+		{modify: func(linkedHashMap *LinkedHashMap[int, int]) { linkedHashMap.Clear() }},
+	}
+
+	for _, test := range concurrentModificationTests {
+		linkedHashMap := New[int, int](pair.Of(1, 1), pair.Of(2, 2), pair.Of(3, 3))
+		it := linkedHashMap.Iterator()
+		it.Next()
+		test.modify(linkedHashMap)
+		assert.PanicsWithError(t, errors.ConcurrentModification("LinkedHashMap").Error(), func() { it.Next() })
+		assert.PanicsWithError(t, errors.ConcurrentModification("LinkedHashMap").Error(), func() {
+			linkedHashMap := New[int, int](pair.Of(1, 1), pair.Of(2, 2), pair.Of(3, 3))
+			for range linkedHashMap.AllKeys() {
+				test.modify(linkedHashMap)
+			}
+		})
+	}
+
+	// updating the value of a mapped key is not a structural modification.
+	linkedHashMap := New[int, int](pair.Of(1, 1), pair.Of(2, 2))
+	it := linkedHashMap.Iterator()
+	it.Next()
+	linkedHashMap.Put(1, 3)
+	assert.Equal(t, pair.Of(2, 2), it.Next())
+}
+
+func TestRemovableIterator(t *testing.T) {
+
+	m := New[int, int](pair.Of(1, 1), pair.Of(2, 2), pair.Of(3, 3), pair.Of(4, 4))
+	it := m.RemovableIterator()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "LinkedHashMap").Error(), func() { it.Remove() })
+	for it.HasNext() {
+		if entry := it.Next(); entry.Key()%2 == 0 {
+			it.Remove()
+		}
+	}
+	assert.Equal(t, []int{1, 3}, m.Keys())
+
+	it = m.RemovableIterator()
+	assert.Equal(t, pair.Of(1, 1), it.Next())
+	it.Remove()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "LinkedHashMap").Error(), func() { it.Remove() })
+	assert.Equal(t, pair.Of(3, 3), it.Next())
+	m.Put(5, 5)
+	assert.PanicsWithError(t, errors.ConcurrentModification("LinkedHashMap").Error(), func() { it.Remove() })
+}
+
+func TestAccessOrder(t *testing.T) {
+
+	m := NewAccessOrder(pair.Of(1, "a"), pair.Of(2, "b"), pair.Of(3, "c"), pair.Of(4, "d"))
+	assert.True(t, m.AccessOrder())
+	assert.False(t, New[int, string]().AccessOrder())
+
+	type accessOrderTest struct {
+		access   func()
+		expected []int
+	}
+
+	accessOrderTests := []accessOrderTest{
+		{access: func() {}, expected: []int{1, 2, 3, 4}},
+		{access: func() { m.Get(1) }, expected: []int{2, 3, 4, 1}},
+		{access: func() { m.Get(1) }, expected: []int{2, 3, 4, 1}},
+		{access: func() { m.Get(5) }, expected: []int{2, 3, 4, 1}},
+		{access: func() { m.Put(3, "C") }, expected: []int{2, 4, 1, 3}},
+		{access: func() { m.PutIfAbsent(2, "B") }, expected: []int{4, 1, 3, 2}},
+		{access: func() { m.Put(5, "e") }, expected: []int{4, 1, 3, 2, 5}},
+		{access: func() { m.Remove(1) }, expected: []int{4, 3, 2, 5}},
+		{access: func() { m.Get(3) }, expected: []int{4, 2, 5, 3}},
+		{access: func() { m.ContainsKey(4) }, expected: []int{4, 2, 5, 3}},
+	}
+
+	for _, test := range accessOrderTests {
+		test.access()
+		assert.Equal(t, test.expected, m.Keys())
+	}
+	assert.Equal(t, "{4=d, 2=b, 5=e, 3=C}", m.String())
+	assert.True(t, m.Equals(m, func(a, b string) bool { return a == b }))
+
+	// Accessing an entry in an access ordered map is a structural modification.
+	it := m.Iterator()
+	it.Next()
+	m.Get(4)
+	assert.PanicsWithError(t, errors.ConcurrentModification("LinkedHashMap").Error(), func() { it.Next() })
+
+	// Insertion ordered maps are not affected by access.
+	insertionOrdered := New(pair.Of(1, "a"), pair.Of(2, "b"))
+	insertionOrdered.Get(1)
+	insertionOrdered.Put(1, "A")
+	assert.Equal(t, []int{1, 2}, insertionOrdered.Keys())
+}
+
+func TestRemoveEldest(t *testing.T) {
+
+	capacity := 3
+	evicted := make([]int, 0)
+	cache := NewAccessOrder[int, string]()
+	cache.SetRemoveEldest(func(eldest pair.Pair[int, string], len int) bool {
+		if len > capacity {
+			evicted = append(evicted, eldest.Key())
+			return true
+		}
+		return false
+	})
+
+	cache.Put(1, "a")
+	cache.Put(2, "b")
+	cache.Put(3, "c")
+	cache.Get(1)
+	cache.Put(4, "d")
+	assert.Equal(t, []int{3, 1, 4}, cache.Keys())
+	assert.Equal(t, []int{2}, evicted)
+
+	cache.Put(3, "C")
+	cache.Put(5, "e")
+	assert.Equal(t, []int{4, 3, 5}, cache.Keys())
+	assert.Equal(t, []int{2, 1}, evicted)
+	assert.Equal(t, 3, cache.Len())
+
+	// Removing the eldest entry also applies to insertion ordered maps.
+	fifo := New[int, int]()
+	fifo.SetRemoveEldest(func(eldest pair.Pair[int, int], len int) bool { return len > 2 })
+	for i := 0; i < 5; i++ {
+		fifo.Put(i, i)
+	}
+	fifo.Put(3, 30)
+	assert.Equal(t, []int{3, 4}, fifo.Keys())
+}
@@ -0,0 +1,435 @@
+// package persistenthashmap defines an immutable hash map in which every modification produces a new version of the map that shares
+// all of its unchanged parts with the version it was derived from.
+package persistenthashmap
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/hashing"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+)
+
+const (
+	levelBits = 5 // The number of bits of a hash consumed by each level of the trie.
+	mask      = 1<<levelBits - 1
+)
+
+// entry a key, value pair stored in a [PersistentHashMap] along with the hash of its key.
+type entry[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	value V
+}
+
+// child a slot of a node, either a subtree or a bucket of entries. A bucket holds more than one entry only when their keys have the same
+// hash.
+type child[K comparable, V any] struct {
+	node    *node[K, V]
+	entries []entry[K, V]
+}
+
+// node a bitmap indexed node of the trie. Bit i of the bitmap is set if the node has a child for the 5 bit hash fragment i, children are
+// stored compactly in the order of their fragments. Nodes are never modified once they are part of a map.
+type node[K comparable, V any] struct {
+	bitmap   uint32
+	children []child[K, V]
+}
+
+// index returns the bit of the given hash fragment and the position of its child.
+func (n *node[K, V]) index(hash uint64, shift int) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & mask)
+	return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+// assoc returns a copy of the node with the entry added or its value replaced and whether a new key was added.
+func (n *node[K, V]) assoc(shift int, e entry[K, V]) (*node[K, V], bool) {
+	bit, i := n.index(e.hash, shift)
+	if n.bitmap&bit == 0 {
+		return n.with(bit, i, child[K, V]{entries: []entry[K, V]{e}}), true
+	}
+	c := n.children[i]
+	if c.node != nil {
+		subNode, added := c.node.assoc(shift+levelBits, e)
+		return n.replace(i, child[K, V]{node: subNode}), added
+	} else if c.entries[0].hash != e.hash {
+		// Two different hashes share the fragment, they are pushed down to a level at which their fragments differ.
+		subNode, _ := (&node[K, V]{}).insert(shift+levelBits, c).assoc(shift+levelBits, e)
+		return n.replace(i, child[K, V]{node: subNode}), true
+	}
+	entries := slices.Clone(c.entries)
+	for j := range entries {
+		if entries[j].key == e.key {
+			entries[j] = e
+			return n.replace(i, child[K, V]{entries: entries}), false
+		}
+	}
+	return n.replace(i, child[K, V]{entries: append(entries, e)}), true
+}
+
+// insert returns a copy of the node with the bucket added.
+func (n *node[K, V]) insert(shift int, bucket child[K, V]) *node[K, V] {
+	bit, i := n.index(bucket.entries[0].hash, shift)
+	return n.with(bit, i, bucket)
+}
+
+// without returns a copy of the node with the key removed, nil if the node becomes empty, and whether the key was removed.
+func (n *node[K, V]) without(shift int, hash uint64, key K) (*node[K, V], bool) {
+	bit, i := n.index(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	c := n.children[i]
+	if c.node != nil {
+		subNode, removed := c.node.without(shift+levelBits, hash, key)
+		if !removed {
+			return n, false
+		} else if subNode == nil {
+			return n.remove(bit, i), true
+		} else if len(subNode.children) == 1 && subNode.children[0].node == nil {
+			// A subtree left with a single bucket collapses into it, which keeps the trie as shallow as possible.
+			return n.replace(i, subNode.children[0]), true
+		}
+		return n.replace(i, child[K, V]{node: subNode}), true
+	}
+	j := slices.IndexFunc(c.entries, func(e entry[K, V]) bool { return e.key == key })
+	if j < 0 {
+		return n, false
+	} else if len(c.entries) == 1 {
+		return n.remove(bit, i), true
+	}
+	return n.replace(i, child[K, V]{entries: slices.Delete(slices.Clone(c.entries), j, j+1)}), true
+}
+
+// with returns a copy of the node with the child inserted at the given position.
+func (n *node[K, V]) with(bit uint32, i int, c child[K, V]) *node[K, V] {
+	return &node[K, V]{bitmap: n.bitmap | bit, children: slices.Insert(slices.Clone(n.children), i, c)}
+}
+
+// replace returns a copy of the node with the child at the given position replaced.
+func (n *node[K, V]) replace(i int, c child[K, V]) *node[K, V] {
+	children := slices.Clone(n.children)
+	children[i] = c
+	return &node[K, V]{bitmap: n.bitmap, children: children}
+}
+
+// remove returns a copy of the node without the child at the given position, or nil if that was its only child.
+func (n *node[K, V]) remove(bit uint32, i int) *node[K, V] {
+	if len(n.children) == 1 {
+		return nil
+	}
+	return &node[K, V]{bitmap: n.bitmap &^ bit, children: slices.Delete(slices.Clone(n.children), i, i+1)}
+}
+
+// get returns the entry of the key.
+func (n *node[K, V]) get(hash uint64, key K) (entry[K, V], bool) {
+	for shift := 0; n != nil; shift += levelBits {
+		bit, i := n.index(hash, shift)
+		if n.bitmap&bit == 0 {
+			break
+		} else if c := n.children[i]; c.node != nil {
+			n = c.node
+			continue
+		} else {
+			for _, e := range c.entries {
+				if e.key == key {
+					return e, true
+				}
+			}
+			break
+		}
+	}
+	return entry[K, V]{}, false
+}
+
+// all returns a sequence over the entries below the node.
+func (n *node[K, V]) all(yield func(entry[K, V]) bool) bool {
+	for _, c := range n.children {
+		if c.node != nil {
+			if !c.node.all(yield) {
+				return false
+			}
+			continue
+		}
+		for _, e := range c.entries {
+			if !yield(e) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// PersistentHashMap an immutable map backed by a hash array mapped trie (HAMT). The hash of a key is consumed 5 bits at a time, each
+// level of the trie branches 32 ways on the next 5 bits and nodes only store the children that exist. Get, Assoc and Without take
+// O(log32 n) time. Modifications copy only the nodes on the path they change and leave the receiver unchanged, versions can be kept
+// cheaply and used from multiple goroutines. Iteration order depends on the hashes of the keys. The zero value is an empty map that uses
+// [hashing.Hash]. The mutating methods of [collections.Map] are unsupported and panic.
+type PersistentHashMap[K comparable, V any] struct {
+	root *node[K, V]
+	len  int
+	hash func(K) uint64
+}
+
+// New creates a map with the given key, value pairs that hashes keys with [hashing.Hash].
+func New[K comparable, V any](pairs ...pair.Pair[K, V]) PersistentHashMap[K, V] {
+	return NewWithHash(nil, pairs...)
+}
+
+// NewWithHash creates a map with the given key, value pairs that hashes keys with the given function. A nil hash uses [hashing.Hash].
+func NewWithHash[K comparable, V any](hash func(K) uint64, pairs ...pair.Pair[K, V]) PersistentHashMap[K, V] {
+	persistentHashMap := PersistentHashMap[K, V]{hash: hash}
+	for _, pair := range pairs {
+		persistentHashMap = persistentHashMap.Assoc(pair.Key(), pair.Value())
+	}
+	return persistentHashMap
+}
+
+// hashOf returns the hash of the key.
+func (persistentHashMap PersistentHashMap[K, V]) hashOf(key K) uint64 {
+	if persistentHashMap.hash == nil {
+		return hashing.Hash(key)
+	}
+	return persistentHashMap.hash(key)
+}
+
+// Assoc returns a new map in which the key is mapped to the given value.
+func (persistentHashMap PersistentHashMap[K, V]) Assoc(key K, value V) PersistentHashMap[K, V] {
+	root := persistentHashMap.root
+	if root == nil {
+		root = &node[K, V]{}
+	}
+	root, added := root.assoc(0, entry[K, V]{hash: persistentHashMap.hashOf(key), key: key, value: value})
+	if added {
+		return PersistentHashMap[K, V]{root: root, len: persistentHashMap.len + 1, hash: persistentHashMap.hash}
+	}
+	return PersistentHashMap[K, V]{root: root, len: persistentHashMap.len, hash: persistentHashMap.hash}
+}
+
+// Without returns a new map without the mapping for the key, the map is returned as is if it does not contain the key.
+func (persistentHashMap PersistentHashMap[K, V]) Without(key K) PersistentHashMap[K, V] {
+	if persistentHashMap.root == nil {
+		return persistentHashMap
+	}
+	root, removed := persistentHashMap.root.without(0, persistentHashMap.hashOf(key), key)
+	if !removed {
+		return persistentHashMap
+	}
+	return PersistentHashMap[K, V]{root: root, len: persistentHashMap.len - 1, hash: persistentHashMap.hash}
+}
+
+// Get optionally returns the value associated with a key.
+func (persistentHashMap PersistentHashMap[K, V]) Get(key K) optional.Optional[V] {
+	if e, ok := persistentHashMap.root.get(persistentHashMap.hashOf(key), key); ok {
+		return optional.Of(e.value)
+	}
+	return optional.Empty[V]()
+}
+
+// GetIf returns the values mapped by keys that match the given predicate.
+func (persistentHashMap PersistentHashMap[K, V]) GetIf(f func(K) bool) []V {
+	values := make([]V, 0)
+	for key, value := range persistentHashMap.All() {
+		if f(key) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// ContainsKey returns true if the map contains a mapping for the specified key.
+func (persistentHashMap PersistentHashMap[K, V]) ContainsKey(key K) bool {
+	_, ok := persistentHashMap.root.get(persistentHashMap.hashOf(key), key)
+	return ok
+}
+
+// ContainsValue returns true if the map maps one or more keys to the specified value.
+func (persistentHashMap PersistentHashMap[K, V]) ContainsValue(value V, equals func(v1, v2 V) bool) bool {
+	for _, v := range persistentHashMap.All() {
+		if equals(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns a slice containing the keys in the map.
+func (persistentHashMap PersistentHashMap[K, V]) Keys() []K {
+	keys := make([]K, 0, persistentHashMap.len)
+	for key := range persistentHashMap.AllKeys() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns a slice containing the values in the map.
+func (persistentHashMap PersistentHashMap[K, V]) Values() []V {
+	values := make([]V, 0, persistentHashMap.len)
+	for value := range persistentHashMap.AllValues() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Len returns the number of entries in the map.
+func (persistentHashMap PersistentHashMap[K, V]) Len() int {
+	return persistentHashMap.len
+}
+
+// Empty returns true if the map has no entries.
+func (persistentHashMap PersistentHashMap[K, V]) Empty() bool {
+	return persistentHashMap.len == 0
+}
+
+// ForEach performs the given action for each key, value mapping in the map.
+func (persistentHashMap PersistentHashMap[K, V]) ForEach(f func(K, V)) {
+	for key, value := range persistentHashMap.All() {
+		f(key, value)
+	}
+}
+
+// Iterator returns an iterator over the entries of the map.
+func (persistentHashMap PersistentHashMap[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
+	it := mapIterator[K, V]{}
+	if persistentHashMap.root != nil {
+		it.stack = []frame[K, V]{{node: persistentHashMap.root}}
+	}
+	return &it
+}
+
+// All returns a sequence over the key, value pairs in the map.
+func (persistentHashMap PersistentHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if persistentHashMap.root != nil {
+			persistentHashMap.root.all(func(e entry[K, V]) bool { return yield(e.key, e.value) })
+		}
+	}
+}
+
+// AllKeys returns a sequence over the keys in the map.
+func (persistentHashMap PersistentHashMap[K, V]) AllKeys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range persistentHashMap.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// AllValues returns a sequence over the values in the map.
+func (persistentHashMap PersistentHashMap[K, V]) AllValues() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range persistentHashMap.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Equals return true if the map is equal to the given map. Two maps are equal if they contain the same key, value pairs.
+func (persistentHashMap PersistentHashMap[K, V]) Equals(other collections.Map[K, V], equals func(V, V) bool) bool {
+	if persistentHashMap.len != other.Len() {
+		return false
+	}
+	for key, value := range other.All() {
+		if v := persistentHashMap.Get(key); v.Empty() || !equals(value, v.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the string representation of the map.
+func (persistentHashMap PersistentHashMap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for key, value := range persistentHashMap.All() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", key, value))
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// frame the position of an iterator within a node.
+type frame[K comparable, V any] struct {
+	node  *node[K, V]
+	child int // The index of the next child of the node.
+	entry int // The index of the next entry of the current bucket.
+}
+
+// mapIterator iterator implementation for [PersistentHashMap], a depth first traversal of the trie with an explicit stack.
+type mapIterator[K comparable, V any] struct {
+	stack []frame[K, V]
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *mapIterator[K, V]) HasNext() bool {
+	for len(it.stack) > 0 {
+		top := &it.stack[len(it.stack)-1]
+		if top.child >= len(top.node.children) {
+			it.stack = it.stack[:len(it.stack)-1]
+			continue
+		}
+		c := top.node.children[top.child]
+		if c.node != nil {
+			top.child++
+			it.stack = append(it.stack, frame[K, V]{node: c.node})
+			continue
+		} else if top.entry < len(c.entries) {
+			return true
+		}
+		top.child++
+		top.entry = 0
+	}
+	return false
+}
+
+// Next returns the next element in the iterator.
+func (it *mapIterator[K, V]) Next() pair.Pair[K, V] {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	}
+	top := &it.stack[len(it.stack)-1]
+	e := top.node.children[top.child].entries[top.entry]
+	top.entry++
+	return pair.Of(e.key, e.value)
+}
+
+// Put unsupported operation, see Assoc.
+func (persistentHashMap PersistentHashMap[K, V]) Put(key K, value V) optional.Optional[V] {
+	panic(errors.UnsupportedOperation("Put", "PersistentHashMap"))
+}
+
+// PutIfAbsent unsupported operation.
+func (persistentHashMap PersistentHashMap[K, V]) PutIfAbsent(key K, value V) optional.Optional[V] {
+	panic(errors.UnsupportedOperation("PutIfAbsent", "PersistentHashMap"))
+}
+
+// Remove unsupported operation, see Without.
+func (persistentHashMap PersistentHashMap[K, V]) Remove(key K) optional.Optional[V] {
+	panic(errors.UnsupportedOperation("Remove", "PersistentHashMap"))
+}
+
+// RemoveIf unsupported operation.
+func (persistentHashMap PersistentHashMap[K, V]) RemoveIf(f func(K) bool) bool {
+	panic(errors.UnsupportedOperation("RemoveIf", "PersistentHashMap"))
+}
+
+// Clear unsupported operation.
+func (persistentHashMap PersistentHashMap[K, V]) Clear() {
+	panic(errors.UnsupportedOperation("Clear", "PersistentHashMap"))
+}
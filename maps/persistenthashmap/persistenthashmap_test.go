@@ -0,0 +1,289 @@
+package persistenthashmap
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func equals(a, b int) bool {
+	return a == b
+}
+
+// toMap returns the entries of the map as a built in map.
+func toMap[K comparable, V any](m PersistentHashMap[K, V]) map[K]V {
+	entries := make(map[K]V)
+	for key, value := range m.All() {
+		entries[key] = value
+	}
+	return entries
+}
+
+func TestNew(t *testing.T) {
+
+	var zero PersistentHashMap[string, int]
+	assert.True(t, zero.Empty())
+	assert.Equal(t, 0, zero.Len())
+	assert.True(t, zero.Get("A").Empty())
+	assert.True(t, zero.Without("A").Empty())
+	assert.Equal(t, optional.Of(1), zero.Assoc("A", 1).Get("A"))
+
+	m := New(pair.Of("A", 1), pair.Of("B", 2), pair.Of("A", 3))
+	assert.Equal(t, 2, m.Len())
+	assert.Equal(t, map[string]int{"A": 3, "B": 2}, toMap(m))
+}
+
+func TestAssoc(t *testing.T) {
+
+	m := New[int, int]()
+	for i := 0; i < 10000; i++ {
+		m = m.Assoc(i, i)
+	}
+	assert.Equal(t, 10000, m.Len())
+	for i := 0; i < 10000; i++ {
+		m = m.Assoc(i, -i)
+	}
+	assert.Equal(t, 10000, m.Len())
+	for i := 0; i < 10000; i++ {
+		assert.Equal(t, optional.Of(-i), m.Get(i))
+	}
+	assert.True(t, m.Get(10000).Empty())
+}
+
+func TestWithout(t *testing.T) {
+
+	m := New[int, int]()
+	for i := 0; i < 10000; i++ {
+		m = m.Assoc(i, i)
+	}
+	assert.Same(t, m.root, m.Without(10000).root)
+	for i := 0; i < 10000; i += 2 {
+		m = m.Without(i)
+	}
+	assert.Equal(t, 5000, m.Len())
+	for i := 0; i < 10000; i++ {
+		assert.Equal(t, i%2 == 1, m.ContainsKey(i))
+	}
+	for i := 1; i < 10000; i += 2 {
+		m = m.Without(i)
+	}
+	assert.True(t, m.Empty())
+	assert.Nil(t, m.root)
+}
+
+func TestCollisions(t *testing.T) {
+
+	// Keys with the same hash share a bucket, keys whose hashes only differ in the last bits are pushed to the bottom of the trie.
+	hash := func(k int) uint64 {
+		if k < 0 {
+			return 1 << 63
+		}
+		return uint64(k%4) << 60
+	}
+	m := NewWithHash[int, int](hash)
+	for i := -10; i < 100; i++ {
+		m = m.Assoc(i, i)
+	}
+	assert.Equal(t, 110, m.Len())
+	for i := -10; i < 100; i++ {
+		assert.Equal(t, optional.Of(i), m.Get(i))
+	}
+	m = m.Assoc(5, 50)
+	assert.Equal(t, optional.Of(50), m.Get(5))
+	assert.Equal(t, 110, m.Len())
+	assert.True(t, m.Get(100).Empty())
+	assert.Same(t, m.root, m.Without(100).root)
+
+	for i := -10; i < 100; i++ {
+		m = m.Without(i)
+		assert.False(t, m.ContainsKey(i))
+		assert.Equal(t, 99-i, m.Len())
+	}
+	assert.True(t, m.Empty())
+}
+
+func TestPointerKeys(t *testing.T) {
+
+	type node struct{ value float64 }
+
+	// Pointer keys are equal by identity, mutating what they point to must not move them in the trie.
+	nodes := make([]*node, 100)
+	m := New[*node, int]()
+	for i := range nodes {
+		nodes[i] = &node{value: float64(i)}
+		m = m.Assoc(nodes[i], i)
+	}
+	for i, node := range nodes {
+		node.value = -float64(i)
+	}
+	for i, node := range nodes {
+		assert.Equal(t, optional.Of(i), m.Get(node))
+	}
+	assert.False(t, m.ContainsKey(&node{value: 0}))
+	assert.Equal(t, 100, m.Assoc(nodes[0], -1).Len())
+	assert.Equal(t, 99, m.Without(nodes[99]).Len())
+
+	// Struct keys holding 0 and -0 are equal.
+	structs := New(pair.Of(node{value: 0}, 1))
+	assert.Equal(t, optional.Of(1), structs.Get(node{value: math.Copysign(0, -1)}))
+}
+
+func TestStructuralSharing(t *testing.T) {
+
+	base := New[int, int]()
+	for i := 0; i < 1000; i++ {
+		base = base.Assoc(i, i)
+	}
+	assigned := base.Assoc(0, -1)
+	removed := base.Without(0)
+
+	// Every version is unaffected by the versions derived from it.
+	assert.Equal(t, optional.Of(0), base.Get(0))
+	assert.Equal(t, optional.Of(-1), assigned.Get(0))
+	assert.False(t, removed.ContainsKey(0))
+	assert.Equal(t, 1000, base.Len())
+	assert.Equal(t, 999, removed.Len())
+
+	// Only the path to the modified key is copied.
+	shared := 0
+	for i := range base.root.children {
+		if base.root.children[i].node != nil && base.root.children[i].node == assigned.root.children[i].node {
+			shared++
+		}
+	}
+	assert.Equal(t, len(base.root.children)-1, shared)
+}
+
+func TestRandomOperations(t *testing.T) {
+
+	random := rand.New(rand.NewSource(7))
+	m := New[int, int]()
+	expected := make(map[int]int)
+
+	for i := 0; i < 20000; i++ {
+		key := random.Intn(2000)
+		if random.Intn(3) == 0 {
+			m = m.Without(key)
+			delete(expected, key)
+		} else {
+			m = m.Assoc(key, i)
+			expected[key] = i
+		}
+	}
+	assert.Equal(t, len(expected), m.Len())
+	assert.Equal(t, expected, toMap(m))
+}
+
+func TestMap(t *testing.T) {
+
+	m := New(pair.Of(1, 10), pair.Of(2, 20), pair.Of(3, 30))
+
+	assert.True(t, m.ContainsKey(1))
+	assert.False(t, m.ContainsKey(4))
+	assert.True(t, m.ContainsValue(20, equals))
+	assert.False(t, m.ContainsValue(40, equals))
+	assert.ElementsMatch(t, []int{10, 30}, m.GetIf(func(k int) bool { return k%2 == 1 }))
+	assert.ElementsMatch(t, []int{1, 2, 3}, m.Keys())
+	assert.ElementsMatch(t, []int{10, 20, 30}, m.Values())
+
+	entries := make(map[int]int)
+	m.ForEach(func(k, v int) { entries[k] = v })
+	assert.Equal(t, map[int]int{1: 10, 2: 20, 3: 30}, entries)
+	for range m.All() {
+		break
+	}
+	for range m.AllKeys() {
+		break
+	}
+	for range m.AllValues() {
+		break
+	}
+}
+
+func TestEquals(t *testing.T) {
+
+	m := New(pair.Of(1, 10), pair.Of(2, 20))
+	assert.True(t, m.Equals(m, equals))
+	assert.True(t, m.Equals(hashmap.New(pair.Of(1, 10), pair.Of(2, 20)), equals))
+	assert.True(t, hashmap.New(pair.Of(1, 10), pair.Of(2, 20)).Equals(m, equals))
+	assert.False(t, m.Equals(m.Without(1), equals))
+	assert.False(t, m.Equals(m.Assoc(1, 11), equals))
+	assert.False(t, m.Equals(m.Without(1).Assoc(3, 10), equals))
+}
+
+func TestIterator(t *testing.T) {
+
+	assert.False(t, New[int, int]().Iterator().HasNext())
+
+	m := New[int, int]()
+	expected := make([]pair.Pair[int, int], 0)
+	for i := 0; i < 1000; i++ {
+		m = m.Assoc(i, i)
+		expected = append(expected, pair.Of(i, i))
+	}
+	it := m.Iterator()
+	assert.ElementsMatch(t, expected, iterator.ToSlice(it))
+	assert.PanicsWithError(t, errors.NoSuchElement().Error(), func() { it.Next() })
+}
+
+func TestString(t *testing.T) {
+
+	assert.Equal(t, "{}", New[int, int]().String())
+	assert.Equal(t, "{1=10}", New(pair.Of(1, 10)).String())
+	assert.Contains(t, []string{"{1=10, 2=20}", "{2=20, 1=10}"}, New(pair.Of(1, 10), pair.Of(2, 20)).String())
+	assert.Equal(t, "{A=1}", fmt.Sprint(New(pair.Of("A", 1))))
+}
+
+func TestConcurrentVersions(t *testing.T) {
+
+	base := New[int, int]()
+	for i := 0; i < 1000; i++ {
+		base = base.Assoc(i, i)
+	}
+
+	// Versions can be derived from a shared map by many goroutines without synchronization.
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			m := base
+			for i := 0; i < 100; i++ {
+				m = m.Assoc(i, g).Without(500 + i)
+			}
+			assert.Equal(t, 900, m.Len())
+			assert.Equal(t, optional.Of(g), m.Get(99))
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, 1000, base.Len())
+	for i := 0; i < 1000; i++ {
+		assert.Equal(t, optional.Of(i), base.Get(i))
+	}
+}
+
+func TestUnsupportedOperations(t *testing.T) {
+
+	m := New(pair.Of(1, 10))
+	operations := map[string]func(){
+		"Put":         func() { m.Put(2, 20) },
+		"PutIfAbsent": func() { m.PutIfAbsent(2, 20) },
+		"Remove":      func() { m.Remove(1) },
+		"RemoveIf":    func() { m.RemoveIf(func(int) bool { return true }) },
+		"Clear":       func() { m.Clear() },
+	}
+
+	for operation, f := range operations {
+		assert.PanicsWithError(t, errors.UnsupportedOperation(operation, "PersistentHashMap").Error(), f)
+	}
+	assert.Equal(t, map[int]int{1: 10}, toMap(m))
+}
@@ -0,0 +1,441 @@
+// package persistenttreemap defines an immutable sorted map in which every modification produces a new version of the map that shares
+// all of its unchanged parts with the version it was derived from.
+package persistenttreemap
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// node a node of a left leaning red black tree. Nodes are never modified once they are part of a map, operations copy every node
+// they change.
+type node[K comparable, V any] struct {
+	key   K
+	value V
+	left  *node[K, V]
+	right *node[K, V]
+	red   bool // The color of the link from the parent to the node.
+}
+
+// isRed returns true if the node is red, nil nodes are black.
+func isRed[K comparable, V any](n *node[K, V]) bool {
+	return n != nil && n.red
+}
+
+// clone returns a copy of the node.
+func (n *node[K, V]) clone() *node[K, V] {
+	c := *n
+	return &c
+}
+
+// rotateLeft makes the right child of the node its parent. The node must be a copy owned by the current operation.
+func rotateLeft[K comparable, V any](n *node[K, V]) *node[K, V] {
+	x := n.right.clone()
+	n.right = x.left
+	x.left = n
+	x.red = n.red
+	n.red = true
+	return x
+}
+
+// rotateRight makes the left child of the node its parent. The node must be a copy owned by the current operation.
+func rotateRight[K comparable, V any](n *node[K, V]) *node[K, V] {
+	x := n.left.clone()
+	n.left = x.right
+	x.right = n
+	x.red = n.red
+	n.red = true
+	return x
+}
+
+// flipColors flips the colors of the node and its children. The node must be a copy owned by the current operation.
+func flipColors[K comparable, V any](n *node[K, V]) {
+	n.red = !n.red
+	n.left = n.left.clone()
+	n.left.red = !n.left.red
+	n.right = n.right.clone()
+	n.right.red = !n.right.red
+}
+
+// balance restores the invariants of the tree on the way up from a modification.
+func balance[K comparable, V any](n *node[K, V]) *node[K, V] {
+	if isRed(n.right) && !isRed(n.left) {
+		n = rotateLeft(n)
+	}
+	if isRed(n.left) && isRed(n.left.left) {
+		n = rotateRight(n)
+	}
+	if isRed(n.left) && isRed(n.right) {
+		flipColors(n)
+	}
+	return n
+}
+
+// moveRedLeft makes the left child of the node or one of its children red, given that the node is red and both of its children are
+// black.
+func moveRedLeft[K comparable, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.right.left) {
+		n.right = rotateRight(n.right)
+		n = rotateLeft(n)
+		flipColors(n)
+	}
+	return n
+}
+
+// moveRedRight makes the right child of the node or one of its children red, given that the node is red and both of its children are
+// black.
+func moveRedRight[K comparable, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.left.left) {
+		n = rotateRight(n)
+		flipColors(n)
+	}
+	return n
+}
+
+// PersistentTreeMap an immutable map backed by a persistent left leaning red black tree that keeps its entries sorted by key. Get,
+// Assoc and Without take O(log n) time. Modifications copy only the nodes on the path they change and leave the receiver unchanged,
+// versions can be kept cheaply and used from multiple goroutines. The mutating methods of [collections.Map] are unsupported and panic.
+type PersistentTreeMap[K comparable, V any] struct {
+	root     *node[K, V]
+	len      int
+	lessThan func(k1, k2 K) bool
+}
+
+// New creates a map with the given key, value pairs. Keys are compared using the lessThan function which should satisfy.
+// k1 < k2 => lessThan(k1, k2) = true and lessThan(k2,k1) = false.
+// k1 = k2 => lessThan(k1,k2) = false and lessThan(k2,k1) = false.
+// k1 > k2 -> lessThan(k1,k2) = false and lessThan(k2,k1) = true.
+func New[K comparable, V any](lessThan func(k1, k2 K) bool, pairs ...pair.Pair[K, V]) PersistentTreeMap[K, V] {
+	treeMap := PersistentTreeMap[K, V]{lessThan: lessThan}
+	for _, pair := range pairs {
+		treeMap = treeMap.Assoc(pair.Key(), pair.Value())
+	}
+	return treeMap
+}
+
+// search returns the node with the given key or nil if there is no such node.
+func (treeMap PersistentTreeMap[K, V]) search(key K) *node[K, V] {
+	n := treeMap.root
+	for n != nil {
+		if treeMap.lessThan(key, n.key) {
+			n = n.left
+		} else if treeMap.lessThan(n.key, key) {
+			n = n.right
+		} else {
+			return n
+		}
+	}
+	return nil
+}
+
+// Assoc returns a new map in which the key is mapped to the given value.
+func (treeMap PersistentTreeMap[K, V]) Assoc(key K, value V) PersistentTreeMap[K, V] {
+	root, added := treeMap.insert(treeMap.root, key, value)
+	root.red = false
+	if added {
+		return PersistentTreeMap[K, V]{root: root, len: treeMap.len + 1, lessThan: treeMap.lessThan}
+	}
+	return PersistentTreeMap[K, V]{root: root, len: treeMap.len, lessThan: treeMap.lessThan}
+}
+
+// insert returns a copy of the subtree with the key mapped to the value and whether a new key was added.
+func (treeMap PersistentTreeMap[K, V]) insert(n *node[K, V], key K, value V) (*node[K, V], bool) {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, red: true}, true
+	}
+	n = n.clone()
+	added := false
+	if treeMap.lessThan(key, n.key) {
+		n.left, added = treeMap.insert(n.left, key, value)
+	} else if treeMap.lessThan(n.key, key) {
+		n.right, added = treeMap.insert(n.right, key, value)
+	} else {
+		n.value = value
+	}
+	return balance(n), added
+}
+
+// Without returns a new map without the mapping for the key, the map is returned as is if it does not contain the key.
+func (treeMap PersistentTreeMap[K, V]) Without(key K) PersistentTreeMap[K, V] {
+	if treeMap.search(key) == nil {
+		return treeMap
+	}
+	root := treeMap.root.clone()
+	if !isRed(root.left) && !isRed(root.right) {
+		root.red = true
+	}
+	root = treeMap.delete(root, key)
+	if root != nil {
+		root.red = false
+	}
+	return PersistentTreeMap[K, V]{root: root, len: treeMap.len - 1, lessThan: treeMap.lessThan}
+}
+
+// delete returns a copy of the subtree without the key, the subtree must contain the key.
+func (treeMap PersistentTreeMap[K, V]) delete(n *node[K, V], key K) *node[K, V] {
+	n = n.clone()
+	if treeMap.lessThan(key, n.key) {
+		if !isRed(n.left) && !isRed(n.left.left) {
+			n = moveRedLeft(n)
+		}
+		n.left = treeMap.delete(n.left, key)
+	} else {
+		if isRed(n.left) {
+			n = rotateRight(n)
+		}
+		if !treeMap.lessThan(n.key, key) && n.right == nil {
+			return nil
+		}
+		if !isRed(n.right) && !isRed(n.right.left) {
+			n = moveRedRight(n)
+		}
+		if !treeMap.lessThan(n.key, key) {
+			min := n.right
+			for min.left != nil {
+				min = min.left
+			}
+			n.key, n.value = min.key, min.value
+			n.right = deleteMin(n.right)
+		} else {
+			n.right = treeMap.delete(n.right, key)
+		}
+	}
+	return balance(n)
+}
+
+// deleteMin returns a copy of the subtree without its smallest key.
+func deleteMin[K comparable, V any](n *node[K, V]) *node[K, V] {
+	if n.left == nil {
+		return nil
+	}
+	n = n.clone()
+	if !isRed(n.left) && !isRed(n.left.left) {
+		n = moveRedLeft(n)
+	}
+	n.left = deleteMin(n.left)
+	return balance(n)
+}
+
+// Get optionally returns the value associated with a key.
+func (treeMap PersistentTreeMap[K, V]) Get(key K) optional.Optional[V] {
+	if n := treeMap.search(key); n != nil {
+		return optional.Of(n.value)
+	}
+	return optional.Empty[V]()
+}
+
+// GetIf returns the values mapped by keys that match the given predicate.
+func (treeMap PersistentTreeMap[K, V]) GetIf(f func(K) bool) []V {
+	values := make([]V, 0)
+	for key, value := range treeMap.All() {
+		if f(key) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// ContainsKey returns true if the map contains a mapping for the specified key.
+func (treeMap PersistentTreeMap[K, V]) ContainsKey(key K) bool {
+	return treeMap.search(key) != nil
+}
+
+// ContainsValue returns true if the map maps one or more keys to the specified value.
+func (treeMap PersistentTreeMap[K, V]) ContainsValue(value V, equals func(v1, v2 V) bool) bool {
+	for _, v := range treeMap.All() {
+		if equals(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// FirstEntry returns the entry with the smallest key as an option.
+func (treeMap PersistentTreeMap[K, V]) FirstEntry() optional.Optional[pair.Pair[K, V]] {
+	if treeMap.root == nil {
+		return optional.Empty[pair.Pair[K, V]]()
+	}
+	n := treeMap.root
+	for n.left != nil {
+		n = n.left
+	}
+	return optional.Of(pair.Of(n.key, n.value))
+}
+
+// LastEntry returns the entry with the largest key as an option.
+func (treeMap PersistentTreeMap[K, V]) LastEntry() optional.Optional[pair.Pair[K, V]] {
+	if treeMap.root == nil {
+		return optional.Empty[pair.Pair[K, V]]()
+	}
+	n := treeMap.root
+	for n.right != nil {
+		n = n.right
+	}
+	return optional.Of(pair.Of(n.key, n.value))
+}
+
+// Keys returns a slice containing the keys in the map in sorted order.
+func (treeMap PersistentTreeMap[K, V]) Keys() []K {
+	keys := make([]K, 0, treeMap.len)
+	for key := range treeMap.AllKeys() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns a slice containing the values in the map in the order of their keys.
+func (treeMap PersistentTreeMap[K, V]) Values() []V {
+	values := make([]V, 0, treeMap.len)
+	for value := range treeMap.AllValues() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Len returns the number of entries in the map.
+func (treeMap PersistentTreeMap[K, V]) Len() int {
+	return treeMap.len
+}
+
+// Empty returns true if the map has no entries.
+func (treeMap PersistentTreeMap[K, V]) Empty() bool {
+	return treeMap.len == 0
+}
+
+// ForEach performs the given action for each key, value mapping in the map.
+func (treeMap PersistentTreeMap[K, V]) ForEach(f func(K, V)) {
+	for key, value := range treeMap.All() {
+		f(key, value)
+	}
+}
+
+// Iterator returns an iterator over the entries of the map in sorted order.
+func (treeMap PersistentTreeMap[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
+	it := mapIterator[K, V]{}
+	it.pushLeft(treeMap.root)
+	return &it
+}
+
+// All returns a sequence over the key, value pairs in the map in sorted order.
+func (treeMap PersistentTreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var inOrder func(n *node[K, V]) bool
+		inOrder = func(n *node[K, V]) bool {
+			return n == nil || (inOrder(n.left) && yield(n.key, n.value) && inOrder(n.right))
+		}
+		inOrder(treeMap.root)
+	}
+}
+
+// AllKeys returns a sequence over the keys in the map in sorted order.
+func (treeMap PersistentTreeMap[K, V]) AllKeys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range treeMap.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// AllValues returns a sequence over the values in the map in the order of their keys.
+func (treeMap PersistentTreeMap[K, V]) AllValues() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range treeMap.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Equals return true if the map is equal to the given map. Two maps are equal if they contain the same key, value pairs.
+func (treeMap PersistentTreeMap[K, V]) Equals(other collections.Map[K, V], equals func(V, V) bool) bool {
+	if treeMap.len != other.Len() {
+		return false
+	}
+	for key, value := range other.All() {
+		if v := treeMap.Get(key); v.Empty() || !equals(value, v.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the string representation of the map.
+func (treeMap PersistentTreeMap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for key, value := range treeMap.All() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", key, value))
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// mapIterator iterator implementation for [PersistentTreeMap], an in order traversal of the tree with an explicit stack.
+type mapIterator[K comparable, V any] struct {
+	stack []*node[K, V]
+}
+
+// pushLeft pushes the node and its chain of left descendants.
+func (it *mapIterator[K, V]) pushLeft(n *node[K, V]) {
+	for ; n != nil; n = n.left {
+		it.stack = append(it.stack, n)
+	}
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *mapIterator[K, V]) HasNext() bool {
+	return len(it.stack) > 0
+}
+
+// Next returns the next element in the iterator.
+func (it *mapIterator[K, V]) Next() pair.Pair[K, V] {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	}
+	n := it.stack[len(it.stack)-1]
+	it.stack = it.stack[:len(it.stack)-1]
+	it.pushLeft(n.right)
+	return pair.Of(n.key, n.value)
+}
+
+// Put unsupported operation, see Assoc.
+func (treeMap PersistentTreeMap[K, V]) Put(key K, value V) optional.Optional[V] {
+	panic(errors.UnsupportedOperation("Put", "PersistentTreeMap"))
+}
+
+// PutIfAbsent unsupported operation.
+func (treeMap PersistentTreeMap[K, V]) PutIfAbsent(key K, value V) optional.Optional[V] {
+	panic(errors.UnsupportedOperation("PutIfAbsent", "PersistentTreeMap"))
+}
+
+// Remove unsupported operation, see Without.
+func (treeMap PersistentTreeMap[K, V]) Remove(key K) optional.Optional[V] {
+	panic(errors.UnsupportedOperation("Remove", "PersistentTreeMap"))
+}
+
+// RemoveIf unsupported operation.
+func (treeMap PersistentTreeMap[K, V]) RemoveIf(f func(K) bool) bool {
+	panic(errors.UnsupportedOperation("RemoveIf", "PersistentTreeMap"))
+}
+
+// Clear unsupported operation.
+func (treeMap PersistentTreeMap[K, V]) Clear() {
+	panic(errors.UnsupportedOperation("Clear", "PersistentTreeMap"))
+}
@@ -0,0 +1,253 @@
+package persistenttreemap
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func lessThan(a, b int) bool {
+	return a < b
+}
+
+func equals(a, b int) bool {
+	return a == b
+}
+
+// blackHeight checks the invariants of a left leaning red black tree and returns its black height.
+func blackHeight[K comparable, V any](t *testing.T, n *node[K, V], lessThan func(K, K) bool) int {
+	if n == nil {
+		return 1
+	}
+	assert.False(t, isRed(n.right), "right leaning red link")
+	assert.False(t, isRed(n) && isRed(n.left), "consecutive red links")
+	if n.left != nil {
+		assert.True(t, lessThan(n.left.key, n.key))
+	}
+	if n.right != nil {
+		assert.True(t, lessThan(n.key, n.right.key))
+	}
+	left, right := blackHeight(t, n.left, lessThan), blackHeight(t, n.right, lessThan)
+	assert.Equal(t, left, right, "unbalanced black height")
+	if isRed(n) {
+		return left
+	}
+	return left + 1
+}
+
+func TestNew(t *testing.T) {
+
+	m := New[int, int](lessThan)
+	assert.True(t, m.Empty())
+	assert.Equal(t, 0, m.Len())
+	assert.True(t, m.FirstEntry().Empty())
+	assert.True(t, m.LastEntry().Empty())
+	assert.True(t, m.Without(1).Empty())
+
+	m = New(lessThan, pair.Of(3, 30), pair.Of(1, 10), pair.Of(2, 20), pair.Of(1, 11))
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, []int{1, 2, 3}, m.Keys())
+	assert.Equal(t, []int{11, 20, 30}, m.Values())
+}
+
+func TestAssocAndWithout(t *testing.T) {
+
+	keys := rand.New(rand.NewSource(7)).Perm(2000)
+	m := New[int, int](lessThan)
+	for i, key := range keys {
+		m = m.Assoc(key, key)
+		if i%100 == 0 {
+			blackHeight(t, m.root, lessThan)
+		}
+	}
+	assert.Equal(t, 2000, m.Len())
+	blackHeight(t, m.root, lessThan)
+	for i := 0; i < 2000; i++ {
+		assert.Equal(t, optional.Of(i), m.Get(i))
+	}
+	assert.Equal(t, optional.Of(pair.Of(0, 0)), m.FirstEntry())
+	assert.Equal(t, optional.Of(pair.Of(1999, 1999)), m.LastEntry())
+
+	assert.Same(t, m.root, m.Without(2000).root)
+	for i, key := range keys {
+		m = m.Without(key)
+		assert.False(t, m.ContainsKey(key))
+		assert.Equal(t, 1999-i, m.Len())
+		if i%100 == 0 {
+			blackHeight(t, m.root, lessThan)
+		}
+	}
+	assert.True(t, m.Empty())
+	assert.Nil(t, m.root)
+}
+
+func TestRandomOperations(t *testing.T) {
+
+	random := rand.New(rand.NewSource(7))
+	m := New[int, int](lessThan)
+	expected := make(map[int]int)
+	versions := make(map[int]map[int]int)
+	snapshots := make(map[int]PersistentTreeMap[int, int])
+
+	for i := 0; i < 20000; i++ {
+		key := random.Intn(1000)
+		if random.Intn(3) == 0 {
+			m = m.Without(key)
+			delete(expected, key)
+		} else {
+			m = m.Assoc(key, i)
+			expected[key] = i
+		}
+		if i%1000 == 0 {
+			versions[i] = make(map[int]int)
+			for k, v := range expected {
+				versions[i][k] = v
+			}
+			snapshots[i] = m
+		}
+	}
+	blackHeight(t, m.root, lessThan)
+
+	keys := make([]int, 0)
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	assert.Equal(t, keys, m.Keys())
+	for key, value := range expected {
+		assert.Equal(t, optional.Of(value), m.Get(key))
+	}
+
+	// Earlier versions are unaffected by later modifications.
+	for i, version := range versions {
+		assert.Equal(t, len(version), snapshots[i].Len())
+		for key, value := range version {
+			assert.Equal(t, optional.Of(value), snapshots[i].Get(key))
+		}
+	}
+}
+
+func TestStructuralSharing(t *testing.T) {
+
+	base := New[int, int](lessThan)
+	for i := 0; i < 1000; i++ {
+		base = base.Assoc(i, i)
+	}
+	assigned := base.Assoc(0, -1)
+
+	assert.Equal(t, optional.Of(0), base.Get(0))
+	assert.Equal(t, optional.Of(-1), assigned.Get(0))
+	assert.Same(t, base.root.right, assigned.root.right)
+	assert.NotSame(t, base.root.left, assigned.root.left)
+}
+
+func TestMap(t *testing.T) {
+
+	m := New(lessThan, pair.Of(1, 10), pair.Of(2, 20), pair.Of(3, 30))
+
+	assert.True(t, m.ContainsKey(1))
+	assert.False(t, m.ContainsKey(4))
+	assert.True(t, m.ContainsValue(20, equals))
+	assert.False(t, m.ContainsValue(40, equals))
+	assert.Equal(t, []int{10, 30}, m.GetIf(func(k int) bool { return k%2 == 1 }))
+	assert.True(t, m.Get(4).Empty())
+
+	entries := make([]pair.Pair[int, int], 0)
+	m.ForEach(func(k, v int) { entries = append(entries, pair.Of(k, v)) })
+	assert.Equal(t, []pair.Pair[int, int]{pair.Of(1, 10), pair.Of(2, 20), pair.Of(3, 30)}, entries)
+	for range m.All() {
+		break
+	}
+	for range m.AllKeys() {
+		break
+	}
+	for range m.AllValues() {
+		break
+	}
+}
+
+func TestEquals(t *testing.T) {
+
+	m := New(lessThan, pair.Of(1, 10), pair.Of(2, 20))
+	assert.True(t, m.Equals(m, equals))
+	assert.True(t, m.Equals(hashmap.New(pair.Of(1, 10), pair.Of(2, 20)), equals))
+	assert.True(t, hashmap.New(pair.Of(1, 10), pair.Of(2, 20)).Equals(m, equals))
+	assert.False(t, m.Equals(m.Without(1), equals))
+	assert.False(t, m.Equals(m.Assoc(1, 11), equals))
+}
+
+func TestIterator(t *testing.T) {
+
+	assert.False(t, New[int, int](lessThan).Iterator().HasNext())
+
+	m := New[int, int](lessThan)
+	expected := make([]pair.Pair[int, int], 0)
+	for i := 99; i >= 0; i-- {
+		m = m.Assoc(i, i)
+	}
+	for i := 0; i < 100; i++ {
+		expected = append(expected, pair.Of(i, i))
+	}
+	it := m.Iterator()
+	assert.Equal(t, expected, iterator.ToSlice(it))
+	assert.PanicsWithError(t, errors.NoSuchElement().Error(), func() { it.Next() })
+}
+
+func TestString(t *testing.T) {
+
+	assert.Equal(t, "{}", New[int, int](lessThan).String())
+	assert.Equal(t, "{1=10, 2=20, 3=30}", New(lessThan, pair.Of(3, 30), pair.Of(2, 20), pair.Of(1, 10)).String())
+}
+
+func TestConcurrentVersions(t *testing.T) {
+
+	base := New[int, int](lessThan)
+	for i := 0; i < 1000; i++ {
+		base = base.Assoc(i, i)
+	}
+
+	// Versions can be derived from a shared map by many goroutines without synchronization.
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			m := base
+			for i := 0; i < 100; i++ {
+				m = m.Assoc(i, g).Without(500 + i)
+			}
+			assert.Equal(t, 900, m.Len())
+			assert.Equal(t, optional.Of(g), m.Get(99))
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, 1000, base.Len())
+	for i := 0; i < 1000; i++ {
+		assert.Equal(t, optional.Of(i), base.Get(i))
+	}
+}
+
+func TestUnsupportedOperations(t *testing.T) {
+
+	m := New(lessThan, pair.Of(1, 10))
+	operations := map[string]func(){
+		"Put":         func() { m.Put(2, 20) },
+		"PutIfAbsent": func() { m.PutIfAbsent(2, 20) },
+		"Remove":      func() { m.Remove(1) },
+		"RemoveIf":    func() { m.RemoveIf(func(int) bool { return true }) },
+		"Clear":       func() { m.Clear() },
+	}
+
+	for operation, f := range operations {
+		assert.PanicsWithError(t, errors.UnsupportedOperation(operation, "PersistentTreeMap").Error(), f)
+	}
+	assert.Equal(t, []int{1}, m.Keys())
+}
@@ -0,0 +1,469 @@
+package treemap
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/trees/rbt"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// subMap a view of the entries of a [TreeMap] with keys in a given range, optionally in reverse order. The view is backed by the map,
+// changes to the map are reflected in the view and vice versa. Navigation operations of a descending view are mirrored i.e the first
+// entry of the view is the entry with the largest key in the range and the floor of a key in the view is its ceiling in the map.
+type subMap[K comparable, V any] struct {
+	treeMap    *TreeMap[K, V]
+	lower      rbt.Bound[K] // The lower bound of the range following the ordering of the map.
+	upper      rbt.Bound[K] // The upper bound of the range following the ordering of the map.
+	descending bool         // Whether entries are ordered by key in reverse.
+}
+
+// newSubMap creates a view of the entries of the map with keys in the range described by the given bounds.
+func newSubMap[K comparable, V any](treeMap *TreeMap[K, V], lower rbt.Bound[K], upper rbt.Bound[K], descending bool) *subMap[K, V] {
+	return &subMap[K, V]{treeMap: treeMap, lower: lower, upper: upper, descending: descending}
+}
+
+// bound returns a bound at the given key.
+func bound[K comparable](key K, inclusive bool) rbt.Bound[K] {
+	if inclusive {
+		return rbt.InclusiveBound(key)
+	}
+	return rbt.ExclusiveBound(key)
+}
+
+// closed returns the given bound with its key included in the range.
+func closed[K comparable](bound rbt.Bound[K]) rbt.Bound[K] {
+	if !bound.Bounded() {
+		return bound
+	}
+	return rbt.InclusiveBound(bound.Key())
+}
+
+// inRange returns true if the key falls within the range of the view.
+func (subMap *subMap[K, V]) inRange(key K) bool {
+	return subMap.treeMap.tree.InRange(key, subMap.lower, subMap.upper)
+}
+
+// checkBound panics if the given key cannot be used as a bound of a view nested in this view. An exclusive bound may sit on an
+// exclusive bound of this view.
+func (subMap *subMap[K, V]) checkBound(argument string, key K, inclusive bool) {
+	if inclusive && !subMap.inRange(key) {
+		panic(errors.IllegalArgument(argument, key))
+	} else if !subMap.treeMap.tree.InRange(key, closed(subMap.lower), closed(subMap.upper)) {
+		panic(errors.IllegalArgument(argument, key))
+	}
+}
+
+// entries returns a sequence over the key, value pairs in the view.
+func (subMap *subMap[K, V]) entries() iter.Seq[pair.Pair[K, V]] {
+	return subMap.treeMap.entries(subMap.lower, subMap.upper, subMap.descending)
+}
+
+// Put adds a new key/value pair to the map and optionally returns previously bound value. Put panics if the key is out of the range
+// of the view.
+func (subMap *subMap[K, V]) Put(key K, value V) optional.Optional[V] {
+	if !subMap.inRange(key) {
+		panic(errors.IllegalArgument("key", key))
+	}
+	return subMap.treeMap.Put(key, value)
+}
+
+// PutIfAbsent adds a new key/value pair to the map if the key is not already bounded and optionally returns bound value. PutIfAbsent
+// panics if the key is out of the range of the view.
+func (subMap *subMap[K, V]) PutIfAbsent(key K, value V) optional.Optional[V] {
+	if !subMap.inRange(key) {
+		panic(errors.IllegalArgument("key", key))
+	}
+	return subMap.treeMap.PutIfAbsent(key, value)
+}
+
+// Get optionally returns the value associated with a key.
+func (subMap *subMap[K, V]) Get(key K) optional.Optional[V] {
+	if !subMap.inRange(key) {
+		return optional.Empty[V]()
+	}
+	return subMap.treeMap.Get(key)
+}
+
+// GetIf returns the values mapped by keys that match the given predicate.
+func (subMap *subMap[K, V]) GetIf(f func(K) bool) []V {
+	values := make([]V, 0)
+	for pair := range subMap.entries() {
+		if f(pair.Key()) {
+			values = append(values, pair.Value())
+		}
+	}
+	return values
+}
+
+// Remove removes a key from the map, returning the value associated previously with that key as an option.
+func (subMap *subMap[K, V]) Remove(key K) optional.Optional[V] {
+	if !subMap.inRange(key) {
+		return optional.Empty[V]()
+	}
+	return subMap.treeMap.Remove(key)
+}
+
+// RemoveIf removes all the key, value mapping in which the key matches the given predicate.
+func (subMap *subMap[K, V]) RemoveIf(f func(K) bool) bool {
+	keysToRemove := make([]K, 0)
+	for pair := range subMap.entries() {
+		if f(pair.Key()) {
+			keysToRemove = append(keysToRemove, pair.Key())
+		}
+	}
+
+	for _, key := range keysToRemove {
+		subMap.treeMap.Remove(key)
+	}
+	return len(keysToRemove) > 0
+}
+
+// ContainsKey returns true if this map contains a mapping for the specified key.
+func (subMap *subMap[K, V]) ContainsKey(key K) bool {
+	return subMap.inRange(key) && subMap.treeMap.ContainsKey(key)
+}
+
+// ContainsValue returns true if this map maps one or more keys to the specified value.
+func (subMap *subMap[K, V]) ContainsValue(value V, equals func(v1, v2 V) bool) bool {
+	for pair := range subMap.entries() {
+		if equals(pair.Value(), value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Clear removes all of the mappings in the range of the view from the map.
+func (subMap *subMap[K, V]) Clear() {
+	for _, key := range subMap.Keys() {
+		subMap.treeMap.Remove(key)
+	}
+}
+
+// Keys returns a slice containing the keys in the map.
+func (subMap *subMap[K, V]) Keys() []K {
+	keys := make([]K, 0)
+	for pair := range subMap.entries() {
+		keys = append(keys, pair.Key())
+	}
+	return keys
+}
+
+// Values returns a slice containing the values in the map.
+func (subMap *subMap[K, V]) Values() []V {
+	values := make([]V, 0)
+	for pair := range subMap.entries() {
+		values = append(values, pair.Value())
+	}
+	return values
+}
+
+// offset returns the number of keys in the map that come before the range of the view following the ordering of the map.
+func (subMap *subMap[K, V]) offset() int {
+	lowest := subMap.treeMap.tree.Lowest(subMap.lower, subMap.upper)
+	if lowest.Empty() {
+		return 0
+	}
+	return subMap.treeMap.tree.Rank(lowest.Value().Key())
+}
+
+// Len returns the number of key, value mappings in the map.
+func (subMap *subMap[K, V]) Len() int {
+	tree := subMap.treeMap.tree
+	lowest, highest := tree.Lowest(subMap.lower, subMap.upper), tree.Highest(subMap.lower, subMap.upper)
+	if lowest.Empty() {
+		return 0
+	}
+	return tree.Rank(highest.Value().Key()) - tree.Rank(lowest.Value().Key()) + 1
+}
+
+// Empty returns true if the map has no elements.
+func (subMap *subMap[K, V]) Empty() bool {
+	return subMap.treeMap.tree.Lowest(subMap.lower, subMap.upper).Empty()
+}
+
+// ForEach performs the given action for each key, value mapping in the map.
+func (subMap *subMap[K, V]) ForEach(f func(K, V)) {
+	for pair := range subMap.entries() {
+		f(pair.Key(), pair.Value())
+	}
+}
+
+// Iterator returns an iterator over the map. The iterator is fail fast, Next panics if the map is structurally modified after iteration
+// has started.
+func (subMap *subMap[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
+	return subMap.RemovableIterator()
+}
+
+// RemovableIterator returns an iterator over the map that can remove the last returned entry from the map.
+func (subMap *subMap[K, V]) RemovableIterator() iterator.RemovableIterator[pair.Pair[K, V]] {
+	return &mapIterator[K, V]{initialized: false, treeMap: subMap.treeMap, lower: subMap.lower, upper: subMap.upper,
+		descending: subMap.descending}
+}
+
+// All returns a sequence over the key, value pairs in the map.
+func (subMap *subMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for pair := range subMap.entries() {
+			if !yield(pair.Key(), pair.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// AllKeys returns a sequence over the keys in the map.
+func (subMap *subMap[K, V]) AllKeys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for pair := range subMap.entries() {
+			if !yield(pair.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// AllValues returns a sequence over the values in the map.
+func (subMap *subMap[K, V]) AllValues() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for pair := range subMap.entries() {
+			if !yield(pair.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// floor returns the entry in the view with the greatest key less than the given key, or equal to it if inclusive is true, following
+// the ordering of the map.
+func (subMap *subMap[K, V]) floor(k K, inclusive bool) optional.Optional[pair.Pair[K, V]] {
+	tree := subMap.treeMap.tree
+	if !tree.InRange(k, rbt.Unbounded[K](), subMap.upper) {
+		return tree.Highest(subMap.lower, subMap.upper)
+	}
+	entry := tree.Lower(k)
+	if inclusive {
+		entry = tree.Floor(k)
+	}
+	if entry.Empty() || !subMap.inRange(entry.Value().Key()) {
+		return optional.Empty[pair.Pair[K, V]]()
+	}
+	return entry
+}
+
+// ceiling returns the entry in the view with the least key greater than the given key, or equal to it if inclusive is true, following
+// the ordering of the map.
+func (subMap *subMap[K, V]) ceiling(k K, inclusive bool) optional.Optional[pair.Pair[K, V]] {
+	tree := subMap.treeMap.tree
+	if !tree.InRange(k, subMap.lower, rbt.Unbounded[K]()) {
+		return tree.Lowest(subMap.lower, subMap.upper)
+	}
+	entry := tree.Higher(k)
+	if inclusive {
+		entry = tree.Ceiling(k)
+	}
+	if entry.Empty() || !subMap.inRange(entry.Value().Key()) {
+		return optional.Empty[pair.Pair[K, V]]()
+	}
+	return entry
+}
+
+// FirstEntry returns the first entry of the view as an option.
+func (subMap *subMap[K, V]) FirstEntry() optional.Optional[pair.Pair[K, V]] {
+	if subMap.descending {
+		return subMap.treeMap.tree.Highest(subMap.lower, subMap.upper)
+	}
+	return subMap.treeMap.tree.Lowest(subMap.lower, subMap.upper)
+}
+
+// LastEntry returns the last entry of the view as an option.
+func (subMap *subMap[K, V]) LastEntry() optional.Optional[pair.Pair[K, V]] {
+	if subMap.descending {
+		return subMap.treeMap.tree.Lowest(subMap.lower, subMap.upper)
+	}
+	return subMap.treeMap.tree.Highest(subMap.lower, subMap.upper)
+}
+
+// FloorKey returns the greatest key in the view less than or equal to the given key as an option.
+func (subMap *subMap[K, V]) FloorKey(k K) optional.Optional[K] {
+	return key(subMap.FloorEntry(k))
+}
+
+// FloorEntry returns the entry with the greatest key in the view less than or equal to the given key as an option.
+func (subMap *subMap[K, V]) FloorEntry(k K) optional.Optional[pair.Pair[K, V]] {
+	if subMap.descending {
+		return subMap.ceiling(k, true)
+	}
+	return subMap.floor(k, true)
+}
+
+// CeilingKey returns the least key in the view greater than or equal to the given key as an option.
+func (subMap *subMap[K, V]) CeilingKey(k K) optional.Optional[K] {
+	return key(subMap.CeilingEntry(k))
+}
+
+// CeilingEntry returns the entry with the least key in the view greater than or equal to the given key as an option.
+func (subMap *subMap[K, V]) CeilingEntry(k K) optional.Optional[pair.Pair[K, V]] {
+	if subMap.descending {
+		return subMap.floor(k, true)
+	}
+	return subMap.ceiling(k, true)
+}
+
+// LowerKey returns the greatest key in the view strictly less than the given key as an option.
+func (subMap *subMap[K, V]) LowerKey(k K) optional.Optional[K] {
+	return key(subMap.LowerEntry(k))
+}
+
+// LowerEntry returns the entry with the greatest key in the view strictly less than the given key as an option.
+func (subMap *subMap[K, V]) LowerEntry(k K) optional.Optional[pair.Pair[K, V]] {
+	if subMap.descending {
+		return subMap.ceiling(k, false)
+	}
+	return subMap.floor(k, false)
+}
+
+// HigherKey returns the least key in the view strictly greater than the given key as an option.
+func (subMap *subMap[K, V]) HigherKey(k K) optional.Optional[K] {
+	return key(subMap.HigherEntry(k))
+}
+
+// HigherEntry returns the entry with the least key in the view strictly greater than the given key as an option.
+func (subMap *subMap[K, V]) HigherEntry(k K) optional.Optional[pair.Pair[K, V]] {
+	if subMap.descending {
+		return subMap.floor(k, false)
+	}
+	return subMap.ceiling(k, false)
+}
+
+// PollFirstEntry removes and returns the first entry of the view as an option.
+func (subMap *subMap[K, V]) PollFirstEntry() optional.Optional[pair.Pair[K, V]] {
+	entry := subMap.FirstEntry()
+	if !entry.Empty() {
+		subMap.treeMap.Remove(entry.Value().Key())
+	}
+	return entry
+}
+
+// PollLastEntry removes and returns the last entry of the view as an option.
+func (subMap *subMap[K, V]) PollLastEntry() optional.Optional[pair.Pair[K, V]] {
+	entry := subMap.LastEntry()
+	if !entry.Empty() {
+		subMap.treeMap.Remove(entry.Value().Key())
+	}
+	return entry
+}
+
+// DescendingMap returns a view of the same range in which entries are in the opposite order. The descending map of a descending view
+// over the whole map is the map itself.
+func (subMap *subMap[K, V]) DescendingMap() collections.NavigableMap[K, V] {
+	if subMap.descending && !subMap.lower.Bounded() && !subMap.upper.Bounded() {
+		return subMap.treeMap
+	}
+	return newSubMap(subMap.treeMap, subMap.lower, subMap.upper, !subMap.descending)
+}
+
+// SubMap returns a view of the portion of the view with keys ranging from fromKey to toKey. SubMap panics if fromKey comes after toKey
+// or if either key is out of the range of the view.
+func (subMap *subMap[K, V]) SubMap(fromKey K, fromInclusive bool, toKey K, toInclusive bool) collections.NavigableMap[K, V] {
+	subMap.checkBound("fromKey", fromKey, fromInclusive)
+	subMap.checkBound("toKey", toKey, toInclusive)
+	lower, upper := bound(fromKey, fromInclusive), bound(toKey, toInclusive)
+	if subMap.descending {
+		lower, upper = upper, lower
+	}
+	if !subMap.treeMap.tree.InRange(lower.Key(), rbt.Unbounded[K](), rbt.InclusiveBound(upper.Key())) {
+		panic(errors.IllegalArgument("fromKey", fromKey))
+	}
+	return newSubMap(subMap.treeMap, lower, upper, subMap.descending)
+}
+
+// HeadMap returns a view of the portion of the view with keys that come before toKey, or equal to it if inclusive is true. HeadMap
+// panics if toKey is out of the range of the view.
+func (subMap *subMap[K, V]) HeadMap(toKey K, inclusive bool) collections.NavigableMap[K, V] {
+	subMap.checkBound("toKey", toKey, inclusive)
+	if subMap.descending {
+		return newSubMap(subMap.treeMap, bound(toKey, inclusive), subMap.upper, true)
+	}
+	return newSubMap(subMap.treeMap, subMap.lower, bound(toKey, inclusive), false)
+}
+
+// TailMap returns a view of the portion of the view with keys that come after fromKey, or equal to it if inclusive is true. TailMap
+// panics if fromKey is out of the range of the view.
+func (subMap *subMap[K, V]) TailMap(fromKey K, inclusive bool) collections.NavigableMap[K, V] {
+	subMap.checkBound("fromKey", fromKey, inclusive)
+	if subMap.descending {
+		return newSubMap(subMap.treeMap, subMap.lower, bound(fromKey, inclusive), true)
+	}
+	return newSubMap(subMap.treeMap, bound(fromKey, inclusive), subMap.upper, false)
+}
+
+// At returns the entry with the given index in the view, the first entry of the view has index 0. At panics if the index is out of bounds.
+func (subMap *subMap[K, V]) At(i int) pair.Pair[K, V] {
+	n := subMap.Len()
+	if i < 0 || i >= n {
+		panic(errors.IndexOutOfBounds(i, n))
+	}
+	if subMap.descending {
+		return subMap.treeMap.tree.Select(subMap.offset() + n - 1 - i).Value()
+	}
+	return subMap.treeMap.tree.Select(subMap.offset() + i).Value()
+}
+
+// Rank returns the number of keys in the view that come before the given key. The key does not have to be in the view.
+func (subMap *subMap[K, V]) Rank(k K) int {
+	if subMap.descending {
+		entry := subMap.ceiling(k, false)
+		if entry.Empty() {
+			return 0
+		}
+		return subMap.Len() - (subMap.treeMap.tree.Rank(entry.Value().Key()) - subMap.offset())
+	}
+	entry := subMap.floor(k, false)
+	if entry.Empty() {
+		return 0
+	}
+	return subMap.treeMap.tree.Rank(entry.Value().Key()) - subMap.offset() + 1
+}
+
+// Equals return true if the map is is equal to the given map. Two maps are equal if they contain the same
+// key, value pairs.
+func (subMap *subMap[K, V]) Equals(other collections.Map[K, V], equals func(V, V) bool) bool {
+	if subMap.Len() != other.Len() {
+		return false
+	}
+	it := other.Iterator()
+	for it.HasNext() {
+		pair := it.Next()
+		result := subMap.Get(pair.Key())
+		if result.Empty() {
+			return false
+		} else if !equals(pair.Value(), result.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the string representation of the map.
+func (subMap *subMap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for pair := range subMap.entries() {
+		if i == 0 {
+			sb.WriteString(fmt.Sprintf("%v=%v", pair.Key(), pair.Value()))
+		} else {
+			sb.WriteString(fmt.Sprintf(", %v=%v", pair.Key(), pair.Value()))
+		}
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
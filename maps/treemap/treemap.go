@@ -3,6 +3,7 @@ package treemap
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/phantom820/collections"
@@ -121,42 +122,231 @@ func (treeMap *TreeMap[K, V]) Empty() bool {
 
 // ForEach performs the given action for each key, value mapping in the map.
 func (treeMap *TreeMap[K, V]) ForEach(f func(K, V)) {
-	for _, pair := range treeMap.tree.Nodes() {
+	for pair := range treeMap.tree.Range(rbt.Unbounded[K](), rbt.Unbounded[K](), false) {
 		f(pair.Key(), pair.Value())
 	}
 }
 
-// Iterator returns an iterator over the map.
+// Iterator returns an iterator over the map. The iterator is fail fast, Next panics if the map is structurally modified after
+// iteration has started.
 func (treeMap *TreeMap[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
-	return &mapIterator[K, V]{initialized: false, index: 0, entries: make([]pair.Pair[K, V], 0), initialize: treeMap.tree.Nodes}
+	return treeMap.RemovableIterator()
 }
 
-// mapIterator implementation of an mapIterator for [HashMap].
+// RemovableIterator returns an iterator over the map that can remove the last returned entry from the map.
+func (treeMap *TreeMap[K, V]) RemovableIterator() iterator.RemovableIterator[pair.Pair[K, V]] {
+	return &mapIterator[K, V]{initialized: false, treeMap: treeMap, lower: rbt.Unbounded[K](), upper: rbt.Unbounded[K]()}
+}
+
+// entries returns a sequence over the key, value pairs in the map with keys in the range described by the given bounds. Pairs are yielded
+// in the sorted order of their keys, or in reverse order if descending is true. The sequence panics if the map is structurally modified
+// while ranging over it.
+func (treeMap *TreeMap[K, V]) entries(lower rbt.Bound[K], upper rbt.Bound[K], descending bool) iter.Seq[pair.Pair[K, V]] {
+	return func(yield func(pair.Pair[K, V]) bool) {
+		modCount := treeMap.tree.Modifications()
+		for pair := range treeMap.tree.Range(lower, upper, descending) {
+			if !yield(pair) {
+				return
+			} else if modCount != treeMap.tree.Modifications() {
+				panic(errors.ConcurrentModification("TreeMap"))
+			}
+		}
+	}
+}
+
+// All returns a sequence over the key, value pairs in the map. Entries are yielded in the sorted order of their keys.
+func (treeMap *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for pair := range treeMap.entries(rbt.Unbounded[K](), rbt.Unbounded[K](), false) {
+			if !yield(pair.Key(), pair.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// AllKeys returns a sequence over the keys in the map in sorted order.
+func (treeMap *TreeMap[K, V]) AllKeys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for pair := range treeMap.entries(rbt.Unbounded[K](), rbt.Unbounded[K](), false) {
+			if !yield(pair.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// AllValues returns a sequence over the values in the map. Values are yielded in the sorted order of their keys.
+func (treeMap *TreeMap[K, V]) AllValues() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for pair := range treeMap.entries(rbt.Unbounded[K](), rbt.Unbounded[K](), false) {
+			if !yield(pair.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// mapIterator implementation of an mapIterator for [TreeMap].
 type mapIterator[K comparable, V any] struct {
 	initialized bool
-	initialize  func() []pair.Pair[K, V]
-	index       int
-	entries     []pair.Pair[K, V]
+	treeMap     *TreeMap[K, V]
+	modCount    int // The expected modification count of the tree.
+	iterator    *rbt.Iterator[K, V]
+	removable   bool         // Whether the last returned entry can be removed.
+	lower       rbt.Bound[K] // The lower bound on the keys iterated over.
+	upper       rbt.Bound[K] // The upper bound on the keys iterated over.
+	descending  bool         // Whether entries are iterated over in reverse order.
 }
 
 // HasNext returns true if the iterator has more elements.
 func (it *mapIterator[K, V]) HasNext() bool {
 	if !it.initialized {
 		it.initialized = true
-		it.entries = it.initialize()
+		it.iterator = it.treeMap.tree.RangeIterator(it.lower, it.upper, it.descending)
+		it.modCount = it.treeMap.tree.Modifications()
 	}
-	return it.index < len(it.entries)
-
+	return it.iterator.HasNext()
 }
 
 // Next returns the next element in the iterator.
 func (it *mapIterator[K, V]) Next() pair.Pair[K, V] {
 	if !it.HasNext() {
 		panic(errors.NoSuchElement())
+	} else if it.modCount != it.treeMap.tree.Modifications() {
+		panic(errors.ConcurrentModification("TreeMap"))
+	}
+	it.removable = true
+	return it.iterator.Next()
+}
+
+// Remove removes the last entry returned by Next from the map. Remove can only be called once per call to Next.
+func (it *mapIterator[K, V]) Remove() {
+	if !it.removable {
+		panic(errors.IllegalState("Remove", "TreeMap"))
+	} else if it.modCount != it.treeMap.tree.Modifications() {
+		panic(errors.ConcurrentModification("TreeMap"))
+	}
+	it.iterator.Remove()
+	it.modCount = it.treeMap.tree.Modifications()
+	it.removable = false
+}
+
+// key returns the key of the entry as an option.
+func key[K comparable, V any](entry optional.Optional[pair.Pair[K, V]]) optional.Optional[K] {
+	if entry.Empty() {
+		return optional.Empty[K]()
+	}
+	return optional.Of(entry.Value().Key())
+}
+
+// FirstEntry returns the entry with the smallest key as an option.
+func (treeMap *TreeMap[K, V]) FirstEntry() optional.Optional[pair.Pair[K, V]] {
+	return treeMap.tree.First()
+}
+
+// LastEntry returns the entry with the largest key as an option.
+func (treeMap *TreeMap[K, V]) LastEntry() optional.Optional[pair.Pair[K, V]] {
+	return treeMap.tree.Last()
+}
+
+// FloorKey returns the greatest key less than or equal to the given key as an option.
+func (treeMap *TreeMap[K, V]) FloorKey(k K) optional.Optional[K] {
+	return key(treeMap.tree.Floor(k))
+}
+
+// FloorEntry returns the entry with the greatest key less than or equal to the given key as an option.
+func (treeMap *TreeMap[K, V]) FloorEntry(k K) optional.Optional[pair.Pair[K, V]] {
+	return treeMap.tree.Floor(k)
+}
+
+// CeilingKey returns the least key greater than or equal to the given key as an option.
+func (treeMap *TreeMap[K, V]) CeilingKey(k K) optional.Optional[K] {
+	return key(treeMap.tree.Ceiling(k))
+}
+
+// CeilingEntry returns the entry with the least key greater than or equal to the given key as an option.
+func (treeMap *TreeMap[K, V]) CeilingEntry(k K) optional.Optional[pair.Pair[K, V]] {
+	return treeMap.tree.Ceiling(k)
+}
+
+// LowerKey returns the greatest key strictly less than the given key as an option.
+func (treeMap *TreeMap[K, V]) LowerKey(k K) optional.Optional[K] {
+	return key(treeMap.tree.Lower(k))
+}
+
+// LowerEntry returns the entry with the greatest key strictly less than the given key as an option.
+func (treeMap *TreeMap[K, V]) LowerEntry(k K) optional.Optional[pair.Pair[K, V]] {
+	return treeMap.tree.Lower(k)
+}
+
+// HigherKey returns the least key strictly greater than the given key as an option.
+func (treeMap *TreeMap[K, V]) HigherKey(k K) optional.Optional[K] {
+	return key(treeMap.tree.Higher(k))
+}
+
+// HigherEntry returns the entry with the least key strictly greater than the given key as an option.
+func (treeMap *TreeMap[K, V]) HigherEntry(k K) optional.Optional[pair.Pair[K, V]] {
+	return treeMap.tree.Higher(k)
+}
+
+// PollFirstEntry removes and returns the entry with the smallest key as an option.
+func (treeMap *TreeMap[K, V]) PollFirstEntry() optional.Optional[pair.Pair[K, V]] {
+	entry := treeMap.tree.First()
+	if !entry.Empty() {
+		treeMap.tree.Delete(entry.Value().Key())
 	}
-	index := it.index
-	it.index++
-	return it.entries[index]
+	return entry
+}
+
+// PollLastEntry removes and returns the entry with the largest key as an option.
+func (treeMap *TreeMap[K, V]) PollLastEntry() optional.Optional[pair.Pair[K, V]] {
+	entry := treeMap.tree.Last()
+	if !entry.Empty() {
+		treeMap.tree.Delete(entry.Value().Key())
+	}
+	return entry
+}
+
+// DescendingMap returns a view of the map in which entries are ordered by key in reverse. The view is backed by the map, changes to
+// the map are reflected in the view and vice versa.
+func (treeMap *TreeMap[K, V]) DescendingMap() collections.NavigableMap[K, V] {
+	return newSubMap(treeMap, rbt.Unbounded[K](), rbt.Unbounded[K](), true)
+}
+
+// SubMap returns a view of the portion of the map with keys ranging from fromKey to toKey. The view is backed by the map, changes to the
+// map are reflected in the view and vice versa. SubMap panics if fromKey is greater than toKey and the view panics on attempts to insert
+// a key outside of its range.
+func (treeMap *TreeMap[K, V]) SubMap(fromKey K, fromInclusive bool, toKey K, toInclusive bool) collections.NavigableMap[K, V] {
+	return newSubMap(treeMap, rbt.Unbounded[K](), rbt.Unbounded[K](), false).SubMap(fromKey, fromInclusive, toKey, toInclusive)
+}
+
+// HeadMap returns a view of the portion of the map with keys less than toKey, or equal to it if inclusive is true. The view is backed by
+// the map, changes to the map are reflected in the view and vice versa. The view panics on attempts to insert a key outside of its range.
+func (treeMap *TreeMap[K, V]) HeadMap(toKey K, inclusive bool) collections.NavigableMap[K, V] {
+	return newSubMap(treeMap, rbt.Unbounded[K](), bound(toKey, inclusive), false)
+}
+
+// TailMap returns a view of the portion of the map with keys greater than fromKey, or equal to it if inclusive is true. The view is
+// backed by the map, changes to the map are reflected in the view and vice versa. The view panics on attempts to insert a key outside
+// of its range.
+func (treeMap *TreeMap[K, V]) TailMap(fromKey K, inclusive bool) collections.NavigableMap[K, V] {
+	return newSubMap(treeMap, bound(fromKey, inclusive), rbt.Unbounded[K](), false)
+}
+
+// At returns the entry with the given index in the sorted order of keys, the entry with the smallest key has index 0. At panics if the
+// index is out of bounds.
+func (treeMap *TreeMap[K, V]) At(i int) pair.Pair[K, V] {
+	entry := treeMap.tree.Select(i)
+	if entry.Empty() {
+		panic(errors.IndexOutOfBounds(i, treeMap.Len()))
+	}
+	return entry.Value()
+}
+
+// Rank returns the number of keys in the map that are strictly less than the given key. The key does not have to be in the map.
+func (treeMap *TreeMap[K, V]) Rank(key K) int {
+	return treeMap.tree.Rank(key)
 }
 
 // String returns the string representation of the map.
@@ -166,12 +356,12 @@ func (treeMap *TreeMap[K, V]) String() string {
 		return "{}"
 	}
 	sb.WriteString("{")
-	for i, node := range treeMap.tree.Nodes() {
-		if i == 0 {
-			sb.WriteString(fmt.Sprintf("%v=%v", node.Key(), node.Value()))
-		} else {
-			sb.WriteString(fmt.Sprintf(", %v=%v", node.Key(), node.Value()))
-		}
+	it := treeMap.tree.Iterator()
+	node := it.Next()
+	sb.WriteString(fmt.Sprintf("%v=%v", node.Key(), node.Value()))
+	for it.HasNext() {
+		node = it.Next()
+		sb.WriteString(fmt.Sprintf(", %v=%v", node.Key(), node.Value()))
 	}
 	sb.WriteString("}")
 	return sb.String()
@@ -2,9 +2,12 @@ package treemap
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"testing"
 
 	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
 	"github.com/phantom820/collections/iterator"
 	"github.com/phantom820/collections/maps/hashmap"
 	"github.com/phantom820/collections/maps/linkedhashmap"
@@ -605,3 +608,294 @@ func TestEquals(t *testing.T) {
 
 	}
 }
+
+func TestAll(t *testing.T) {
+
+	lessThanString := func(k1, k2 string) bool { return k1 < k2 }
+	treeMap := New(lessThanString, pair.Of("C", 3), pair.Of("A", 1), pair.Of("B", 2))
+
+	assert.Equal(t, map[string]int{}, maps.Collect(New[string, int](lessThanString).All()))
+	assert.Equal(t, map[string]int{"A": 1, "B": 2, "C": 3}, maps.Collect(treeMap.All()))
+	assert.Equal(t, []string{"A", "B", "C"}, slices.Collect(treeMap.AllKeys()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(treeMap.AllValues()))
+
+	keys := make([]string, 0)
+	for key := range treeMap.All() {
+		if key == "C" {
+			break
+		}
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []string{"A", "B"}, keys)
+}
+
+func TestConcurrentModification(t *testing.T) {
+
+	lessThan := func(k1, k2 int) bool { return k1 < k2 }
+
+	type concurrentModificationTest struct {
+		modify func(treeMap *TreeMap[int, int])
+	}
+
+	concurrentModificationTests := []concurrentModificationTest{
+		{modify: func(treeMap *TreeMap[int, int]) { treeMap.Put(4, 4) }},
+		{modify: func(treeMap *TreeMap[int, int]) { treeMap.Remove(2) }},
+		{modify: func(treeMap *TreeMap[int, int]) { treeMap.RemoveIf(func(k int) bool { return k > 1 }) }},
+		{modify: func(treeMap *TreeMap[int, int]) { treeMap.Clear() }},
+	}
+
+	for _, test := range concurrentModificationTests {
+		treeMap := New[int, int](lessThan, pair.Of(1, 1), pair.Of(2, 2), pair.Of(3, 3))
+		it := treeMap.Iterator()
+		it.Next()
+		test.modify(treeMap)
+		assert.PanicsWithError(t, errors.ConcurrentModification("TreeMap").Error(), func() { it.Next() })
+		assert.PanicsWithError(t, errors.ConcurrentModification("TreeMap").Error(), func() {
+			treeMap := New[int, int](lessThan, pair.Of(1, 1), pair.Of(2, 2), pair.Of(3, 3))
+			for range treeMap.AllValues() {
+				test.modify(treeMap)
+			}
+		})
+	}
+
+	// updating the value of a mapped key is not a structural modification.
+	treeMap := New[int, int](lessThan, pair.Of(1, 1), pair.Of(2, 2))
+	it := treeMap.Iterator()
+	it.Next()
+	treeMap.Put(1, 3)
+	assert.Equal(t, pair.Of(2, 2), it.Next())
+}
+
+func TestRemovableIterator(t *testing.T) {
+
+	m := New[int, int](func(k1, k2 int) bool { return k1 < k2 }, pair.Of(4, 4), pair.Of(3, 3), pair.Of(2, 2), pair.Of(1, 1))
+	it := m.RemovableIterator()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "TreeMap").Error(), func() { it.Remove() })
+	for it.HasNext() {
+		if entry := it.Next(); entry.Key()%2 == 0 {
+			it.Remove()
+		}
+	}
+	assert.Equal(t, []int{1, 3}, m.Keys())
+
+	it = m.RemovableIterator()
+	assert.Equal(t, pair.Of(1, 1), it.Next())
+	it.Remove()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "TreeMap").Error(), func() { it.Remove() })
+	assert.Equal(t, pair.Of(3, 3), it.Next())
+	m.Put(5, 5)
+	assert.PanicsWithError(t, errors.ConcurrentModification("TreeMap").Error(), func() { it.Remove() })
+}
+
+func TestNavigableMap(t *testing.T) {
+
+	lessThan := func(k1, k2 int) bool { return k1 < k2 }
+	var treeMap collections.NavigableMap[int, string] = New[int, string](lessThan, pair.Of(10, "a"), pair.Of(20, "b"), pair.Of(30, "c"))
+
+	type navigableMapTest struct {
+		input    any
+		expected any
+	}
+
+	navigableMapTests := []navigableMapTest{
+		{input: treeMap.FirstEntry(), expected: optional.Of(pair.Of(10, "a"))},
+		{input: treeMap.LastEntry(), expected: optional.Of(pair.Of(30, "c"))},
+		{input: treeMap.FloorKey(25), expected: optional.Of(20)},
+		{input: treeMap.FloorEntry(20), expected: optional.Of(pair.Of(20, "b"))},
+		{input: treeMap.FloorKey(5), expected: optional.Empty[int]()},
+		{input: treeMap.CeilingKey(25), expected: optional.Of(30)},
+		{input: treeMap.CeilingEntry(30), expected: optional.Of(pair.Of(30, "c"))},
+		{input: treeMap.CeilingKey(35), expected: optional.Empty[int]()},
+		{input: treeMap.LowerKey(20), expected: optional.Of(10)},
+		{input: treeMap.LowerEntry(10), expected: optional.Empty[pair.Pair[int, string]]()},
+		{input: treeMap.HigherKey(20), expected: optional.Of(30)},
+		{input: treeMap.HigherEntry(30), expected: optional.Empty[pair.Pair[int, string]]()},
+	}
+
+	for _, test := range navigableMapTests {
+		assert.Equal(t, test.expected, test.input)
+	}
+
+	assert.Equal(t, optional.Of(pair.Of(10, "a")), treeMap.PollFirstEntry())
+	assert.Equal(t, optional.Of(pair.Of(30, "c")), treeMap.PollLastEntry())
+	assert.Equal(t, []int{20}, treeMap.Keys())
+	assert.Equal(t, optional.Of(pair.Of(20, "b")), treeMap.PollLastEntry())
+	assert.True(t, treeMap.PollFirstEntry().Empty())
+	assert.True(t, treeMap.PollLastEntry().Empty())
+	assert.True(t, treeMap.FirstEntry().Empty())
+}
+
+func TestDescendingMap(t *testing.T) {
+
+	lessThan := func(k1, k2 int) bool { return k1 < k2 }
+	treeMap := New[int, string](lessThan, pair.Of(10, "a"), pair.Of(20, "b"), pair.Of(30, "c"))
+	descendingMap := treeMap.DescendingMap()
+
+	assert.Equal(t, []int{30, 20, 10}, descendingMap.Keys())
+	assert.Equal(t, []string{"c", "b", "a"}, descendingMap.Values())
+	assert.Equal(t, []int{30, 20, 10}, slices.Collect(descendingMap.AllKeys()))
+	assert.Equal(t, []pair.Pair[int, string]{pair.Of(30, "c"), pair.Of(20, "b"), pair.Of(10, "a")}, iterator.ToSlice(descendingMap.Iterator()))
+	assert.Equal(t, "{30=c, 20=b, 10=a}", fmt.Sprint(descendingMap))
+	assert.Equal(t, optional.Of(pair.Of(30, "c")), descendingMap.FirstEntry())
+	assert.Equal(t, optional.Of(pair.Of(10, "a")), descendingMap.LastEntry())
+	assert.Equal(t, optional.Of(30), descendingMap.FloorKey(25))
+	assert.Equal(t, optional.Of(20), descendingMap.CeilingKey(25))
+	assert.Equal(t, optional.Of(10), descendingMap.HigherKey(20))
+	assert.Equal(t, optional.Of(30), descendingMap.LowerKey(20))
+	assert.True(t, descendingMap.Equals(treeMap, func(a, b string) bool { return a == b }))
+	assert.True(t, descendingMap.DescendingMap() == treeMap)
+
+	// the view is backed by the map.
+	descendingMap.Put(40, "d")
+	treeMap.Remove(10)
+	assert.Equal(t, []int{40, 30, 20}, descendingMap.Keys())
+	assert.Equal(t, []int{20, 30, 40}, treeMap.Keys())
+	assert.Equal(t, optional.Of(pair.Of(40, "d")), descendingMap.PollFirstEntry())
+	assert.Equal(t, optional.Of(pair.Of(20, "b")), descendingMap.PollLastEntry())
+	assert.Equal(t, []int{30}, treeMap.Keys())
+}
+
+func TestSubMap(t *testing.T) {
+
+	lessThan := func(k1, k2 int) bool { return k1 < k2 }
+	treeMap := New[int, string](lessThan)
+	for i := 1; i <= 10; i++ {
+		treeMap.Put(i*10, fmt.Sprint(i))
+	}
+
+	subMap := treeMap.SubMap(20, true, 60, false)
+	headMap := treeMap.HeadMap(30, true)
+	tailMap := treeMap.TailMap(80, false)
+
+	assert.Equal(t, []int{20, 30, 40, 50}, subMap.Keys())
+	assert.Equal(t, []int{10, 20, 30}, headMap.Keys())
+	assert.Equal(t, []int{90, 100}, tailMap.Keys())
+	assert.Equal(t, 4, subMap.Len())
+	assert.Equal(t, "{20=2, 30=3, 40=4, 50=5}", fmt.Sprint(subMap))
+	assert.True(t, subMap.Get(60).Empty())
+	assert.False(t, subMap.ContainsKey(10))
+	assert.True(t, subMap.ContainsKey(20))
+
+	// Navigation is restricted to the range of the view.
+	assert.Equal(t, optional.Of(pair.Of(20, "2")), subMap.FirstEntry())
+	assert.Equal(t, optional.Of(pair.Of(50, "5")), subMap.LastEntry())
+	assert.Equal(t, optional.Of(50), subMap.FloorKey(100))
+	assert.Equal(t, optional.Of(50), subMap.LowerKey(60))
+	assert.True(t, subMap.FloorKey(15).Empty())
+	assert.Equal(t, optional.Of(20), subMap.CeilingKey(0))
+	assert.Equal(t, optional.Of(30), subMap.HigherKey(20))
+	assert.True(t, subMap.HigherKey(50).Empty())
+
+	// The views are backed by the map.
+	treeMap.Put(45, "4.5")
+	treeMap.Remove(20)
+	assert.Equal(t, []int{30, 40, 45, 50}, subMap.Keys())
+	subMap.Put(55, "5.5")
+	assert.Equal(t, optional.Of("5.5"), treeMap.Get(55))
+	assert.Equal(t, optional.Of(pair.Of(30, "3")), subMap.PollFirstEntry())
+	assert.False(t, treeMap.ContainsKey(30))
+	assert.Equal(t, []int{10}, headMap.Keys())
+	tailMap.Clear()
+	assert.Equal(t, []int{10, 40, 45, 50, 55, 60, 70, 80}, treeMap.Keys())
+	assert.True(t, tailMap.Empty())
+	assert.True(t, subMap.RemoveIf(func(k int) bool { return k%10 == 5 }))
+	assert.Equal(t, []int{10, 40, 50, 60, 70, 80}, treeMap.Keys())
+
+	// Insertions outside the range of the view are rejected.
+	assert.PanicsWithError(t, errors.IllegalArgument("key", 60).Error(), func() { subMap.Put(60, "6") })
+	assert.PanicsWithError(t, errors.IllegalArgument("key", 80).Error(), func() { tailMap.PutIfAbsent(80, "8") })
+	assert.PanicsWithError(t, errors.IllegalArgument("fromKey", 60).Error(), func() { treeMap.SubMap(60, true, 20, true) })
+
+	// Views of views are restricted to the range of the enclosing view.
+	nested := subMap.TailMap(40, false)
+	assert.Equal(t, []int{50}, nested.Keys())
+	assert.Equal(t, []int{50, 40}, subMap.DescendingMap().Keys())
+	assert.Equal(t, []int{50}, subMap.DescendingMap().HeadMap(40, false).Keys())
+	assert.Equal(t, []int{70, 60, 50}, treeMap.DescendingMap().SubMap(70, true, 40, false).Keys())
+	assert.Equal(t, []int{50}, subMap.SubMap(20, true, 60, false).TailMap(45, true).Keys())
+	assert.PanicsWithError(t, errors.IllegalArgument("toKey", 70).Error(), func() { subMap.HeadMap(70, true) })
+	assert.PanicsWithError(t, errors.IllegalArgument("toKey", 60).Error(), func() { subMap.HeadMap(60, true) })
+	assert.NotPanics(t, func() { subMap.HeadMap(60, false) })
+}
+
+func TestSubMapIterator(t *testing.T) {
+
+	lessThan := func(k1, k2 int) bool { return k1 < k2 }
+	treeMap := New[int, int](lessThan, pair.Of(1, 1), pair.Of(2, 2), pair.Of(3, 3), pair.Of(4, 4), pair.Of(5, 5))
+	subMap := treeMap.SubMap(2, true, 4, true)
+
+	assert.Equal(t, []pair.Pair[int, int]{pair.Of(2, 2), pair.Of(3, 3), pair.Of(4, 4)}, iterator.ToSlice(subMap.Iterator()))
+	assert.Equal(t, []pair.Pair[int, int]{pair.Of(4, 4), pair.Of(3, 3), pair.Of(2, 2)}, iterator.ToSlice(subMap.DescendingMap().Iterator()))
+	assert.Equal(t, []int{2, 3, 4}, slices.Collect(subMap.AllValues()))
+
+	it := subMap.Iterator()
+	it.Next()
+	treeMap.Put(6, 6)
+	assert.PanicsWithError(t, errors.ConcurrentModification("TreeMap").Error(), func() { it.Next() })
+}
+
+func TestOrderStatistics(t *testing.T) {
+
+	lessThan := func(k1, k2 int) bool { return k1 < k2 }
+	treeMap := New[int, string](lessThan)
+	for i := 1; i <= 10; i++ {
+		treeMap.Put(i*10, fmt.Sprint(i))
+	}
+
+	assert.Equal(t, pair.Of(10, "1"), treeMap.At(0))
+	assert.Equal(t, pair.Of(100, "10"), treeMap.At(9))
+	assert.Equal(t, 0, treeMap.Rank(10))
+	assert.Equal(t, 4, treeMap.Rank(45))
+	assert.Equal(t, 10, treeMap.Rank(200))
+	assert.PanicsWithError(t, errors.IndexOutOfBounds(10, 10).Error(), func() { treeMap.At(10) })
+	assert.PanicsWithError(t, errors.IndexOutOfBounds(-1, 10).Error(), func() { treeMap.At(-1) })
+
+	view := treeMap.SubMap(30, false, 80, true).(*subMap[int, string])
+	assert.Equal(t, 5, view.Len())
+	assert.Equal(t, pair.Of(40, "4"), view.At(0))
+	assert.Equal(t, pair.Of(80, "8"), view.At(4))
+	assert.Equal(t, 0, view.Rank(10))
+	assert.Equal(t, 2, view.Rank(55))
+	assert.Equal(t, 5, view.Rank(100))
+	assert.PanicsWithError(t, errors.IndexOutOfBounds(5, 5).Error(), func() { view.At(5) })
+
+	descendingMap := view.DescendingMap().(*subMap[int, string])
+	assert.Equal(t, pair.Of(80, "8"), descendingMap.At(0))
+	assert.Equal(t, pair.Of(40, "4"), descendingMap.At(4))
+	assert.Equal(t, 0, descendingMap.Rank(100))
+	assert.Equal(t, 3, descendingMap.Rank(50))
+	assert.Equal(t, 5, descendingMap.Rank(10))
+
+	treeMap.Remove(50)
+	assert.Equal(t, 4, view.Len())
+	assert.Equal(t, pair.Of(60, "6"), view.At(1))
+	assert.Equal(t, 0, treeMap.SubMap(51, true, 59, true).Len())
+}
+
+func TestIteratorRemoval(t *testing.T) {
+
+	lessThan := func(k1, k2 int) bool { return k1 < k2 }
+	treeMap := New[int, int](lessThan)
+	for i := 1; i <= 100; i++ {
+		treeMap.Put(i, i)
+	}
+
+	// Entries added before iteration starts are visible to the iterator.
+	it := treeMap.DescendingMap().(*subMap[int, int]).RemovableIterator()
+	treeMap.Put(101, 101)
+	for it.HasNext() {
+		if it.Next().Key()%2 == 1 {
+			it.Remove()
+		}
+	}
+	assert.Equal(t, 50, treeMap.Len())
+	assert.Equal(t, pair.Of(2, 2), treeMap.At(0))
+	assert.Equal(t, optional.Of(pair.Of(100, 100)), treeMap.LastEntry())
+
+	it = treeMap.SubMap(10, true, 20, true).(*subMap[int, int]).RemovableIterator()
+	for it.HasNext() {
+		it.Next()
+		it.Remove()
+	}
+	assert.Equal(t, []int{2, 4, 6, 8, 22}, treeMap.HeadMap(22, true).Keys())
+}
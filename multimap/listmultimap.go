@@ -0,0 +1,80 @@
+package multimap
+
+import (
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/maps/linkedhashmap"
+	"github.com/phantom820/collections/maps/treemap"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/phantom820/collections/sets/linkedhashset"
+	"github.com/phantom820/collections/sets/treeset"
+)
+
+// HashListMultimap a [ListMultimap] that keeps its keys in a [hashmap.HashMap] and the values of each key in a [vector.Vector].
+type HashListMultimap[K comparable, V comparable] struct {
+	multimap[K, V, *vector.Vector[V]]
+}
+
+// NewHashListMultimap creates an empty multimap.
+func NewHashListMultimap[K comparable, V comparable]() *HashListMultimap[K, V] {
+	multimap := HashListMultimap[K, V]{}
+	multimap.values = hashmap.New[K, *vector.Vector[V]]()
+	multimap.newCollection = func() *vector.Vector[V] { return vector.New[V]() }
+	multimap.newKeySet = func() collections.Set[K] { return hashset.New[K]() }
+	return &multimap
+}
+
+// Inverse returns a new multimap that has an entry v, k for every entry k, v of the multimap.
+func (multimap *HashListMultimap[K, V]) Inverse() ListMultimap[V, K] {
+	inverse := NewHashListMultimap[V, K]()
+	multimap.invert(inverse)
+	return inverse
+}
+
+// LinkedListMultimap a [ListMultimap] that keeps its keys in a [linkedhashmap.LinkedHashMap], so keys are iterated on in the order in
+// which they were first added, and the values of each key in a [vector.Vector].
+type LinkedListMultimap[K comparable, V comparable] struct {
+	multimap[K, V, *vector.Vector[V]]
+}
+
+// NewLinkedListMultimap creates an empty multimap.
+func NewLinkedListMultimap[K comparable, V comparable]() *LinkedListMultimap[K, V] {
+	multimap := LinkedListMultimap[K, V]{}
+	multimap.values = linkedhashmap.New[K, *vector.Vector[V]]()
+	multimap.newCollection = func() *vector.Vector[V] { return vector.New[V]() }
+	multimap.newKeySet = func() collections.Set[K] { return linkedhashset.New[K]() }
+	return &multimap
+}
+
+// Inverse returns a new multimap that has an entry v, k for every entry k, v of the multimap.
+func (multimap *LinkedListMultimap[K, V]) Inverse() ListMultimap[V, K] {
+	inverse := NewLinkedListMultimap[V, K]()
+	multimap.invert(inverse)
+	return inverse
+}
+
+// TreeListMultimap a [ListMultimap] that keeps its keys in a [treemap.TreeMap], so keys are iterated on in sorted order, and the
+// values of each key in a [vector.Vector].
+type TreeListMultimap[K comparable, V comparable] struct {
+	multimap[K, V, *vector.Vector[V]]
+	keyLessThan   func(k1, k2 K) bool
+	valueLessThan func(v1, v2 V) bool
+}
+
+// NewTreeListMultimap creates an empty multimap whose keys are compared using keyLessThan. The values are compared using valueLessThan
+// only to order the keys of the Inverse of the multimap.
+func NewTreeListMultimap[K comparable, V comparable](keyLessThan func(k1, k2 K) bool, valueLessThan func(v1, v2 V) bool) *TreeListMultimap[K, V] {
+	multimap := TreeListMultimap[K, V]{keyLessThan: keyLessThan, valueLessThan: valueLessThan}
+	multimap.values = treemap.New[K, *vector.Vector[V]](keyLessThan)
+	multimap.newCollection = func() *vector.Vector[V] { return vector.New[V]() }
+	multimap.newKeySet = func() collections.Set[K] { return treeset.New(keyLessThan) }
+	return &multimap
+}
+
+// Inverse returns a new multimap that has an entry v, k for every entry k, v of the multimap.
+func (multimap *TreeListMultimap[K, V]) Inverse() ListMultimap[V, K] {
+	inverse := NewTreeListMultimap(multimap.valueLessThan, multimap.keyLessThan)
+	multimap.invert(inverse)
+	return inverse
+}
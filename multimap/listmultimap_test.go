@@ -0,0 +1,66 @@
+package multimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMultimap(t *testing.T) {
+
+	type listMultimapTest struct {
+		input ListMultimap[int, int]
+	}
+
+	listMultimapTests := []listMultimapTest{
+		{input: NewHashListMultimap[int, int]()},
+		{input: NewLinkedListMultimap[int, int]()},
+		{input: NewTreeListMultimap(lessThan, lessThan)},
+	}
+
+	for _, test := range listMultimapTests {
+		// Values are kept in insertion order and duplicate entries are allowed.
+		assert.True(t, test.input.Put(1, 12))
+		assert.True(t, test.input.Put(1, 10))
+		assert.True(t, test.input.Put(1, 12))
+		assert.Equal(t, 3, test.input.Len())
+		assert.Equal(t, []int{12, 10, 12}, test.input.Get(1).ToSlice())
+
+		// Remove only removes a single entry.
+		assert.True(t, test.input.Remove(1, 12))
+		assert.Equal(t, []int{10, 12}, test.input.Get(1).ToSlice())
+
+		test.input.Put(2, 10)
+		inverse := test.input.Inverse()
+		assert.Equal(t, 3, inverse.Len())
+		assert.ElementsMatch(t, []int{1, 2}, inverse.Get(10).ToSlice())
+		assert.Equal(t, []int{1}, inverse.Get(12).ToSlice())
+		assert.ElementsMatch(t, []int{10, 12}, inverse.KeySet().ToSlice())
+
+		// The inverse is a separate multimap.
+		inverse.Put(10, 3)
+		assert.False(t, test.input.ContainsKey(3))
+	}
+}
+
+func TestLinkedListMultimap(t *testing.T) {
+
+	multimap := NewLinkedListMultimap[string, int]()
+	multimap.Put("b", 1)
+	multimap.Put("a", 2)
+	multimap.Put("b", 3)
+	assert.Equal(t, []string{"b", "a"}, multimap.KeySet().ToSlice())
+	assert.Equal(t, "{b=[1 3], a=[2]}", multimap.String())
+	assert.Equal(t, "{1=[b], 3=[b], 2=[a]}", multimap.Inverse().(*LinkedListMultimap[int, string]).String())
+}
+
+func TestTreeListMultimap(t *testing.T) {
+
+	multimap := NewTreeListMultimap(func(a, b string) bool { return a < b }, lessThan)
+	multimap.Put("b", 3)
+	multimap.Put("a", 2)
+	multimap.Put("b", 1)
+	assert.Equal(t, []string{"a", "b"}, multimap.KeySet().ToSlice())
+	assert.Equal(t, "{a=[2], b=[3 1]}", multimap.String())
+	assert.Equal(t, "{1=[b], 2=[a], 3=[b]}", multimap.Inverse().(*TreeListMultimap[int, string]).String())
+}
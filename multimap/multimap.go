@@ -0,0 +1,252 @@
+// package multimap defines maps that associate each key with a collection of values. An entry of a multimap is a single key, value
+// pair and the values of a key are held in a collection built from the list and set types of this module.
+//
+//  1. ListMultimap[K, V] : The values of a key are kept in insertion order and may contain duplicates.
+//     a. HashListMultimap[K, V] : Keys are kept in a [hashmap.HashMap] with no particular ordering.
+//     b. LinkedListMultimap[K, V] : Keys are kept in a [linkedhashmap.LinkedHashMap] and iterated on in insertion order.
+//     c. TreeListMultimap[K, V] : Keys are kept in a [treemap.TreeMap] and iterated on in sorted order.
+//  2. SetMultimap[K, V] : The values of a key are unique.
+//     a. HashSetMultimap[K, V] : Keys are kept in a [hashmap.HashMap] and values in a [hashset.HashSet].
+//     b. LinkedSetMultimap[K, V] : Keys are kept in a [linkedhashmap.LinkedHashMap] and values in a [linkedhashset.LinkedHashSet].
+//     c. TreeSetMultimap[K, V] : Keys are kept in a [treemap.TreeMap] and values in a [treeset.TreeSet].
+package multimap
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// Multimap a container that maps each key to a collection of values. A key is only present while it has at least one value.
+type Multimap[K comparable, V comparable] interface {
+	iterable.Iterable[pair.Pair[K, V]]
+	Put(k K, v V) bool                              // Adds the key, value entry and returns true if the multimap changed as a result of the operation.
+	PutAll(k K, iterable iterable.Iterable[V]) bool // Adds an entry for the key and each of the values in the iterable and returns true if the multimap changed as a result of the operation.
+	Get(k K) collections.Collection[V]              // Returns a view of the values of the key, changes to the view are reflected in the multimap and vice versa.
+	Remove(k K, v V) bool                           // Removes a single key, value entry and returns true if the multimap changed as a result of the operation.
+	RemoveAll(k K) collections.Collection[V]        // Removes all the entries of the key and returns their values.
+	ContainsKey(k K) bool                           // Returns true if the multimap contains at least one entry with the key.
+	ContainsValue(v V) bool                         // Returns true if the multimap contains at least one entry with the value.
+	ContainsEntry(k K, v V) bool                    // Returns true if the multimap contains the key, value entry.
+	KeySet() collections.Set[K]                     // Returns a set containing the distinct keys of the multimap.
+	Entries() iter.Seq2[K, V]                       // Returns a sequence over the key, value entries of the multimap.
+	ForEach(f func(K, V))                           // Performs the given action for each key, value entry of the multimap.
+	Len() int                                       // Returns the number of key, value entries in the multimap.
+	Empty() bool                                    // Returns true if the multimap contains no entries.
+	Clear()                                         // Removes all of the entries from the multimap.
+}
+
+// ListMultimap a [Multimap] that keeps the values of each key in insertion order and allows duplicate key, value entries.
+type ListMultimap[K comparable, V comparable] interface {
+	Multimap[K, V]
+	Inverse() ListMultimap[V, K] // Returns a new multimap that has an entry v, k for every entry k, v of the multimap.
+}
+
+// SetMultimap a [Multimap] that does not allow duplicate key, value entries.
+type SetMultimap[K comparable, V comparable] interface {
+	Multimap[K, V]
+	Inverse() SetMultimap[V, K] // Returns a new multimap that has an entry v, k for every entry k, v of the multimap.
+}
+
+// multimap the implementation of [Multimap] shared by the list and set multimaps, the values of a key are held in a collection of
+// type C.
+type multimap[K comparable, V comparable, C collections.Collection[V]] struct {
+	values        collections.Map[K, C]     // The collection of values of each key.
+	newCollection func() C                  // Creates the collection of values of a new key.
+	newKeySet     func() collections.Set[K] // Creates the set returned by KeySet.
+	len           int
+}
+
+// collection returns the collection of values of the key and whether the key is present.
+func (multimap *multimap[K, V, C]) collection(key K) (C, bool) {
+	collection := multimap.values.Get(key)
+	if collection.Empty() {
+		var zero C
+		return zero, false
+	}
+	return collection.Value(), true
+}
+
+// update applies the given modification to the values of the key, keeps the number of entries in sync and removes the key once it
+// has no values left. A collection is created for an absent key if create is true, otherwise the modification is skipped. Returns
+// true if the number of values of the key changed.
+func (multimap *multimap[K, V, C]) update(key K, create bool, f func(C)) bool {
+	collection, ok := multimap.collection(key)
+	if !ok {
+		if !create {
+			return false
+		}
+		collection = multimap.newCollection()
+	}
+	before := collection.Len()
+	f(collection)
+	multimap.len += collection.Len() - before
+	if collection.Empty() {
+		if ok {
+			multimap.values.Remove(key)
+		}
+	} else if !ok {
+		multimap.values.Put(key, collection)
+	}
+	return collection.Len() != before
+}
+
+// Put adds the key, value entry and returns true if the multimap changed as a result of the operation.
+func (multimap *multimap[K, V, C]) Put(key K, value V) bool {
+	return multimap.update(key, true, func(collection C) { collection.Add(value) })
+}
+
+// PutAll adds an entry for the key and each of the values in the iterable and returns true if the multimap changed as a result of
+// the operation.
+func (multimap *multimap[K, V, C]) PutAll(key K, iterable iterable.Iterable[V]) bool {
+	return multimap.update(key, true, func(collection C) { collection.AddAll(iterable) })
+}
+
+// Get returns a view of the values of the key. The view reflects the current values of the key even if the key is absent when the view
+// is created, changes to the view are reflected in the multimap and vice versa.
+func (multimap *multimap[K, V, C]) Get(key K) collections.Collection[V] {
+	return &valuesView[K, V, C]{multimap: multimap, key: key}
+}
+
+// Remove removes a single key, value entry and returns true if the multimap changed as a result of the operation.
+func (multimap *multimap[K, V, C]) Remove(key K, value V) bool {
+	return multimap.update(key, false, func(collection C) { collection.Remove(value) })
+}
+
+// RemoveAll removes all the entries of the key and returns their values.
+func (multimap *multimap[K, V, C]) RemoveAll(key K) collections.Collection[V] {
+	collection, ok := multimap.collection(key)
+	if !ok {
+		return multimap.newCollection()
+	}
+	multimap.values.Remove(key)
+	multimap.len -= collection.Len()
+	return collection
+}
+
+// ContainsKey returns true if the multimap contains at least one entry with the key.
+func (multimap *multimap[K, V, C]) ContainsKey(key K) bool {
+	return multimap.values.ContainsKey(key)
+}
+
+// ContainsValue returns true if the multimap contains at least one entry with the value.
+func (multimap *multimap[K, V, C]) ContainsValue(value V) bool {
+	for collection := range multimap.values.AllValues() {
+		if collection.Contains(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsEntry returns true if the multimap contains the key, value entry.
+func (multimap *multimap[K, V, C]) ContainsEntry(key K, value V) bool {
+	collection, ok := multimap.collection(key)
+	return ok && collection.Contains(value)
+}
+
+// KeySet returns a set containing the distinct keys of the multimap.
+func (multimap *multimap[K, V, C]) KeySet() collections.Set[K] {
+	keys := multimap.newKeySet()
+	for key := range multimap.values.AllKeys() {
+		keys.Add(key)
+	}
+	return keys
+}
+
+// Entries returns a sequence over the key, value entries of the multimap.
+func (multimap *multimap[K, V, C]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, collection := range multimap.values.All() {
+			for value := range collection.All() {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Iterator returns an iterator over the key, value entries of the multimap.
+func (multimap *multimap[K, V, C]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
+	return &entryIterator[K, V, C]{keys: multimap.values.Iterator()}
+}
+
+// ForEach performs the given action for each key, value entry of the multimap.
+func (multimap *multimap[K, V, C]) ForEach(f func(K, V)) {
+	for key, value := range multimap.Entries() {
+		f(key, value)
+	}
+}
+
+// Len returns the number of key, value entries in the multimap.
+func (multimap *multimap[K, V, C]) Len() int {
+	return multimap.len
+}
+
+// Empty returns true if the multimap contains no entries.
+func (multimap *multimap[K, V, C]) Empty() bool {
+	return multimap.len == 0
+}
+
+// Clear removes all of the entries from the multimap.
+func (multimap *multimap[K, V, C]) Clear() {
+	multimap.values.Clear()
+	multimap.len = 0
+}
+
+// invert adds an entry v, k to the given multimap for every entry k, v of the multimap.
+func (multimap *multimap[K, V, C]) invert(inverse Multimap[V, K]) {
+	for key, value := range multimap.Entries() {
+		inverse.Put(value, key)
+	}
+}
+
+// String returns the string representation of the multimap.
+func (multimap *multimap[K, V, C]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for key, collection := range multimap.values.All() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", key, collection))
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// entryIterator iterator implementation for the entries of a multimap.
+type entryIterator[K comparable, V comparable, C collections.Collection[V]] struct {
+	keys   iterator.Iterator[pair.Pair[K, C]]
+	key    K
+	values iterator.Iterator[V]
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *entryIterator[K, V, C]) HasNext() bool {
+	for it.values == nil || !it.values.HasNext() {
+		if !it.keys.HasNext() {
+			return false
+		}
+		next := it.keys.Next()
+		it.key, it.values = next.Key(), next.Value().Iterator()
+	}
+	return true
+}
+
+// Next returns the next element in the iterator.
+func (it *entryIterator[K, V, C]) Next() pair.Pair[K, V] {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	}
+	return pair.Of(it.key, it.values.Next())
+}
@@ -0,0 +1,406 @@
+package multimap
+
+import (
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func lessThan(a, b int) bool {
+	return a < b
+}
+
+// putEntries adds the entries to the multimap and returns it.
+func putEntries[M Multimap[int, int]](multimap M, entries ...pair.Pair[int, int]) M {
+	for _, entry := range entries {
+		multimap.Put(entry.Key(), entry.Value())
+	}
+	return multimap
+}
+
+func TestNew(t *testing.T) {
+
+	for _, multimap := range []Multimap[int, int]{NewHashListMultimap[int, int](), NewLinkedListMultimap[int, int](),
+		NewTreeListMultimap(lessThan, lessThan), NewHashSetMultimap[int, int](), NewLinkedSetMultimap[int, int](),
+		NewTreeSetMultimap(lessThan, lessThan)} {
+		assert.NotNil(t, multimap)
+		assert.True(t, multimap.Empty())
+		assert.Equal(t, 0, multimap.Len())
+		assert.False(t, multimap.Iterator().HasNext())
+	}
+}
+
+func TestPut(t *testing.T) {
+
+	type putTest struct {
+		input    Multimap[int, int]
+		entries  []pair.Pair[int, int]
+		changed  []bool
+		expected []pair.Pair[int, int]
+	}
+
+	entries := []pair.Pair[int, int]{pair.Of(1, 10), pair.Of(1, 10), pair.Of(2, 20)}
+	putTests := []putTest{
+		{input: NewHashListMultimap[int, int](), entries: entries, changed: []bool{true, true, true}, expected: entries},
+		{input: NewLinkedListMultimap[int, int](), entries: entries, changed: []bool{true, true, true}, expected: entries},
+		{input: NewTreeListMultimap(lessThan, lessThan), entries: entries, changed: []bool{true, true, true}, expected: entries},
+		{input: NewHashSetMultimap[int, int](), entries: entries, changed: []bool{true, false, true},
+			expected: []pair.Pair[int, int]{pair.Of(1, 10), pair.Of(2, 20)}},
+		{input: NewLinkedSetMultimap[int, int](), entries: entries, changed: []bool{true, false, true},
+			expected: []pair.Pair[int, int]{pair.Of(1, 10), pair.Of(2, 20)}},
+		{input: NewTreeSetMultimap(lessThan, lessThan), entries: entries, changed: []bool{true, false, true},
+			expected: []pair.Pair[int, int]{pair.Of(1, 10), pair.Of(2, 20)}},
+	}
+
+	for _, test := range putTests {
+		for i, entry := range test.entries {
+			assert.Equal(t, test.changed[i], test.input.Put(entry.Key(), entry.Value()))
+		}
+		assert.ElementsMatch(t, test.expected, iterator.ToSlice(test.input.Iterator()))
+		assert.Equal(t, len(test.expected), test.input.Len())
+	}
+}
+
+func TestPutAll(t *testing.T) {
+
+	type putAllTest struct {
+		input    Multimap[int, int]
+		key      int
+		values   []int
+		changed  bool
+		expected []int
+	}
+
+	putAllTests := []putAllTest{
+		{input: NewHashListMultimap[int, int](), key: 1, values: []int{}, changed: false, expected: []int{}},
+		{input: putEntries(NewLinkedListMultimap[int, int](), pair.Of(1, 10)), key: 1, values: []int{10, 11}, changed: true,
+			expected: []int{10, 10, 11}},
+		{input: NewTreeListMultimap(lessThan, lessThan), key: 1, values: []int{11, 10}, changed: true, expected: []int{11, 10}},
+		{input: putEntries(NewHashSetMultimap[int, int](), pair.Of(1, 10)), key: 1, values: []int{10}, changed: false, expected: []int{10}},
+		{input: NewLinkedSetMultimap[int, int](), key: 1, values: []int{11, 10, 11}, changed: true, expected: []int{11, 10}},
+		{input: putEntries(NewTreeSetMultimap(lessThan, lessThan), pair.Of(1, 12)), key: 1, values: []int{11, 10}, changed: true,
+			expected: []int{10, 11, 12}},
+	}
+
+	for _, test := range putAllTests {
+		assert.Equal(t, test.changed, test.input.PutAll(test.key, vector.Of(test.values...)))
+		assert.Equal(t, test.expected, test.input.Get(test.key).ToSlice())
+		assert.Equal(t, len(test.expected) > 0, test.input.ContainsKey(test.key))
+		assert.Equal(t, len(test.expected), test.input.Len())
+	}
+}
+
+func TestGet(t *testing.T) {
+
+	type getTest struct {
+		input    Multimap[int, int]
+		key      int
+		expected []int
+	}
+
+	getTests := []getTest{
+		{input: putEntries(NewHashListMultimap[int, int](), pair.Of(1, 11), pair.Of(1, 10)), key: 1, expected: []int{11, 10}},
+		{input: putEntries(NewLinkedListMultimap[int, int](), pair.Of(1, 11), pair.Of(2, 20)), key: 3, expected: []int{}},
+		{input: putEntries(NewTreeListMultimap(lessThan, lessThan), pair.Of(1, 11), pair.Of(1, 10)), key: 1, expected: []int{11, 10}},
+		{input: putEntries(NewHashSetMultimap[int, int](), pair.Of(1, 11), pair.Of(1, 11)), key: 1, expected: []int{11}},
+		{input: putEntries(NewLinkedSetMultimap[int, int](), pair.Of(1, 11), pair.Of(1, 10)), key: 1, expected: []int{11, 10}},
+		{input: putEntries(NewTreeSetMultimap(lessThan, lessThan), pair.Of(1, 11), pair.Of(1, 10)), key: 1, expected: []int{10, 11}},
+	}
+
+	for _, test := range getTests {
+		values := test.input.Get(test.key)
+		assert.Equal(t, test.expected, values.ToSlice())
+		assert.Equal(t, test.expected, iterator.ToSlice(values.Iterator()))
+		assert.Equal(t, len(test.expected), values.Len())
+		assert.Equal(t, len(test.expected) == 0, values.Empty())
+		slice := make([]int, 0)
+		values.ForEach(func(e int) { slice = append(slice, e) })
+		assert.Equal(t, test.expected, slice)
+		slice = make([]int, 0)
+		for e := range values.All() {
+			slice = append(slice, e)
+		}
+		assert.Equal(t, test.expected, slice)
+		for _, e := range test.expected {
+			assert.True(t, values.Contains(e))
+		}
+	}
+}
+
+func TestValuesView(t *testing.T) {
+
+	type valuesViewTest struct {
+		input Multimap[int, int]
+	}
+
+	valuesViewTests := []valuesViewTest{
+		{input: NewHashListMultimap[int, int]()},
+		{input: NewLinkedListMultimap[int, int]()},
+		{input: NewTreeListMultimap(lessThan, lessThan)},
+		{input: NewHashSetMultimap[int, int]()},
+		{input: NewLinkedSetMultimap[int, int]()},
+		{input: NewTreeSetMultimap(lessThan, lessThan)},
+	}
+
+	for _, test := range valuesViewTests {
+		values := test.input.Get(1)
+
+		// Changes to the multimap are reflected in the view, even if the key was absent when the view was created.
+		test.input.Put(1, 10)
+		test.input.Put(1, 11)
+		assert.Equal(t, 2, values.Len())
+		assert.ElementsMatch(t, []int{10, 11}, values.ToSlice())
+
+		// Changes to the view are reflected in the multimap.
+		assert.True(t, values.Add(12))
+		assert.True(t, values.AddAll(vector.Of(13)))
+		assert.True(t, values.AddSlice([]int{14, 15}))
+		assert.Equal(t, 6, test.input.Len())
+		assert.True(t, values.Remove(10))
+		assert.True(t, values.RemoveIf(func(e int) bool { return e == 11 }))
+		assert.True(t, values.RemoveAll(vector.Of(12)))
+		assert.True(t, values.RemoveSlice([]int{13}))
+		assert.True(t, values.RetainAll(hashset.New(14)))
+		assert.Equal(t, 1, test.input.Len())
+		assert.True(t, test.input.ContainsEntry(1, 14))
+
+		// Removing the last value removes the key, the view stays usable afterwards.
+		values.Clear()
+		assert.False(t, test.input.ContainsKey(1))
+		assert.True(t, test.input.Empty())
+		assert.False(t, values.Remove(14))
+		assert.False(t, values.RemoveIf(func(e int) bool { return true }))
+		values.Add(16)
+		assert.True(t, test.input.ContainsEntry(1, 16))
+		assert.Equal(t, 1, test.input.Len())
+	}
+}
+
+func TestRemove(t *testing.T) {
+
+	type removeTest struct {
+		input    Multimap[int, int]
+		key      int
+		value    int
+		removed  bool
+		expected []pair.Pair[int, int]
+	}
+
+	removeTests := []removeTest{
+		{input: NewHashListMultimap[int, int](), key: 1, value: 10, removed: false, expected: []pair.Pair[int, int]{}},
+		{input: putEntries(NewLinkedListMultimap[int, int](), pair.Of(1, 10), pair.Of(1, 10)), key: 1, value: 10, removed: true,
+			expected: []pair.Pair[int, int]{pair.Of(1, 10)}},
+		{input: putEntries(NewTreeListMultimap(lessThan, lessThan), pair.Of(1, 10), pair.Of(2, 20)), key: 1, value: 20, removed: false,
+			expected: []pair.Pair[int, int]{pair.Of(1, 10), pair.Of(2, 20)}},
+		{input: putEntries(NewHashSetMultimap[int, int](), pair.Of(1, 10), pair.Of(2, 20)), key: 1, value: 10, removed: true,
+			expected: []pair.Pair[int, int]{pair.Of(2, 20)}},
+		{input: putEntries(NewLinkedSetMultimap[int, int](), pair.Of(1, 10), pair.Of(1, 11)), key: 1, value: 11, removed: true,
+			expected: []pair.Pair[int, int]{pair.Of(1, 10)}},
+		{input: putEntries(NewTreeSetMultimap(lessThan, lessThan), pair.Of(1, 10)), key: 3, value: 10, removed: false,
+			expected: []pair.Pair[int, int]{pair.Of(1, 10)}},
+	}
+
+	for _, test := range removeTests {
+		assert.Equal(t, test.removed, test.input.Remove(test.key, test.value))
+		assert.ElementsMatch(t, test.expected, iterator.ToSlice(test.input.Iterator()))
+		assert.Equal(t, len(test.expected), test.input.Len())
+	}
+}
+
+func TestRemoveAll(t *testing.T) {
+
+	type removeAllTest struct {
+		input    Multimap[int, int]
+		key      int
+		removed  []int
+		expected []pair.Pair[int, int]
+	}
+
+	removeAllTests := []removeAllTest{
+		{input: NewHashListMultimap[int, int](), key: 1, removed: []int{}, expected: []pair.Pair[int, int]{}},
+		{input: putEntries(NewLinkedListMultimap[int, int](), pair.Of(1, 10), pair.Of(1, 10), pair.Of(2, 20)), key: 1,
+			removed: []int{10, 10}, expected: []pair.Pair[int, int]{pair.Of(2, 20)}},
+		{input: putEntries(NewTreeListMultimap(lessThan, lessThan), pair.Of(1, 10)), key: 2, removed: []int{},
+			expected: []pair.Pair[int, int]{pair.Of(1, 10)}},
+		{input: putEntries(NewHashSetMultimap[int, int](), pair.Of(1, 10), pair.Of(1, 11)), key: 1, removed: []int{10, 11},
+			expected: []pair.Pair[int, int]{}},
+		{input: putEntries(NewLinkedSetMultimap[int, int](), pair.Of(1, 11), pair.Of(1, 10)), key: 1, removed: []int{11, 10},
+			expected: []pair.Pair[int, int]{}},
+		{input: putEntries(NewTreeSetMultimap(lessThan, lessThan), pair.Of(1, 11), pair.Of(1, 10), pair.Of(2, 20)), key: 1,
+			removed: []int{10, 11}, expected: []pair.Pair[int, int]{pair.Of(2, 20)}},
+	}
+
+	for _, test := range removeAllTests {
+		assert.ElementsMatch(t, test.removed, test.input.RemoveAll(test.key).ToSlice())
+		assert.False(t, test.input.ContainsKey(test.key))
+		assert.ElementsMatch(t, test.expected, iterator.ToSlice(test.input.Iterator()))
+		assert.Equal(t, len(test.expected), test.input.Len())
+	}
+}
+
+func TestContains(t *testing.T) {
+
+	type containsTest struct {
+		input         Multimap[int, int]
+		key           int
+		value         int
+		containsKey   bool
+		containsValue bool
+		containsEntry bool
+	}
+
+	containsTests := []containsTest{
+		{input: NewHashListMultimap[int, int](), key: 1, value: 10},
+		{input: putEntries(NewLinkedListMultimap[int, int](), pair.Of(1, 10)), key: 1, value: 10, containsKey: true, containsValue: true,
+			containsEntry: true},
+		{input: putEntries(NewTreeListMultimap(lessThan, lessThan), pair.Of(1, 10), pair.Of(2, 20)), key: 1, value: 20, containsKey: true,
+			containsValue: true},
+		{input: putEntries(NewHashSetMultimap[int, int](), pair.Of(1, 10)), key: 2, value: 10, containsValue: true},
+		{input: putEntries(NewLinkedSetMultimap[int, int](), pair.Of(1, 10)), key: 1, value: 11, containsKey: true},
+		{input: putEntries(NewTreeSetMultimap(lessThan, lessThan), pair.Of(1, 10)), key: 2, value: 20},
+	}
+
+	for _, test := range containsTests {
+		assert.Equal(t, test.containsKey, test.input.ContainsKey(test.key))
+		assert.Equal(t, test.containsValue, test.input.ContainsValue(test.value))
+		assert.Equal(t, test.containsEntry, test.input.ContainsEntry(test.key, test.value))
+	}
+}
+
+func TestKeySet(t *testing.T) {
+
+	type keySetTest struct {
+		input    Multimap[int, int]
+		expected []int
+	}
+
+	keySetTests := []keySetTest{
+		{input: NewHashListMultimap[int, int](), expected: []int{}},
+		{input: putEntries(NewLinkedListMultimap[int, int](), pair.Of(2, 20), pair.Of(1, 10), pair.Of(2, 21)), expected: []int{2, 1}},
+		{input: putEntries(NewTreeListMultimap(lessThan, lessThan), pair.Of(2, 20), pair.Of(1, 10)), expected: []int{1, 2}},
+		{input: putEntries(NewHashSetMultimap[int, int](), pair.Of(2, 20), pair.Of(2, 21)), expected: []int{2}},
+		{input: putEntries(NewLinkedSetMultimap[int, int](), pair.Of(3, 30), pair.Of(1, 10), pair.Of(2, 20)), expected: []int{3, 1, 2}},
+		{input: putEntries(NewTreeSetMultimap(lessThan, lessThan), pair.Of(3, 30), pair.Of(1, 10), pair.Of(2, 20)), expected: []int{1, 2, 3}},
+	}
+
+	for _, test := range keySetTests {
+		assert.Equal(t, test.expected, test.input.KeySet().ToSlice())
+	}
+}
+
+func TestClear(t *testing.T) {
+
+	type clearTest struct {
+		input Multimap[int, int]
+	}
+
+	clearTests := []clearTest{
+		{input: NewHashListMultimap[int, int]()},
+		{input: putEntries(NewLinkedListMultimap[int, int](), pair.Of(1, 10), pair.Of(1, 11))},
+		{input: putEntries(NewTreeListMultimap(lessThan, lessThan), pair.Of(1, 10), pair.Of(2, 20))},
+		{input: putEntries(NewHashSetMultimap[int, int](), pair.Of(1, 10), pair.Of(2, 20))},
+		{input: putEntries(NewLinkedSetMultimap[int, int](), pair.Of(1, 10))},
+		{input: putEntries(NewTreeSetMultimap(lessThan, lessThan), pair.Of(1, 10), pair.Of(1, 11))},
+	}
+
+	for _, test := range clearTests {
+		test.input.Clear()
+		assert.True(t, test.input.Empty())
+		assert.Equal(t, 0, test.input.Len())
+		assert.False(t, test.input.ContainsKey(1))
+		assert.False(t, test.input.Iterator().HasNext())
+	}
+}
+
+func TestForEach(t *testing.T) {
+
+	type forEachTest struct {
+		input    Multimap[int, int]
+		expected []pair.Pair[int, int]
+	}
+
+	forEachTests := []forEachTest{
+		{input: NewHashListMultimap[int, int](), expected: []pair.Pair[int, int]{}},
+		{input: putEntries(NewLinkedListMultimap[int, int](), pair.Of(2, 20), pair.Of(1, 10), pair.Of(2, 20)),
+			expected: []pair.Pair[int, int]{pair.Of(2, 20), pair.Of(2, 20), pair.Of(1, 10)}},
+		{input: putEntries(NewTreeListMultimap(lessThan, lessThan), pair.Of(2, 20), pair.Of(1, 11), pair.Of(1, 10)),
+			expected: []pair.Pair[int, int]{pair.Of(1, 11), pair.Of(1, 10), pair.Of(2, 20)}},
+		{input: putEntries(NewHashSetMultimap[int, int](), pair.Of(1, 10), pair.Of(1, 10)), expected: []pair.Pair[int, int]{pair.Of(1, 10)}},
+		{input: putEntries(NewLinkedSetMultimap[int, int](), pair.Of(2, 21), pair.Of(2, 20), pair.Of(1, 10)),
+			expected: []pair.Pair[int, int]{pair.Of(2, 21), pair.Of(2, 20), pair.Of(1, 10)}},
+		{input: putEntries(NewTreeSetMultimap(lessThan, lessThan), pair.Of(2, 21), pair.Of(2, 20), pair.Of(1, 10)),
+			expected: []pair.Pair[int, int]{pair.Of(1, 10), pair.Of(2, 20), pair.Of(2, 21)}},
+	}
+
+	for _, test := range forEachTests {
+		seen := make([]pair.Pair[int, int], 0)
+		test.input.ForEach(func(k, v int) { seen = append(seen, pair.Of(k, v)) })
+		assert.Equal(t, test.expected, seen)
+		seen = make([]pair.Pair[int, int], 0)
+		for k, v := range test.input.Entries() {
+			seen = append(seen, pair.Of(k, v))
+		}
+		assert.Equal(t, test.expected, seen)
+		for range test.input.Entries() {
+			break
+		}
+	}
+}
+
+func TestIterator(t *testing.T) {
+
+	type iteratorTest struct {
+		input    Multimap[int, int]
+		expected []pair.Pair[int, int]
+	}
+
+	iteratorTests := []iteratorTest{
+		{input: NewHashListMultimap[int, int](), expected: []pair.Pair[int, int]{}},
+		{input: putEntries(NewLinkedListMultimap[int, int](), pair.Of(2, 20), pair.Of(1, 10), pair.Of(2, 21)),
+			expected: []pair.Pair[int, int]{pair.Of(2, 20), pair.Of(2, 21), pair.Of(1, 10)}},
+		{input: putEntries(NewTreeListMultimap(lessThan, lessThan), pair.Of(2, 20), pair.Of(1, 10), pair.Of(1, 11)),
+			expected: []pair.Pair[int, int]{pair.Of(1, 10), pair.Of(1, 11), pair.Of(2, 20)}},
+		{input: putEntries(NewLinkedSetMultimap[int, int](), pair.Of(2, 21), pair.Of(1, 10), pair.Of(2, 20)),
+			expected: []pair.Pair[int, int]{pair.Of(2, 21), pair.Of(2, 20), pair.Of(1, 10)}},
+		{input: putEntries(NewTreeSetMultimap(lessThan, lessThan), pair.Of(2, 21), pair.Of(1, 10), pair.Of(2, 20)),
+			expected: []pair.Pair[int, int]{pair.Of(1, 10), pair.Of(2, 20), pair.Of(2, 21)}},
+	}
+
+	for _, test := range iteratorTests {
+		it := test.input.Iterator()
+		assert.Equal(t, test.expected, iterator.ToSlice(it))
+		assert.PanicsWithError(t, errors.NoSuchElement().Error(), func() { it.Next() })
+	}
+}
+
+func TestString(t *testing.T) {
+
+	type stringTest struct {
+		input    Multimap[int, int]
+		expected string
+	}
+
+	stringTests := []stringTest{
+		{input: NewHashListMultimap[int, int](), expected: "{}"},
+		{input: putEntries(NewHashListMultimap[int, int](), pair.Of(1, 10), pair.Of(1, 10)), expected: "{1=[10 10]}"},
+		{input: putEntries(NewLinkedListMultimap[int, int](), pair.Of(2, 20), pair.Of(1, 10)), expected: "{2=[20], 1=[10]}"},
+		{input: putEntries(NewTreeListMultimap(lessThan, lessThan), pair.Of(2, 20), pair.Of(1, 10), pair.Of(1, 10)),
+			expected: "{1=[10 10], 2=[20]}"},
+		{input: putEntries(NewHashSetMultimap[int, int](), pair.Of(1, 10), pair.Of(1, 10)), expected: "{1={10}}"},
+		{input: putEntries(NewLinkedSetMultimap[int, int](), pair.Of(2, 20), pair.Of(1, 10)), expected: "{2={20}, 1={10}}"},
+		{input: putEntries(NewTreeSetMultimap(lessThan, lessThan), pair.Of(2, 21), pair.Of(2, 20)), expected: "{2={20, 21}}"},
+	}
+
+	for _, test := range stringTests {
+		assert.Equal(t, test.expected, test.input.(interface{ String() string }).String())
+	}
+
+	multimap := putEntries(NewTreeListMultimap(lessThan, lessThan), pair.Of(1, 10), pair.Of(1, 10))
+	assert.Equal(t, "[10 10]", multimap.Get(1).(*valuesView[int, int, *vector.Vector[int]]).String())
+	assert.Equal(t, "[]", multimap.Get(3).(*valuesView[int, int, *vector.Vector[int]]).String())
+}
@@ -0,0 +1,78 @@
+package multimap
+
+import (
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/maps/linkedhashmap"
+	"github.com/phantom820/collections/maps/treemap"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/phantom820/collections/sets/linkedhashset"
+	"github.com/phantom820/collections/sets/treeset"
+)
+
+// HashSetMultimap a [SetMultimap] that keeps its keys in a [hashmap.HashMap] and the values of each key in a [hashset.HashSet].
+type HashSetMultimap[K comparable, V comparable] struct {
+	multimap[K, V, *hashset.HashSet[V]]
+}
+
+// NewHashSetMultimap creates an empty multimap.
+func NewHashSetMultimap[K comparable, V comparable]() *HashSetMultimap[K, V] {
+	multimap := HashSetMultimap[K, V]{}
+	multimap.values = hashmap.New[K, *hashset.HashSet[V]]()
+	multimap.newCollection = func() *hashset.HashSet[V] { return hashset.New[V]() }
+	multimap.newKeySet = func() collections.Set[K] { return hashset.New[K]() }
+	return &multimap
+}
+
+// Inverse returns a new multimap that has an entry v, k for every entry k, v of the multimap.
+func (multimap *HashSetMultimap[K, V]) Inverse() SetMultimap[V, K] {
+	inverse := NewHashSetMultimap[V, K]()
+	multimap.invert(inverse)
+	return inverse
+}
+
+// LinkedSetMultimap a [SetMultimap] that keeps its keys in a [linkedhashmap.LinkedHashMap] and the values of each key in a
+// [linkedhashset.LinkedHashSet], so both are iterated on in the order in which they were first added.
+type LinkedSetMultimap[K comparable, V comparable] struct {
+	multimap[K, V, *linkedhashset.LinkedHashSet[V]]
+}
+
+// NewLinkedSetMultimap creates an empty multimap.
+func NewLinkedSetMultimap[K comparable, V comparable]() *LinkedSetMultimap[K, V] {
+	multimap := LinkedSetMultimap[K, V]{}
+	multimap.values = linkedhashmap.New[K, *linkedhashset.LinkedHashSet[V]]()
+	multimap.newCollection = func() *linkedhashset.LinkedHashSet[V] { return linkedhashset.New[V]() }
+	multimap.newKeySet = func() collections.Set[K] { return linkedhashset.New[K]() }
+	return &multimap
+}
+
+// Inverse returns a new multimap that has an entry v, k for every entry k, v of the multimap.
+func (multimap *LinkedSetMultimap[K, V]) Inverse() SetMultimap[V, K] {
+	inverse := NewLinkedSetMultimap[V, K]()
+	multimap.invert(inverse)
+	return inverse
+}
+
+// TreeSetMultimap a [SetMultimap] that keeps its keys in a [treemap.TreeMap] and the values of each key in a [treeset.TreeSet], so
+// both are iterated on in sorted order.
+type TreeSetMultimap[K comparable, V comparable] struct {
+	multimap[K, V, *treeset.TreeSet[V]]
+	keyLessThan   func(k1, k2 K) bool
+	valueLessThan func(v1, v2 V) bool
+}
+
+// NewTreeSetMultimap creates an empty multimap whose keys are compared using keyLessThan and values using valueLessThan.
+func NewTreeSetMultimap[K comparable, V comparable](keyLessThan func(k1, k2 K) bool, valueLessThan func(v1, v2 V) bool) *TreeSetMultimap[K, V] {
+	multimap := TreeSetMultimap[K, V]{keyLessThan: keyLessThan, valueLessThan: valueLessThan}
+	multimap.values = treemap.New[K, *treeset.TreeSet[V]](keyLessThan)
+	multimap.newCollection = func() *treeset.TreeSet[V] { return treeset.New(valueLessThan) }
+	multimap.newKeySet = func() collections.Set[K] { return treeset.New(keyLessThan) }
+	return &multimap
+}
+
+// Inverse returns a new multimap that has an entry v, k for every entry k, v of the multimap.
+func (multimap *TreeSetMultimap[K, V]) Inverse() SetMultimap[V, K] {
+	inverse := NewTreeSetMultimap(multimap.valueLessThan, multimap.keyLessThan)
+	multimap.invert(inverse)
+	return inverse
+}
@@ -0,0 +1,67 @@
+package multimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMultimap(t *testing.T) {
+
+	type setMultimapTest struct {
+		input SetMultimap[int, int]
+	}
+
+	setMultimapTests := []setMultimapTest{
+		{input: NewHashSetMultimap[int, int]()},
+		{input: NewLinkedSetMultimap[int, int]()},
+		{input: NewTreeSetMultimap(lessThan, lessThan)},
+	}
+
+	for _, test := range setMultimapTests {
+		// Duplicate entries are not added.
+		assert.True(t, test.input.Put(1, 10))
+		assert.False(t, test.input.Put(1, 10))
+		assert.False(t, test.input.Get(1).Add(10))
+		assert.False(t, test.input.PutAll(1, test.input.Get(2)))
+		assert.Equal(t, 1, test.input.Len())
+
+		test.input.Put(1, 11)
+		test.input.Put(2, 10)
+		inverse := test.input.Inverse()
+		assert.Equal(t, 3, inverse.Len())
+		assert.ElementsMatch(t, []int{1, 2}, inverse.Get(10).ToSlice())
+		assert.ElementsMatch(t, []int{1}, inverse.Get(11).ToSlice())
+
+		// The inverse of the inverse has the entries of the multimap.
+		twice := inverse.Inverse()
+		assert.Equal(t, test.input.Len(), twice.Len())
+		for k, v := range test.input.Entries() {
+			assert.True(t, twice.ContainsEntry(k, v))
+		}
+	}
+}
+
+func TestLinkedSetMultimap(t *testing.T) {
+
+	multimap := NewLinkedSetMultimap[string, int]()
+	multimap.Put("b", 3)
+	multimap.Put("a", 2)
+	multimap.Put("b", 1)
+	multimap.Put("b", 3)
+	assert.Equal(t, []string{"b", "a"}, multimap.KeySet().ToSlice())
+	assert.Equal(t, []int{3, 1}, multimap.Get("b").ToSlice())
+	assert.Equal(t, 3, multimap.Len())
+}
+
+func TestTreeSetMultimap(t *testing.T) {
+
+	multimap := NewTreeSetMultimap(func(a, b string) bool { return a < b }, lessThan)
+	multimap.Put("b", 3)
+	multimap.Put("a", 2)
+	multimap.Put("b", 1)
+	multimap.Put("b", 3)
+	assert.Equal(t, []string{"a", "b"}, multimap.KeySet().ToSlice())
+	assert.Equal(t, []int{1, 3}, multimap.Get("b").ToSlice())
+	assert.Equal(t, "{1={b}, 2={a}, 3={b}}", multimap.Inverse().(*TreeSetMultimap[int, string]).String())
+}
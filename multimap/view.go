@@ -0,0 +1,126 @@
+package multimap
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+)
+
+// valuesView a [collections.Collection] view of the values of a key in a multimap. The view looks up the values of the key on every
+// operation, so it stays valid while the key is removed and added back. Adding to the view adds entries for the key and removing the
+// last value from the view removes the key from the multimap.
+type valuesView[K comparable, V comparable, C collections.Collection[V]] struct {
+	multimap *multimap[K, V, C]
+	key      K
+}
+
+// Add adds an entry for the key with the given value and returns true if the multimap changed.
+func (view *valuesView[K, V, C]) Add(e V) bool {
+	return view.multimap.Put(view.key, e)
+}
+
+// AddAll adds an entry for the key with each of the values in the iterable and returns true if the multimap changed.
+func (view *valuesView[K, V, C]) AddAll(iterable iterable.Iterable[V]) bool {
+	return view.multimap.PutAll(view.key, iterable)
+}
+
+// AddSlice adds an entry for the key with each of the values in the slice and returns true if the multimap changed.
+func (view *valuesView[K, V, C]) AddSlice(s []V) bool {
+	return view.multimap.update(view.key, true, func(collection C) { collection.AddSlice(s) })
+}
+
+// Clear removes all the entries of the key.
+func (view *valuesView[K, V, C]) Clear() {
+	view.multimap.RemoveAll(view.key)
+}
+
+// Contains returns true if the key is mapped to the value.
+func (view *valuesView[K, V, C]) Contains(e V) bool {
+	return view.multimap.ContainsEntry(view.key, e)
+}
+
+// Empty returns true if the key has no values.
+func (view *valuesView[K, V, C]) Empty() bool {
+	return !view.multimap.ContainsKey(view.key)
+}
+
+// Remove removes a single entry of the key with the given value and returns true if the multimap changed.
+func (view *valuesView[K, V, C]) Remove(e V) bool {
+	return view.multimap.Remove(view.key, e)
+}
+
+// RemoveIf removes the entries of the key whose values satisfy the given predicate and returns true if the multimap changed.
+func (view *valuesView[K, V, C]) RemoveIf(f func(V) bool) bool {
+	return view.multimap.update(view.key, false, func(collection C) { collection.RemoveIf(f) })
+}
+
+// RemoveAll removes the entries of the key whose values are contained in the iterable and returns true if the multimap changed.
+func (view *valuesView[K, V, C]) RemoveAll(iterable iterable.Iterable[V]) bool {
+	return view.multimap.update(view.key, false, func(collection C) { collection.RemoveAll(iterable) })
+}
+
+// RemoveSlice removes the entries of the key whose values are contained in the slice and returns true if the multimap changed.
+func (view *valuesView[K, V, C]) RemoveSlice(s []V) bool {
+	return view.multimap.update(view.key, false, func(collection C) { collection.RemoveSlice(s) })
+}
+
+// RetainAll removes the entries of the key whose values are not contained in the collection and returns true if the multimap changed.
+func (view *valuesView[K, V, C]) RetainAll(c collections.Collection[V]) bool {
+	return view.multimap.update(view.key, false, func(collection C) { collection.RetainAll(c) })
+}
+
+// ForEach performs the given action for each value of the key.
+func (view *valuesView[K, V, C]) ForEach(f func(V)) {
+	if collection, ok := view.multimap.collection(view.key); ok {
+		collection.ForEach(f)
+	}
+}
+
+// Len returns the number of values of the key.
+func (view *valuesView[K, V, C]) Len() int {
+	if collection, ok := view.multimap.collection(view.key); ok {
+		return collection.Len()
+	}
+	return 0
+}
+
+// ToSlice returns a slice containing the values of the key.
+func (view *valuesView[K, V, C]) ToSlice() []V {
+	if collection, ok := view.multimap.collection(view.key); ok {
+		return slices.Clone(collection.ToSlice())
+	}
+	return []V{}
+}
+
+// Iterator returns an iterator over the values of the key.
+func (view *valuesView[K, V, C]) Iterator() iterator.Iterator[V] {
+	if collection, ok := view.multimap.collection(view.key); ok {
+		return collection.Iterator()
+	}
+	return iterator.Of[V]()
+}
+
+// All returns a sequence over the values of the key.
+func (view *valuesView[K, V, C]) All() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if collection, ok := view.multimap.collection(view.key); ok {
+			for e := range collection.All() {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// String returns the string representation of the values of the key.
+func (view *valuesView[K, V, C]) String() string {
+	if collection, ok := view.multimap.collection(view.key); ok {
+		return fmt.Sprint(collection)
+	}
+	return fmt.Sprint(view.multimap.newCollection())
+}
@@ -0,0 +1,41 @@
+package multiset
+
+import (
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/maps/linkedhashmap"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/phantom820/collections/sets/linkedhashset"
+)
+
+// HashMultiset a [Multiset] that keeps the counts of its elements in a [hashmap.HashMap], elements are iterated on in no particular
+// order.
+type HashMultiset[T comparable] struct {
+	multiset[T]
+}
+
+// NewHashMultiset creates a multiset with the given elements.
+func NewHashMultiset[T comparable](elements ...T) *HashMultiset[T] {
+	multiset := HashMultiset[T]{}
+	multiset.counts = hashmap.New[T, int]()
+	multiset.newElementSet = func() collections.Set[T] { return hashset.New[T]() }
+	multiset.name = "HashMultiset"
+	multiset.AddSlice(elements)
+	return &multiset
+}
+
+// LinkedMultiset a [Multiset] that keeps the counts of its elements in a [linkedhashmap.LinkedHashMap], elements are iterated on in
+// the order in which they were first added.
+type LinkedMultiset[T comparable] struct {
+	multiset[T]
+}
+
+// NewLinkedMultiset creates a multiset with the given elements.
+func NewLinkedMultiset[T comparable](elements ...T) *LinkedMultiset[T] {
+	multiset := LinkedMultiset[T]{}
+	multiset.counts = linkedhashmap.New[T, int]()
+	multiset.newElementSet = func() collections.Set[T] { return linkedhashset.New[T]() }
+	multiset.name = "LinkedMultiset"
+	multiset.AddSlice(elements)
+	return &multiset
+}
@@ -0,0 +1,296 @@
+// package multiset defines collections that allow duplicate elements and keep a count of the occurrences of each element, also known
+// as bags. The counts are held in the map types of this module.
+//
+//  1. HashMultiset[T] : Counts are kept in a [hashmap.HashMap] with no particular ordering.
+//  2. LinkedMultiset[T] : Counts are kept in a [linkedhashmap.LinkedHashMap] and elements are iterated on in the order in which they were first added.
+//  3. TreeMultiset[T] : Counts are kept in a [treemap.TreeMap] and elements are iterated on in sorted order, the most and least common
+//     elements are found through a red black tree ordered by count.
+//
+// The package also defines Union, Intersection, Sum and Difference which produce a lazy MultisetView of two multisets.
+package multiset
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/sets/linkedhashset"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// Multiset a [collections.Collection] that allows duplicate elements and keeps a count of the occurrences of each element. Len is the
+// total number of occurrences and iteration visits each element as many times as it occurs, with the occurrences of an element grouped
+// together.
+type Multiset[T comparable] interface {
+	collections.Collection[T]
+	Count(e T) int                                // Returns the number of occurrences of the element in the multiset.
+	AddN(e T, n int) int                          // Adds n occurrences of the element and returns its previous count.
+	RemoveN(e T, n int) int                       // Removes up to n occurrences of the element and returns its previous count.
+	SetCount(e T, n int) int                      // Sets the number of occurrences of the element and returns its previous count.
+	ElementSet() collections.Set[T]               // Returns a set containing the distinct elements of the multiset.
+	EntrySet() collections.Set[pair.Pair[T, int]] // Returns a set containing each distinct element of the multiset paired with its count.
+	Equals(multiset Multiset[T]) bool             // Returns true if both multisets contain the same elements with the same counts.
+}
+
+// multiset the implementation of [Multiset] shared by the hash, linked and tree multisets.
+type multiset[T comparable] struct {
+	counts        collections.Map[T, int]
+	newElementSet func() collections.Set[T]          // Creates the set returned by ElementSet.
+	onChange      func(e T, previous int, count int) // Optionally notified of every change of the count of an element.
+	name          string                             // The name of the multiset type used in errors.
+	len           int
+	modCount      int // The number of modifications, used to detect modifications during iteration.
+}
+
+// setCount sets the count of the element and returns its previous count.
+func (multiset *multiset[T]) setCount(e T, count int) int {
+	previous := multiset.Count(e)
+	if count == previous {
+		return previous
+	} else if count == 0 {
+		multiset.counts.Remove(e)
+	} else {
+		multiset.counts.Put(e, count)
+	}
+	multiset.len += count - previous
+	multiset.modCount++
+	if multiset.onChange != nil {
+		multiset.onChange(e, previous, count)
+	}
+	return previous
+}
+
+// Count returns the number of occurrences of the element in the multiset.
+func (multiset *multiset[T]) Count(e T) int {
+	if count := multiset.counts.Get(e); !count.Empty() {
+		return count.Value()
+	}
+	return 0
+}
+
+// AddN adds n occurrences of the element and returns its previous count. Panics if n is negative.
+func (multiset *multiset[T]) AddN(e T, n int) int {
+	if n < 0 {
+		panic(errors.IllegalArgument("n", n))
+	}
+	count := multiset.Count(e)
+	return multiset.setCount(e, count+n)
+}
+
+// RemoveN removes up to n occurrences of the element and returns its previous count. Panics if n is negative.
+func (multiset *multiset[T]) RemoveN(e T, n int) int {
+	if n < 0 {
+		panic(errors.IllegalArgument("n", n))
+	}
+	count := multiset.Count(e)
+	return multiset.setCount(e, max(count-n, 0))
+}
+
+// SetCount sets the number of occurrences of the element and returns its previous count, a count of 0 removes the element. Panics if
+// n is negative.
+func (multiset *multiset[T]) SetCount(e T, n int) int {
+	if n < 0 {
+		panic(errors.IllegalArgument("n", n))
+	}
+	return multiset.setCount(e, n)
+}
+
+// Add adds an occurrence of the element and returns true.
+func (multiset *multiset[T]) Add(e T) bool {
+	multiset.AddN(e, 1)
+	return true
+}
+
+// AddAll adds an occurrence of each of the elements in the iterable and returns true if the multiset changed.
+func (multiset *multiset[T]) AddAll(iterable iterable.Iterable[T]) bool {
+	return multiset.AddSlice(iterator.ToSlice(iterable.Iterator()))
+}
+
+// AddSlice adds an occurrence of each of the elements in the slice and returns true if the multiset changed.
+func (multiset *multiset[T]) AddSlice(s []T) bool {
+	for _, e := range s {
+		multiset.AddN(e, 1)
+	}
+	return len(s) > 0
+}
+
+// Clear removes all of the elements from the multiset.
+func (multiset *multiset[T]) Clear() {
+	for _, e := range multiset.counts.Keys() {
+		multiset.setCount(e, 0)
+	}
+}
+
+// Contains returns true if the multiset contains at least one occurrence of the element.
+func (multiset *multiset[T]) Contains(e T) bool {
+	return multiset.counts.ContainsKey(e)
+}
+
+// Empty returns true if the multiset contains no elements.
+func (multiset *multiset[T]) Empty() bool {
+	return multiset.len == 0
+}
+
+// Len returns the total number of occurrences of all elements in the multiset.
+func (multiset *multiset[T]) Len() int {
+	return multiset.len
+}
+
+// Remove removes a single occurrence of the element and returns true if the multiset changed.
+func (multiset *multiset[T]) Remove(e T) bool {
+	return multiset.RemoveN(e, 1) > 0
+}
+
+// RemoveIf removes all occurrences of the elements that satisfy the given predicate and returns true if the multiset changed.
+func (multiset *multiset[T]) RemoveIf(f func(T) bool) bool {
+	removed := false
+	for _, e := range multiset.counts.Keys() {
+		if f(e) {
+			multiset.setCount(e, 0)
+			removed = true
+		}
+	}
+	return removed
+}
+
+// RemoveAll removes all occurrences of the elements contained in the iterable and returns true if the multiset changed.
+func (multiset *multiset[T]) RemoveAll(iterable iterable.Iterable[T]) bool {
+	return multiset.RemoveSlice(iterator.ToSlice(iterable.Iterator()))
+}
+
+// RemoveSlice removes all occurrences of the elements contained in the slice and returns true if the multiset changed.
+func (multiset *multiset[T]) RemoveSlice(s []T) bool {
+	removed := false
+	for _, e := range s {
+		if multiset.setCount(e, 0) > 0 {
+			removed = true
+		}
+	}
+	return removed
+}
+
+// RetainAll removes all occurrences of the elements that are not contained in the collection and returns true if the multiset changed.
+func (multiset *multiset[T]) RetainAll(c collections.Collection[T]) bool {
+	return multiset.RemoveIf(func(e T) bool { return !c.Contains(e) })
+}
+
+// ForEach performs the given action for each occurrence of each element in the multiset.
+func (multiset *multiset[T]) ForEach(f func(T)) {
+	for e, count := range multiset.counts.All() {
+		for i := 0; i < count; i++ {
+			f(e)
+		}
+	}
+}
+
+// ToSlice returns a slice containing every occurrence of each element in the multiset.
+func (multiset *multiset[T]) ToSlice() []T {
+	slice := make([]T, 0, multiset.len)
+	multiset.ForEach(func(e T) { slice = append(slice, e) })
+	return slice
+}
+
+// Iterator returns an iterator over the occurrences of the elements in the multiset. The iterator is fail fast, Next panics if the
+// multiset is modified after the iterator is created.
+func (multiset *multiset[T]) Iterator() iterator.Iterator[T] {
+	return &multisetIterator[T]{multiset: multiset, entries: multiset.counts.Iterator(), modCount: multiset.modCount}
+}
+
+// All returns a sequence over the occurrences of the elements in the multiset.
+func (multiset *multiset[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e, count := range multiset.counts.All() {
+			for i := 0; i < count; i++ {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// elements returns a sequence over the distinct elements of the multiset.
+func (multiset *multiset[T]) elements() iter.Seq[T] {
+	return multiset.counts.AllKeys()
+}
+
+// ElementSet returns a set containing the distinct elements of the multiset.
+func (multiset *multiset[T]) ElementSet() collections.Set[T] {
+	set := multiset.newElementSet()
+	for e := range multiset.elements() {
+		set.Add(e)
+	}
+	return set
+}
+
+// EntrySet returns a set containing each distinct element of the multiset paired with its count, in the iteration order of the
+// multiset.
+func (multiset *multiset[T]) EntrySet() collections.Set[pair.Pair[T, int]] {
+	set := linkedhashset.New[pair.Pair[T, int]]()
+	for e, count := range multiset.counts.All() {
+		set.Add(pair.Of(e, count))
+	}
+	return set
+}
+
+// Equals returns true if both multisets contain the same elements with the same counts.
+func (multiset *multiset[T]) Equals(other Multiset[T]) bool {
+	if multiset.len != other.Len() {
+		return false
+	}
+	for e, count := range multiset.counts.All() {
+		if other.Count(e) != count {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the string representation of the multiset, each distinct element is followed by its count.
+func (multiset *multiset[T]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for e, count := range multiset.counts.All() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", e, count))
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// multisetIterator iterator implementation for the multisets.
+type multisetIterator[T comparable] struct {
+	multiset  *multiset[T]
+	entries   iterator.Iterator[pair.Pair[T, int]]
+	element   T
+	remaining int // The number of occurrences of the current element that are yet to be returned.
+	modCount  int // The expected modification count of the multiset.
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *multisetIterator[T]) HasNext() bool {
+	return it.remaining > 0 || it.entries.HasNext()
+}
+
+// Next returns the next element in the iterator.
+func (it *multisetIterator[T]) Next() T {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	} else if it.modCount != it.multiset.modCount {
+		panic(errors.ConcurrentModification(it.multiset.name))
+	}
+	if it.remaining == 0 {
+		entry := it.entries.Next()
+		it.element, it.remaining = entry.Key(), entry.Value()
+	}
+	it.remaining--
+	return it.element
+}
@@ -0,0 +1,349 @@
+package multiset
+
+import (
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func lessThan(a, b string) bool {
+	return a < b
+}
+
+func TestNew(t *testing.T) {
+
+	type newTest struct {
+		input    Multiset[string]
+		expected map[string]int
+	}
+
+	newTests := []newTest{
+		{input: NewHashMultiset[string](), expected: map[string]int{}},
+		{input: NewHashMultiset("a", "b", "a"), expected: map[string]int{"a": 2, "b": 1}},
+		{input: NewLinkedMultiset[string](), expected: map[string]int{}},
+		{input: NewLinkedMultiset("a", "b", "a"), expected: map[string]int{"a": 2, "b": 1}},
+		{input: NewTreeMultiset[string](lessThan), expected: map[string]int{}},
+		{input: NewTreeMultiset(lessThan, "a", "b", "a"), expected: map[string]int{"a": 2, "b": 1}},
+	}
+
+	for _, test := range newTests {
+		total := 0
+		for e, count := range test.expected {
+			assert.Equal(t, count, test.input.Count(e))
+			total += count
+		}
+		assert.Equal(t, total, test.input.Len())
+		assert.Equal(t, total == 0, test.input.Empty())
+		assert.Equal(t, 0, test.input.Count("c"))
+	}
+}
+
+func TestAddN(t *testing.T) {
+
+	type addNTest struct {
+		input    Multiset[string]
+		n        []int
+		previous []int
+		expected int
+	}
+
+	addNTests := []addNTest{
+		{input: NewHashMultiset[string](), n: []int{3, 2, 0}, previous: []int{0, 3, 5}, expected: 5},
+		{input: NewLinkedMultiset("a"), n: []int{1, 0}, previous: []int{1, 2}, expected: 2},
+		{input: NewTreeMultiset(lessThan, "b"), n: []int{0}, previous: []int{0}, expected: 0},
+	}
+
+	for _, test := range addNTests {
+		for i, n := range test.n {
+			assert.Equal(t, test.previous[i], test.input.AddN("a", n))
+		}
+		assert.Equal(t, test.expected, test.input.Count("a"))
+		assert.Equal(t, test.expected > 0, test.input.Contains("a"))
+		assert.PanicsWithError(t, errors.IllegalArgument("n", -1).Error(), func() { test.input.AddN("a", -1) })
+	}
+}
+
+func TestRemoveN(t *testing.T) {
+
+	type removeNTest struct {
+		input    Multiset[string]
+		n        []int
+		previous []int
+		expected int
+	}
+
+	removeNTests := []removeNTest{
+		{input: NewHashMultiset("a", "a", "a", "a", "a"), n: []int{2, 10, 1}, previous: []int{5, 3, 0}, expected: 0},
+		{input: NewLinkedMultiset("a", "a", "b"), n: []int{1}, previous: []int{2}, expected: 1},
+		{input: NewTreeMultiset(lessThan, "b"), n: []int{1}, previous: []int{0}, expected: 0},
+	}
+
+	for _, test := range removeNTests {
+		for i, n := range test.n {
+			assert.Equal(t, test.previous[i], test.input.RemoveN("a", n))
+		}
+		assert.Equal(t, test.expected, test.input.Count("a"))
+		assert.Equal(t, test.expected > 0, test.input.Contains("a"))
+		assert.PanicsWithError(t, errors.IllegalArgument("n", -1).Error(), func() { test.input.RemoveN("a", -1) })
+	}
+}
+
+func TestSetCount(t *testing.T) {
+
+	type setCountTest struct {
+		input    Multiset[string]
+		count    int
+		previous int
+		len      int
+	}
+
+	setCountTests := []setCountTest{
+		{input: NewHashMultiset[string](), count: 4, previous: 0, len: 4},
+		{input: NewLinkedMultiset("a", "a", "a", "a", "b"), count: 1, previous: 4, len: 2},
+		{input: NewTreeMultiset(lessThan, "a"), count: 0, previous: 1, len: 0},
+	}
+
+	for _, test := range setCountTests {
+		assert.Equal(t, test.previous, test.input.SetCount("a", test.count))
+		assert.Equal(t, test.count, test.input.Count("a"))
+		assert.Equal(t, test.count > 0, test.input.Contains("a"))
+		assert.Equal(t, test.len, test.input.Len())
+		assert.PanicsWithError(t, errors.IllegalArgument("n", -1).Error(), func() { test.input.SetCount("a", -1) })
+	}
+}
+
+func TestAdd(t *testing.T) {
+
+	type addTest struct {
+		input    Multiset[string]
+		action   func(Multiset[string]) bool
+		changed  bool
+		expected []string
+	}
+
+	addTests := []addTest{
+		{input: NewHashMultiset("a"), action: func(m Multiset[string]) bool { return m.Add("a") }, changed: true,
+			expected: []string{"a", "a"}},
+		{input: NewLinkedMultiset("a"), action: func(m Multiset[string]) bool { return m.AddAll(vector.Of("b", "c", "c")) }, changed: true,
+			expected: []string{"a", "b", "c", "c"}},
+		{input: NewTreeMultiset(lessThan, "b"), action: func(m Multiset[string]) bool { return m.AddSlice([]string{"a", "b"}) }, changed: true,
+			expected: []string{"a", "b", "b"}},
+		{input: NewTreeMultiset(lessThan, "b"), action: func(m Multiset[string]) bool { return m.AddSlice([]string{}) }, changed: false,
+			expected: []string{"b"}},
+	}
+
+	for _, test := range addTests {
+		assert.Equal(t, test.changed, test.action(test.input))
+		assert.Equal(t, test.expected, test.input.ToSlice())
+		assert.Equal(t, len(test.expected), test.input.Len())
+	}
+}
+
+func TestRemove(t *testing.T) {
+
+	type removeTest struct {
+		input    Multiset[string]
+		action   func(Multiset[string]) bool
+		changed  bool
+		expected []string
+	}
+
+	// Remove removes a single occurrence, the bulk removals remove every occurrence.
+	removeTests := []removeTest{
+		{input: NewHashMultiset("a", "a"), action: func(m Multiset[string]) bool { return m.Remove("a") }, changed: true,
+			expected: []string{"a"}},
+		{input: NewHashMultiset("a"), action: func(m Multiset[string]) bool { return m.Remove("e") }, changed: false, expected: []string{"a"}},
+		{input: NewLinkedMultiset("c", "a", "c"), action: func(m Multiset[string]) bool { return m.RemoveAll(vector.Of("c")) }, changed: true,
+			expected: []string{"a"}},
+		{input: NewLinkedMultiset("a"), action: func(m Multiset[string]) bool { return m.RemoveSlice([]string{"c"}) }, changed: false,
+			expected: []string{"a"}},
+		{input: NewTreeMultiset(lessThan, "d", "a", "d"), action: func(m Multiset[string]) bool {
+			return m.RemoveIf(func(e string) bool { return e == "d" })
+		}, changed: true, expected: []string{"a"}},
+		{input: NewTreeMultiset(lessThan, "a"), action: func(m Multiset[string]) bool {
+			return m.RemoveIf(func(e string) bool { return e == "d" })
+		}, changed: false, expected: []string{"a"}},
+	}
+
+	for _, test := range removeTests {
+		assert.Equal(t, test.changed, test.action(test.input))
+		assert.Equal(t, test.expected, test.input.ToSlice())
+		assert.Equal(t, len(test.expected), test.input.Len())
+	}
+}
+
+func TestRetainAll(t *testing.T) {
+
+	type retainAllTest struct {
+		input    Multiset[string]
+		retain   []string
+		changed  bool
+		expected []string
+	}
+
+	retainAllTests := []retainAllTest{
+		{input: NewHashMultiset("a", "b", "a"), retain: []string{"a"}, changed: true, expected: []string{"a", "a"}},
+		{input: NewLinkedMultiset("a", "a", "a"), retain: []string{"a"}, changed: false, expected: []string{"a", "a", "a"}},
+		{input: NewTreeMultiset(lessThan, "c", "b"), retain: []string{}, changed: true, expected: []string{}},
+	}
+
+	for _, test := range retainAllTests {
+		assert.Equal(t, test.changed, test.input.RetainAll(hashset.New(test.retain...)))
+		assert.Equal(t, test.expected, test.input.ToSlice())
+	}
+}
+
+func TestClear(t *testing.T) {
+
+	type clearTest struct {
+		input Multiset[string]
+	}
+
+	clearTests := []clearTest{
+		{input: NewHashMultiset("a", "b", "a")},
+		{input: NewLinkedMultiset[string]()},
+		{input: NewTreeMultiset(lessThan, "a")},
+	}
+
+	for _, test := range clearTests {
+		test.input.Clear()
+		assert.True(t, test.input.Empty())
+		assert.False(t, test.input.Contains("a"))
+		assert.Equal(t, []string{}, test.input.ToSlice())
+	}
+}
+
+func TestIterator(t *testing.T) {
+
+	type iteratorTest struct {
+		input    Multiset[string]
+		name     string
+		expected []string
+	}
+
+	iteratorTests := []iteratorTest{
+		{input: NewHashMultiset("a", "a", "a"), name: "HashMultiset", expected: []string{"a", "a", "a"}},
+		{input: NewLinkedMultiset("b", "a", "c", "a", "b"), name: "LinkedMultiset", expected: []string{"b", "b", "a", "a", "c"}},
+		{input: NewTreeMultiset(lessThan, "b", "a", "c", "a", "b"), name: "TreeMultiset", expected: []string{"a", "a", "b", "b", "c"}},
+	}
+
+	for _, test := range iteratorTests {
+		assert.Equal(t, test.expected, test.input.ToSlice())
+		assert.Equal(t, test.expected, iterator.ToSlice(test.input.Iterator()))
+
+		slice := make([]string, 0)
+		test.input.ForEach(func(e string) { slice = append(slice, e) })
+		assert.Equal(t, test.expected, slice)
+
+		slice = make([]string, 0)
+		for e := range test.input.All() {
+			slice = append(slice, e)
+		}
+		assert.Equal(t, test.expected, slice)
+		for range test.input.All() {
+			break
+		}
+
+		it := test.input.Iterator()
+		for it.HasNext() {
+			it.Next()
+		}
+		assert.PanicsWithError(t, errors.NoSuchElement().Error(), func() { it.Next() })
+
+		it = test.input.Iterator()
+		it.Next()
+		test.input.SetCount("a", 5)
+		assert.PanicsWithError(t, errors.ConcurrentModification(test.name).Error(), func() { it.Next() })
+	}
+}
+
+func TestElementSet(t *testing.T) {
+
+	type elementSetTest struct {
+		input    Multiset[string]
+		expected []string
+	}
+
+	elementSetTests := []elementSetTest{
+		{input: NewHashMultiset[string](), expected: []string{}},
+		{input: NewLinkedMultiset("b", "a", "b"), expected: []string{"b", "a"}},
+		{input: NewTreeMultiset(lessThan, "b", "a", "b"), expected: []string{"a", "b"}},
+	}
+
+	for _, test := range elementSetTests {
+		assert.ElementsMatch(t, test.expected, test.input.ElementSet().ToSlice())
+
+		// The set is a copy.
+		test.input.ElementSet().Add("c")
+		assert.False(t, test.input.Contains("c"))
+	}
+}
+
+func TestEntrySet(t *testing.T) {
+
+	type entrySetTest struct {
+		input    Multiset[string]
+		expected []pair.Pair[string, int]
+	}
+
+	entrySetTests := []entrySetTest{
+		{input: NewHashMultiset("a", "b", "a"), expected: []pair.Pair[string, int]{pair.Of("a", 2), pair.Of("b", 1)}},
+		{input: NewLinkedMultiset[string](), expected: []pair.Pair[string, int]{}},
+		{input: NewTreeMultiset(lessThan, "b", "a", "b"), expected: []pair.Pair[string, int]{pair.Of("a", 1), pair.Of("b", 2)}},
+	}
+
+	for _, test := range entrySetTests {
+		assert.ElementsMatch(t, test.expected, test.input.EntrySet().ToSlice())
+
+		// The set is a copy.
+		test.input.EntrySet().Add(pair.Of("c", 1))
+		assert.False(t, test.input.Contains("c"))
+	}
+}
+
+func TestEquals(t *testing.T) {
+
+	type equalsTest struct {
+		a        Multiset[string]
+		b        Multiset[string]
+		expected bool
+	}
+
+	equalsTests := []equalsTest{
+		{a: NewHashMultiset("a", "b", "a"), b: NewLinkedMultiset("b", "a", "a"), expected: true},
+		{a: NewTreeMultiset(lessThan, "a", "a", "b"), b: NewHashMultiset("a", "b", "a"), expected: true},
+		{a: NewHashMultiset("a", "b", "a"), b: NewHashMultiset("a", "b", "b"), expected: false},
+		{a: NewLinkedMultiset("a", "b", "a"), b: NewTreeMultiset(lessThan, "a", "b"), expected: false},
+		{a: NewHashMultiset[string](), b: NewTreeMultiset[string](lessThan), expected: true},
+	}
+
+	for _, test := range equalsTests {
+		assert.True(t, test.a.Equals(test.a))
+		assert.Equal(t, test.expected, test.a.Equals(test.b))
+		assert.Equal(t, test.expected, test.b.Equals(test.a))
+	}
+}
+
+func TestString(t *testing.T) {
+
+	type stringTest struct {
+		input    Multiset[string]
+		expected string
+	}
+
+	stringTests := []stringTest{
+		{input: NewHashMultiset[string](), expected: "{}"},
+		{input: NewHashMultiset("a", "a"), expected: "{a=2}"},
+		{input: NewLinkedMultiset("b", "a", "a"), expected: "{b=1, a=2}"},
+		{input: NewTreeMultiset(lessThan, "b", "a", "a"), expected: "{a=2, b=1}"},
+	}
+
+	for _, test := range stringTests {
+		assert.Equal(t, test.expected, test.input.(interface{ String() string }).String())
+	}
+}
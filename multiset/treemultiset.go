@@ -0,0 +1,62 @@
+package multiset
+
+import (
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/maps/treemap"
+	"github.com/phantom820/collections/sets/treeset"
+	"github.com/phantom820/collections/trees/rbt"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// TreeMultiset a [Multiset] that keeps the counts of its elements in a [treemap.TreeMap], elements are iterated on in sorted order.
+// The elements are also kept in a red black tree ordered by count, so the most and least common elements are found in O(log n + k)
+// time.
+type TreeMultiset[T comparable] struct {
+	multiset[T]
+	byCount *rbt.RedBlackTree[pair.Pair[T, int], struct{}] // The elements paired with their counts, ordered by count and then by element.
+}
+
+// NewTreeMultiset creates a multiset with the given elements. Elements are compared using the lessThan function which should satisfy.
+// e1 < e2 => lessThan(e1, e2) = true and lessThan(e2,e1) = false.
+// e1 = e2 => lessThan(e1,e2) = false and lessThan(e2,e1) = false.
+// e1 > e2 -> lessThan(e1,e2) = false and lessThan(e2,e1) = true.
+func NewTreeMultiset[T comparable](lessThan func(e1, e2 T) bool, elements ...T) *TreeMultiset[T] {
+	multiset := TreeMultiset[T]{}
+	multiset.counts = treemap.New[T, int](lessThan)
+	multiset.newElementSet = func() collections.Set[T] { return treeset.New(lessThan) }
+	multiset.name = "TreeMultiset"
+	multiset.byCount = rbt.New[pair.Pair[T, int], struct{}](func(a, b pair.Pair[T, int]) bool {
+		return a.Value() < b.Value() || (a.Value() == b.Value() && lessThan(a.Key(), b.Key()))
+	})
+	multiset.onChange = func(e T, previous int, count int) {
+		if previous > 0 {
+			multiset.byCount.Delete(pair.Of(e, previous))
+		}
+		if count > 0 {
+			multiset.byCount.Insert(pair.Of(e, count), struct{}{})
+		}
+	}
+	multiset.AddSlice(elements)
+	return &multiset
+}
+
+// MostCommon returns up to n distinct elements paired with their counts, from the most common element to the least common. Elements
+// with the same count are returned in descending order.
+func (multiset *TreeMultiset[T]) MostCommon(n int) []pair.Pair[T, int] {
+	return take(multiset.byCount.DescendingIterator(), n)
+}
+
+// LeastCommon returns up to n distinct elements paired with their counts, from the least common element to the most common. Elements
+// with the same count are returned in ascending order.
+func (multiset *TreeMultiset[T]) LeastCommon(n int) []pair.Pair[T, int] {
+	return take(multiset.byCount.Iterator(), n)
+}
+
+// take returns up to n entries from the iterator.
+func take[T comparable](it *rbt.Iterator[pair.Pair[T, int], struct{}], n int) []pair.Pair[T, int] {
+	entries := make([]pair.Pair[T, int], 0)
+	for len(entries) < n && it.HasNext() {
+		entries = append(entries, it.Next().Key())
+	}
+	return entries
+}
@@ -0,0 +1,37 @@
+package multiset
+
+import (
+	"testing"
+
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeMultiset(t *testing.T) {
+
+	multiset := NewTreeMultiset(lessThan, "c", "a", "b", "a")
+	assert.Equal(t, []string{"a", "a", "b", "c"}, multiset.ToSlice())
+	assert.Equal(t, []string{"a", "b", "c"}, multiset.ElementSet().ToSlice())
+	assert.Equal(t, []pair.Pair[string, int]{pair.Of("a", 2), pair.Of("b", 1), pair.Of("c", 1)}, multiset.EntrySet().ToSlice())
+}
+
+func TestMostAndLeastCommon(t *testing.T) {
+
+	multiset := NewTreeMultiset(lessThan, "a", "b", "b", "c", "c", "c", "d")
+	assert.Equal(t, []pair.Pair[string, int]{pair.Of("c", 3), pair.Of("b", 2)}, multiset.MostCommon(2))
+	assert.Equal(t, []pair.Pair[string, int]{pair.Of("a", 1), pair.Of("d", 1)}, multiset.LeastCommon(2))
+	assert.Equal(t, 4, len(multiset.MostCommon(10)))
+	assert.Equal(t, []pair.Pair[string, int]{}, multiset.MostCommon(0))
+
+	// The order by count follows every change of a count.
+	multiset.AddN("a", 5)
+	multiset.Remove("c")
+	multiset.SetCount("b", 0)
+	multiset.RemoveIf(func(e string) bool { return e == "d" })
+	assert.Equal(t, []pair.Pair[string, int]{pair.Of("a", 6), pair.Of("c", 2)}, multiset.MostCommon(10))
+	assert.Equal(t, []pair.Pair[string, int]{pair.Of("c", 2), pair.Of("a", 6)}, multiset.LeastCommon(10))
+
+	multiset.Clear()
+	assert.Equal(t, []pair.Pair[string, int]{}, multiset.MostCommon(1))
+	assert.Equal(t, 0, multiset.byCount.Len())
+}
@@ -0,0 +1,217 @@
+package multiset
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+)
+
+// Codes indicating different view types for a MultisetView.
+const (
+	UNION        = 0
+	INTERSECTION = 1
+	SUM          = 2
+	DIFFERENCE   = 3
+)
+
+// MultisetView an unmodifiable view of a multiset which is backed by two other multisets, this view will change as the backing
+// multisets change. The count of an element in the view is computed from its counts in the backing multisets on every operation.
+type MultisetView[T comparable] struct {
+	multisetA Multiset[T]
+	multisetB Multiset[T]
+	view      int
+}
+
+// Type return the type of multiset view (union, intersection , ...)
+func (multisetView *MultisetView[T]) Type() int {
+	return multisetView.view
+}
+
+// Count returns the number of occurrences of the element in the multiset view.
+func (multisetView *MultisetView[T]) Count(e T) int {
+	a, b := multisetView.multisetA.Count(e), multisetView.multisetB.Count(e)
+	switch multisetView.view {
+	case UNION:
+		return max(a, b)
+	case INTERSECTION:
+		return min(a, b)
+	case SUM:
+		return a + b
+	case DIFFERENCE:
+		return max(a-b, 0)
+	default:
+		view := strings.ToTitle(strings.ToLower(fmt.Sprint(multisetView.view)))
+		panic(errors.UnsupportedOperation(view, "MultisetView"))
+	}
+}
+
+// Contains returns true if the multiset view contains at least one occurrence of the element.
+func (multisetView *MultisetView[T]) Contains(e T) bool {
+	return multisetView.Count(e) > 0
+}
+
+// distinct returns a sequence over the distinct elements of a multiset without copying them. The multisets of this package go through
+// their counts, any other multiset has the repeated occurrences of an element skipped since they are grouped together.
+func distinct[T comparable](multiset Multiset[T]) iter.Seq[T] {
+	if m, ok := multiset.(interface{ elements() iter.Seq[T] }); ok {
+		return m.elements()
+	}
+	return func(yield func(T) bool) {
+		first := true
+		var previous T
+		for e := range multiset.All() {
+			if first || e != previous {
+				if !yield(e) {
+					return
+				}
+				first, previous = false, e
+			}
+		}
+	}
+}
+
+// entries returns a sequence over the distinct elements of the view and their counts. Only the elements of the first multiset can be
+// in an intersection or a difference, a union or a sum also has the elements of the second multiset that are not in the first.
+func (multisetView *MultisetView[T]) entries() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for e := range distinct(multisetView.multisetA) {
+			if count := multisetView.Count(e); count > 0 && !yield(e, count) {
+				return
+			}
+		}
+		if multisetView.view != UNION && multisetView.view != SUM {
+			return
+		}
+		for e := range distinct(multisetView.multisetB) {
+			if !multisetView.multisetA.Contains(e) && !yield(e, multisetView.Count(e)) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the total number of occurrences of all elements in the multiset view. Apart from a sum this needs to be calculated based
+// on the backing multisets of the view.
+func (multisetView *MultisetView[T]) Len() int {
+	if multisetView.view == SUM {
+		return multisetView.multisetA.Len() + multisetView.multisetB.Len()
+	}
+	len := 0
+	for _, count := range multisetView.entries() {
+		len += count
+	}
+	return len
+}
+
+// Empty returns true if the multiset view contains no elements. An intersection or a difference of non empty multisets is checked
+// until an element is found in the view.
+func (multisetView *MultisetView[T]) Empty() bool {
+	if multisetView.view == UNION || multisetView.view == SUM {
+		return multisetView.multisetA.Empty() && multisetView.multisetB.Empty()
+	} else if multisetView.multisetA.Empty() {
+		return true
+	}
+	for range multisetView.entries() {
+		return false
+	}
+	return true
+}
+
+// ForEach performs the given action for each occurrence of each element in the multiset view.
+func (multisetView *MultisetView[T]) ForEach(f func(T)) {
+	for e := range multisetView.All() {
+		f(e)
+	}
+}
+
+// All returns a sequence over the occurrences of the elements in the multiset view.
+func (multisetView *MultisetView[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e, count := range multisetView.entries() {
+			for i := 0; i < count; i++ {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Iterator returns an iterator over a snapshot of the occurrences of the elements in the multiset view.
+func (multisetView *MultisetView[T]) Iterator() iterator.Iterator[T] {
+	return iterator.Of(multisetView.ToSlice()...)
+}
+
+// ToSlice returns a slice containing every occurrence of each element in the multiset view.
+func (multisetView *MultisetView[T]) ToSlice() []T {
+	slice := make([]T, 0)
+	multisetView.ForEach(func(e T) { slice = append(slice, e) })
+	return slice
+}
+
+// ToHashMultiset returns a [HashMultiset] with all the elements from the multiset view.
+func (multisetView *MultisetView[T]) ToHashMultiset() *HashMultiset[T] {
+	multiset := NewHashMultiset[T]()
+	for e, count := range multisetView.entries() {
+		multiset.AddN(e, count)
+	}
+	return multiset
+}
+
+// ToLinkedMultiset returns a [LinkedMultiset] with all the elements from the multiset view.
+func (multisetView *MultisetView[T]) ToLinkedMultiset() *LinkedMultiset[T] {
+	multiset := NewLinkedMultiset[T]()
+	for e, count := range multisetView.entries() {
+		multiset.AddN(e, count)
+	}
+	return multiset
+}
+
+// ToTreeMultiset returns a [TreeMultiset] with all the elements from the multiset view.
+func (multisetView *MultisetView[T]) ToTreeMultiset(lessThan func(e1, e2 T) bool) *TreeMultiset[T] {
+	multiset := NewTreeMultiset(lessThan)
+	for e, count := range multisetView.entries() {
+		multiset.AddN(e, count)
+	}
+	return multiset
+}
+
+// String returns the string representation of the multiset view, each distinct element is followed by its count.
+func (multisetView *MultisetView[T]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for e, count := range multisetView.entries() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", e, count))
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// Union returns an unmodifiable view of the union of two multisets, the count of an element is the larger of its counts.
+func Union[T comparable](multisetA Multiset[T], multisetB Multiset[T]) MultisetView[T] {
+	return MultisetView[T]{multisetA: multisetA, multisetB: multisetB, view: UNION}
+}
+
+// Intersection returns an unmodifiable view of the intersection of two multisets, the count of an element is the smaller of its counts.
+func Intersection[T comparable](multisetA Multiset[T], multisetB Multiset[T]) MultisetView[T] {
+	return MultisetView[T]{multisetA: multisetA, multisetB: multisetB, view: INTERSECTION}
+}
+
+// Sum returns an unmodifiable view of the sum of two multisets, the count of an element is the sum of its counts.
+func Sum[T comparable](multisetA Multiset[T], multisetB Multiset[T]) MultisetView[T] {
+	return MultisetView[T]{multisetA: multisetA, multisetB: multisetB, view: SUM}
+}
+
+// Difference returns an unmodifiable view of the difference of two multisets, the count of an element is its count in the first
+// multiset less its count in the second, or 0 if that is negative.
+func Difference[T comparable](multisetA Multiset[T], multisetB Multiset[T]) MultisetView[T] {
+	return MultisetView[T]{multisetA: multisetA, multisetB: multisetB, view: DIFFERENCE}
+}
@@ -0,0 +1,156 @@
+package multiset
+
+import (
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultisetView(t *testing.T) {
+
+	type multisetViewTest struct {
+		view     MultisetView[string]
+		expected map[string]int
+	}
+
+	a := NewHashMultiset("a", "a", "a", "b", "c")
+	b := NewHashMultiset("a", "b", "b", "d")
+
+	multisetViewTests := []multisetViewTest{
+		{view: Union[string](a, b), expected: map[string]int{"a": 3, "b": 2, "c": 1, "d": 1}},
+		{view: Intersection[string](a, b), expected: map[string]int{"a": 1, "b": 1}},
+		{view: Sum[string](a, b), expected: map[string]int{"a": 4, "b": 3, "c": 1, "d": 1}},
+		{view: Difference[string](a, b), expected: map[string]int{"a": 2, "c": 1}},
+		{view: Difference[string](b, a), expected: map[string]int{"b": 1, "d": 1}},
+	}
+
+	for _, test := range multisetViewTests {
+		total := 0
+		for e, count := range test.expected {
+			assert.Equal(t, count, test.view.Count(e))
+			assert.True(t, test.view.Contains(e))
+			total += count
+		}
+		assert.False(t, test.view.Contains("e"))
+		assert.Equal(t, total, test.view.Len())
+		assert.False(t, test.view.Empty())
+
+		counts := make(map[string]int)
+		test.view.ForEach(func(e string) { counts[e]++ })
+		assert.Equal(t, test.expected, counts)
+		assert.Equal(t, total, len(test.view.ToSlice()))
+		assert.Equal(t, total, len(iterator.ToSlice(test.view.Iterator())))
+		for range test.view.All() {
+			break
+		}
+
+		assert.True(t, test.view.ToHashMultiset().Equals(test.view.ToLinkedMultiset()))
+		assert.True(t, test.view.ToTreeMultiset(lessThan).Equals(test.view.ToHashMultiset()))
+		assert.Equal(t, total, test.view.ToHashMultiset().Len())
+	}
+}
+
+func TestMultisetViewIsLazy(t *testing.T) {
+
+	a := NewLinkedMultiset("a")
+	b := NewLinkedMultiset[string]()
+	view := Sum[string](a, b)
+	assert.Equal(t, SUM, view.Type())
+	assert.Equal(t, "{a=1}", view.String())
+
+	// Changes to the backing multisets are reflected in the view.
+	b.AddN("a", 2)
+	b.Add("b")
+	assert.Equal(t, "{a=3, b=1}", view.String())
+	a.Clear()
+	b.Clear()
+	assert.True(t, view.Empty())
+	assert.Equal(t, "{}", view.String())
+}
+
+func TestMultisetViewEmpty(t *testing.T) {
+
+	type multisetViewEmptyTest struct {
+		view     MultisetView[string]
+		expected bool
+	}
+
+	a := NewHashMultiset("a", "a", "b")
+	b := NewTreeMultiset(lessThan, "c")
+	empty := NewLinkedMultiset[string]()
+
+	multisetViewEmptyTests := []multisetViewEmptyTest{
+		{view: Union[string](empty, empty), expected: true},
+		{view: Union[string](empty, b), expected: false},
+		{view: Sum[string](a, empty), expected: false},
+		{view: Intersection[string](a, b), expected: true},
+		{view: Intersection[string](a, a), expected: false},
+		{view: Difference[string](a, a), expected: true},
+		{view: Difference[string](empty, b), expected: true},
+		{view: Difference[string](b, a), expected: false},
+	}
+
+	for _, test := range multisetViewEmptyTests {
+		assert.Equal(t, test.expected, test.view.Empty())
+		assert.Equal(t, test.expected, test.view.Len() == 0)
+	}
+}
+
+func TestMultisetViewCost(t *testing.T) {
+
+	// views returns the views of multisets with n distinct elements each.
+	views := func(n int) []MultisetView[int] {
+		a, b := NewHashMultiset[int](), NewTreeMultiset(func(i, j int) bool { return i < j })
+		for i := 0; i < n; i++ {
+			a.AddN(i, 2)
+			b.Add(i + n/2)
+		}
+		return []MultisetView[int]{Union[int](a, b), Sum[int](a, b)}
+	}
+
+	// Checking whether a union or a sum is empty and the length of a sum come from the backing multisets, so they cost as much for
+	// 1000 elements as for 2.
+	small, large := views(2), views(1000)
+	for i := range small {
+		assert.Equal(t, testing.AllocsPerRun(10, func() { small[i].Empty() }), testing.AllocsPerRun(10, func() { large[i].Empty() }))
+	}
+	assert.Equal(t, testing.AllocsPerRun(10, func() { small[1].Len() }), testing.AllocsPerRun(10, func() { large[1].Len() }))
+	assert.Equal(t, 2500, large[0].Len())
+	assert.Equal(t, 3000, large[1].Len())
+}
+
+func TestMultisetViewOfOtherMultisets(t *testing.T) {
+
+	// other hides the distinct elements of a multiset of this package, so the view finds them by skipping repeats in All.
+	type other struct {
+		Multiset[string]
+	}
+
+	type otherMultisetTest struct {
+		view     MultisetView[string]
+		expected string
+		len      int
+	}
+
+	a := other{NewLinkedMultiset("a", "a", "b", "c", "c", "c")}
+	b := other{NewTreeMultiset(lessThan, "b", "b", "d")}
+	otherMultisetTests := []otherMultisetTest{
+		{view: Union[string](a, b), expected: "{a=2, b=2, c=3, d=1}", len: 8},
+		{view: Intersection[string](a, b), expected: "{b=1}", len: 1},
+		{view: Sum[string](a, b), expected: "{a=2, b=3, c=3, d=1}", len: 9},
+		{view: Difference[string](a, b), expected: "{a=2, c=3}", len: 5},
+	}
+
+	for _, test := range otherMultisetTests {
+		assert.Equal(t, test.expected, test.view.String())
+		assert.Equal(t, test.len, test.view.Len())
+	}
+}
+
+func TestUnsupportedMultisetView(t *testing.T) {
+
+	view := MultisetView[string]{multisetA: NewHashMultiset[string](), multisetB: NewHashMultiset[string](), view: 4}
+	assert.PanicsWithError(t, errors.UnsupportedOperation("4", "MultisetView").Error(), func() { view.Count("a") })
+}
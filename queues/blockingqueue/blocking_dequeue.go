@@ -0,0 +1,435 @@
+package blockingqueue
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// blockingDequeue the implementation shared by the blocking dequeues, it guards a dequeue with a mutex and bounds its length.
+type blockingDequeue[T comparable] struct {
+	mutex    sync.Mutex
+	dequeue  collections.Dequeue[T]
+	capacity int
+	closed   bool
+	changed  chan struct{} // Closed and replaced whenever the dequeue changes, releasing the goroutines waiting on it.
+	name     string        // The name of the dequeue type used in errors.
+}
+
+// init initializes the blocking dequeue with the backing dequeue, panics if the capacity is not positive.
+func (dequeue *blockingDequeue[T]) init(backing collections.Dequeue[T], capacity int, name string) {
+	if capacity < 1 {
+		panic(errors.IllegalArgument("capacity", capacity))
+	}
+	dequeue.dequeue = backing
+	dequeue.capacity = capacity
+	dequeue.changed = make(chan struct{})
+	dequeue.name = name
+}
+
+// signal releases the goroutines waiting for the dequeue to change. The lock must be held.
+func (dequeue *blockingDequeue[T]) signal() {
+	close(dequeue.changed)
+	dequeue.changed = make(chan struct{})
+}
+
+// full returns true if no elements can be added to the dequeue. The lock must be held.
+func (dequeue *blockingDequeue[T]) full() bool {
+	return dequeue.dequeue.Len() >= dequeue.capacity
+}
+
+// notFull returns true if an element can be added to the dequeue. The lock must be held.
+func (dequeue *blockingDequeue[T]) notFull() bool {
+	return !dequeue.full()
+}
+
+// notEmpty returns true if an element can be removed from the dequeue. The lock must be held.
+func (dequeue *blockingDequeue[T]) notEmpty() bool {
+	return !dequeue.dequeue.Empty()
+}
+
+// await waits until ready returns true. Waiting ends with an error if the dequeue is closed while not ready, the context is done or the
+// timeout channel fires. The lock must be held, it is released while waiting.
+func (dequeue *blockingDequeue[T]) await(ctx context.Context, timeout <-chan time.Time, ready func() bool) error {
+	for !ready() {
+		if dequeue.closed {
+			return errors.Closed(dequeue.name)
+		}
+		changed := dequeue.changed
+		dequeue.mutex.Unlock()
+		select {
+		case <-changed:
+			dequeue.mutex.Lock()
+		case <-ctx.Done():
+			dequeue.mutex.Lock()
+			return ctx.Err()
+		case <-timeout:
+			dequeue.mutex.Lock()
+			return context.DeadlineExceeded
+		}
+	}
+	return nil
+}
+
+// put adds an element to the front or back of the dequeue, waiting for space until the context is done or the timeout fires.
+func (dequeue *blockingDequeue[T]) put(ctx context.Context, timeout <-chan time.Time, e T, first bool) error {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	if dequeue.closed {
+		return errors.Closed(dequeue.name)
+	} else if err := dequeue.await(ctx, timeout, dequeue.notFull); err != nil {
+		return err
+	}
+	if first {
+		dequeue.dequeue.AddFirst(e)
+	} else {
+		dequeue.dequeue.AddLast(e)
+	}
+	dequeue.signal()
+	return nil
+}
+
+// take removes an element from the front or back of the dequeue, waiting for one until the context is done or the timeout fires.
+func (dequeue *blockingDequeue[T]) take(ctx context.Context, timeout <-chan time.Time, last bool) (T, error) {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	if err := dequeue.await(ctx, timeout, dequeue.notEmpty); err != nil {
+		var zero T
+		return zero, err
+	}
+	var e optional.Optional[T]
+	if last {
+		e = dequeue.dequeue.RemoveLast()
+	} else {
+		e = dequeue.dequeue.RemoveFirst()
+	}
+	dequeue.signal()
+	return e.Value(), nil
+}
+
+// Put adds an element to the back of the dequeue, waiting for space if the dequeue is full. Put returns an error if the dequeue is
+// closed before the element is added.
+func (dequeue *blockingDequeue[T]) Put(e T) error {
+	return dequeue.put(context.Background(), nil, e, false)
+}
+
+// PutContext adds an element to the back of the dequeue, waiting for space if the dequeue is full. PutContext returns the error of the
+// context if it is done before the element is added, or an error if the dequeue is closed.
+func (dequeue *blockingDequeue[T]) PutContext(ctx context.Context, e T) error {
+	return dequeue.put(ctx, nil, e, false)
+}
+
+// Offer adds an element to the back of the dequeue, waiting up to the timeout for space if the dequeue is full. Offer returns true if the
+// element was added, a timeout that is not positive does not wait.
+func (dequeue *blockingDequeue[T]) Offer(e T, timeout time.Duration) bool {
+	return dequeue.put(context.Background(), time.After(timeout), e, false) == nil
+}
+
+// PutFirst adds an element to the front of the dequeue, waiting for space if the dequeue is full. PutFirst returns an error if the
+// dequeue is closed before the element is added.
+func (dequeue *blockingDequeue[T]) PutFirst(e T) error {
+	return dequeue.put(context.Background(), nil, e, true)
+}
+
+// PutFirstContext adds an element to the front of the dequeue, waiting for space if the dequeue is full. PutFirstContext returns the
+// error of the context if it is done before the element is added, or an error if the dequeue is closed.
+func (dequeue *blockingDequeue[T]) PutFirstContext(ctx context.Context, e T) error {
+	return dequeue.put(ctx, nil, e, true)
+}
+
+// OfferFirst adds an element to the front of the dequeue, waiting up to the timeout for space if the dequeue is full. OfferFirst returns
+// true if the element was added, a timeout that is not positive does not wait.
+func (dequeue *blockingDequeue[T]) OfferFirst(e T, timeout time.Duration) bool {
+	return dequeue.put(context.Background(), time.After(timeout), e, true) == nil
+}
+
+// Take removes the front element of the dequeue, waiting for an element if the dequeue is empty. Take returns an error if the dequeue is
+// closed and empty.
+func (dequeue *blockingDequeue[T]) Take() (T, error) {
+	return dequeue.take(context.Background(), nil, false)
+}
+
+// TakeContext removes the front element of the dequeue, waiting for an element if the dequeue is empty. TakeContext returns the error of
+// the context if it is done before an element is removed, or an error if the dequeue is closed and empty.
+func (dequeue *blockingDequeue[T]) TakeContext(ctx context.Context) (T, error) {
+	return dequeue.take(ctx, nil, false)
+}
+
+// Poll removes the front element of the dequeue, waiting up to the timeout for an element if the dequeue is empty. Poll returns an empty
+// option if no element was removed, a timeout that is not positive does not wait.
+func (dequeue *blockingDequeue[T]) Poll(timeout time.Duration) optional.Optional[T] {
+	if e, err := dequeue.take(context.Background(), time.After(timeout), false); err == nil {
+		return optional.Of(e)
+	}
+	return optional.Empty[T]()
+}
+
+// TakeLast removes the back element of the dequeue, waiting for an element if the dequeue is empty. TakeLast returns an error if the
+// dequeue is closed and empty.
+func (dequeue *blockingDequeue[T]) TakeLast() (T, error) {
+	return dequeue.take(context.Background(), nil, true)
+}
+
+// TakeLastContext removes the back element of the dequeue, waiting for an element if the dequeue is empty. TakeLastContext returns the
+// error of the context if it is done before an element is removed, or an error if the dequeue is closed and empty.
+func (dequeue *blockingDequeue[T]) TakeLastContext(ctx context.Context) (T, error) {
+	return dequeue.take(ctx, nil, true)
+}
+
+// PollLast removes the back element of the dequeue, waiting up to the timeout for an element if the dequeue is empty. PollLast returns an
+// empty option if no element was removed, a timeout that is not positive does not wait.
+func (dequeue *blockingDequeue[T]) PollLast(timeout time.Duration) optional.Optional[T] {
+	if e, err := dequeue.take(context.Background(), time.After(timeout), true); err == nil {
+		return optional.Of(e)
+	}
+	return optional.Empty[T]()
+}
+
+// Capacity returns the maximum number of elements the dequeue holds.
+func (dequeue *blockingDequeue[T]) Capacity() int {
+	return dequeue.capacity
+}
+
+// RemainingCapacity returns the number of elements that can be added to the dequeue without waiting.
+func (dequeue *blockingDequeue[T]) RemainingCapacity() int {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	return dequeue.capacity - dequeue.dequeue.Len()
+}
+
+// Close closes the dequeue. Elements can no longer be added, goroutines waiting to add elements are released with an error and so are
+// goroutines waiting to remove elements once the dequeue is empty. Closing a closed dequeue has no effect.
+func (dequeue *blockingDequeue[T]) Close() {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	if !dequeue.closed {
+		dequeue.closed = true
+		dequeue.signal()
+	}
+}
+
+// Closed returns true if the dequeue has been closed.
+func (dequeue *blockingDequeue[T]) Closed() bool {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	return dequeue.closed
+}
+
+// add adds an element to the front or back of the dequeue without waiting, panics if the dequeue is full or closed.
+func (dequeue *blockingDequeue[T]) add(operation string, e T, first bool) optional.Optional[T] {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	if dequeue.closed || dequeue.full() {
+		panic(errors.IllegalState(operation, dequeue.name))
+	}
+	defer dequeue.signal()
+	if first {
+		return dequeue.dequeue.AddFirst(e)
+	}
+	return dequeue.dequeue.AddLast(e)
+}
+
+// Add adds an element to the back of the dequeue without waiting. Add panics if the dequeue is full or closed.
+func (dequeue *blockingDequeue[T]) Add(e T) bool {
+	dequeue.add("Add", e, false)
+	return true
+}
+
+// AddLast adds an element to the back of the dequeue without waiting and returns the previous back element. AddLast panics if the
+// dequeue is full or closed.
+func (dequeue *blockingDequeue[T]) AddLast(e T) optional.Optional[T] {
+	return dequeue.add("AddLast", e, false)
+}
+
+// AddFirst adds an element to the front of the dequeue without waiting and returns the previous front element. AddFirst panics if the
+// dequeue is full or closed.
+func (dequeue *blockingDequeue[T]) AddFirst(e T) optional.Optional[T] {
+	return dequeue.add("AddFirst", e, true)
+}
+
+// AddAll adds all of the elements in the specified iterable to the back of the dequeue without waiting. Either all or none of the
+// elements are added, AddAll panics if they do not fit or the dequeue is closed.
+func (dequeue *blockingDequeue[T]) AddAll(iterable iterable.Iterable[T]) bool {
+	return dequeue.addSlice("AddAll", iterator.ToSlice(iterable.Iterator()))
+}
+
+// AddSlice adds all of the elements in the slice to the back of the dequeue without waiting. Either all or none of the elements are
+// added, AddSlice panics if they do not fit or the dequeue is closed.
+func (dequeue *blockingDequeue[T]) AddSlice(s []T) bool {
+	return dequeue.addSlice("AddSlice", s)
+}
+
+// addSlice adds all of the elements in the slice to the back of the dequeue, panics if they do not fit or the dequeue is closed.
+func (dequeue *blockingDequeue[T]) addSlice(operation string, s []T) bool {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	if dequeue.closed || dequeue.dequeue.Len()+len(s) > dequeue.capacity {
+		panic(errors.IllegalState(operation, dequeue.name))
+	} else if len(s) == 0 {
+		return false
+	}
+	dequeue.dequeue.AddSlice(s)
+	dequeue.signal()
+	return true
+}
+
+// PeekFirst retrieves, but does not remove, the front element of the dequeue.
+func (dequeue *blockingDequeue[T]) PeekFirst() optional.Optional[T] {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	return dequeue.dequeue.PeekFirst()
+}
+
+// PeekLast retrieves, but does not remove, the back element of the dequeue.
+func (dequeue *blockingDequeue[T]) PeekLast() optional.Optional[T] {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	return dequeue.dequeue.PeekLast()
+}
+
+// RemoveFirst retrieves and removes the front element of the dequeue without waiting.
+func (dequeue *blockingDequeue[T]) RemoveFirst() optional.Optional[T] {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	e := dequeue.dequeue.RemoveFirst()
+	if !e.Empty() {
+		dequeue.signal()
+	}
+	return e
+}
+
+// RemoveLast retrieves and removes the back element of the dequeue without waiting.
+func (dequeue *blockingDequeue[T]) RemoveLast() optional.Optional[T] {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	e := dequeue.dequeue.RemoveLast()
+	if !e.Empty() {
+		dequeue.signal()
+	}
+	return e
+}
+
+// Contains returns true if the dequeue contains the specified element.
+func (dequeue *blockingDequeue[T]) Contains(e T) bool {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	return dequeue.dequeue.Contains(e)
+}
+
+// Len returns the number of elements in the dequeue.
+func (dequeue *blockingDequeue[T]) Len() int {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	return dequeue.dequeue.Len()
+}
+
+// Empty returns true if the dequeue contains no elements.
+func (dequeue *blockingDequeue[T]) Empty() bool {
+	return dequeue.Len() == 0
+}
+
+// Clear removes all of the elements from the dequeue.
+func (dequeue *blockingDequeue[T]) Clear() {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	dequeue.dequeue.Clear()
+	dequeue.signal()
+}
+
+// RemoveIf removes all of the elements of the dequeue that satisfy the given predicate. The predicate is called with the lock held and
+// must not use the dequeue.
+func (dequeue *blockingDequeue[T]) RemoveIf(f func(T) bool) bool {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	elements := dequeue.dequeue.ToSlice()
+	retained := slices.DeleteFunc(slices.Clone(elements), f)
+	if len(retained) == len(elements) {
+		return false
+	}
+	dequeue.dequeue.Clear()
+	dequeue.dequeue.AddSlice(retained)
+	dequeue.signal()
+	return true
+}
+
+// Remove removes the first occurrence of the given element from the dequeue and returns true if the dequeue changed as a result.
+func (dequeue *blockingDequeue[T]) Remove(e T) bool {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	elements := dequeue.dequeue.ToSlice()
+	i := slices.Index(elements, e)
+	if i == -1 {
+		return false
+	}
+	dequeue.dequeue.Clear()
+	dequeue.dequeue.AddSlice(slices.Delete(elements, i, i+1))
+	dequeue.signal()
+	return true
+}
+
+// RemoveAll removes all of the dequeue's elements that are also contained in the specified iterable.
+func (dequeue *blockingDequeue[T]) RemoveAll(iterable iterable.Iterable[T]) bool {
+	return dequeue.RemoveSlice(iterator.ToSlice(iterable.Iterator()))
+}
+
+// RemoveSlice removes all of the dequeue's elements that are also contained in the specified slice.
+func (dequeue *blockingDequeue[T]) RemoveSlice(s []T) bool {
+	elements := make(map[T]struct{}, len(s))
+	for _, e := range s {
+		elements[e] = struct{}{}
+	}
+	return dequeue.RemoveIf(func(e T) bool {
+		_, ok := elements[e]
+		return ok
+	})
+}
+
+// RetainAll retains only the elements in the dequeue that are contained in the specified collection.
+func (dequeue *blockingDequeue[T]) RetainAll(c collections.Collection[T]) bool {
+	return dequeue.RemoveIf(func(e T) bool { return !c.Contains(e) })
+}
+
+// ToSlice returns a slice containing the elements of the dequeue from front to back.
+func (dequeue *blockingDequeue[T]) ToSlice() []T {
+	dequeue.mutex.Lock()
+	defer dequeue.mutex.Unlock()
+	return dequeue.dequeue.ToSlice()
+}
+
+// ForEach performs the given action for each element of a snapshot of the dequeue.
+func (dequeue *blockingDequeue[T]) ForEach(f func(T)) {
+	for _, e := range dequeue.ToSlice() {
+		f(e)
+	}
+}
+
+// Iterator returns an iterator over a snapshot of the elements in the dequeue from front to back. The iterator does not reflect changes
+// made to the dequeue after it was created.
+func (dequeue *blockingDequeue[T]) Iterator() iterator.Iterator[T] {
+	return iterator.Of(dequeue.ToSlice()...)
+}
+
+// All returns a sequence over a snapshot of the elements in the dequeue from front to back, taken when ranging starts.
+func (dequeue *blockingDequeue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, e := range dequeue.ToSlice() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the string representation of the dequeue.
+func (dequeue *blockingDequeue[T]) String() string {
+	return fmt.Sprint(dequeue.ToSlice())
+}
@@ -0,0 +1,73 @@
+// package blockingqueue defines bounded dequeues that are safe for concurrent use. Producers adding to a full dequeue wait for space and
+// consumers removing from an empty dequeue wait for elements, which makes them suited to producer/consumer pipelines. Unlike channels the
+// dequeues can be peeked at, searched and have arbitrary elements removed.
+//
+//  1. ArrayBlockingDequeue[T] : A blocking dequeue backed by a [vectordequeue.VectorDequeue].
+//  2. LinkedBlockingDequeue[T] : A blocking dequeue backed by a [listdequeue.ListDequeue].
+package blockingqueue
+
+import (
+	"context"
+	"time"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/queues/listdequeue"
+	"github.com/phantom820/collections/queues/vectordequeue"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// BlockingQueue a bounded [collections.Queue] that is safe for concurrent use and supports waiting for space when adding elements and
+// for elements when removing them. Once closed no elements can be added, the remaining elements can still be removed.
+//
+// The non blocking Add methods of [collections.Queue] panic if the queue is full or closed, Offer adds an element without panicking.
+type BlockingQueue[T comparable] interface {
+	collections.Queue[T]
+	Put(e T) error                                   // Adds an element to the back of the queue, waiting for space. Returns an error if the queue is closed.
+	PutContext(ctx context.Context, e T) error       // Adds an element to the back of the queue, waiting for space until the context is done.
+	Offer(e T, timeout time.Duration) bool           // Adds an element to the back of the queue, waiting for space up to the timeout. Returns true if the element was added.
+	Take() (T, error)                                // Removes the front element of the queue, waiting for one. Returns an error if the queue is closed and empty.
+	TakeContext(ctx context.Context) (T, error)      // Removes the front element of the queue, waiting for one until the context is done.
+	Poll(timeout time.Duration) optional.Optional[T] // Removes the front element of the queue as an option, waiting for one up to the timeout.
+	Capacity() int                                   // Returns the maximum number of elements the queue holds.
+	RemainingCapacity() int                          // Returns the number of elements that can be added to the queue without waiting.
+	Close()                                          // Closes the queue, waiting producers and consumers of an empty queue are released.
+	Closed() bool                                    // Returns true if the queue has been closed.
+}
+
+// BlockingDequeue a double ended [BlockingQueue].
+type BlockingDequeue[T comparable] interface {
+	BlockingQueue[T]
+	collections.Dequeue[T]
+	PutFirst(e T) error                                  // Adds an element to the front of the dequeue, waiting for space. Returns an error if the dequeue is closed.
+	PutFirstContext(ctx context.Context, e T) error      // Adds an element to the front of the dequeue, waiting for space until the context is done.
+	OfferFirst(e T, timeout time.Duration) bool          // Adds an element to the front of the dequeue, waiting for space up to the timeout. Returns true if the element was added.
+	TakeLast() (T, error)                                // Removes the back element of the dequeue, waiting for one. Returns an error if the dequeue is closed and empty.
+	TakeLastContext(ctx context.Context) (T, error)      // Removes the back element of the dequeue, waiting for one until the context is done.
+	PollLast(timeout time.Duration) optional.Optional[T] // Removes the back element of the dequeue as an option, waiting for one up to the timeout.
+}
+
+// ArrayBlockingDequeue a [BlockingDequeue] backed by a [vectordequeue.VectorDequeue].
+type ArrayBlockingDequeue[T comparable] struct {
+	blockingDequeue[T]
+}
+
+// NewArray creates an empty array backed blocking dequeue that holds at most capacity elements. NewArray panics if the capacity is not
+// positive.
+func NewArray[T comparable](capacity int) *ArrayBlockingDequeue[T] {
+	dequeue := ArrayBlockingDequeue[T]{}
+	dequeue.init(vectordequeue.New[T](), capacity, "ArrayBlockingDequeue")
+	return &dequeue
+}
+
+// LinkedBlockingDequeue a [BlockingDequeue] backed by a [listdequeue.ListDequeue].
+type LinkedBlockingDequeue[T comparable] struct {
+	blockingDequeue[T]
+}
+
+// NewLinked creates an empty linked blocking dequeue that holds at most capacity elements. NewLinked panics if the capacity is not
+// positive.
+func NewLinked[T comparable](capacity int) *LinkedBlockingDequeue[T] {
+	dequeue := LinkedBlockingDequeue[T]{}
+	dequeue.init(listdequeue.New[T](), capacity, "LinkedBlockingDequeue")
+	return &dequeue
+}
@@ -0,0 +1,233 @@
+package blockingqueue
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+type dequeueTest struct {
+	name string
+	new  func(capacity int) BlockingDequeue[int]
+}
+
+var dequeueTests = []dequeueTest{
+	{name: "ArrayBlockingDequeue", new: func(capacity int) BlockingDequeue[int] { return NewArray[int](capacity) }},
+	{name: "LinkedBlockingDequeue", new: func(capacity int) BlockingDequeue[int] { return NewLinked[int](capacity) }},
+}
+
+func TestNew(t *testing.T) {
+
+	assert.PanicsWithError(t, errors.IllegalArgument("capacity", 0).Error(), func() { NewArray[int](0) })
+	assert.PanicsWithError(t, errors.IllegalArgument("capacity", -1).Error(), func() { NewLinked[int](-1) })
+
+	for _, test := range dequeueTests {
+		dequeue := test.new(3)
+		assert.Equal(t, 3, dequeue.Capacity())
+		assert.Equal(t, 3, dequeue.RemainingCapacity())
+		assert.True(t, dequeue.Empty())
+		assert.False(t, dequeue.Closed())
+	}
+}
+
+func TestNonBlocking(t *testing.T) {
+
+	for _, test := range dequeueTests {
+		dequeue := test.new(4)
+		assert.True(t, dequeue.Add(2))
+		assert.Equal(t, optional.Of(2), dequeue.AddLast(3))
+		assert.Equal(t, optional.Of(2), dequeue.AddFirst(1))
+		assert.True(t, dequeue.Offer(4, 0))
+		assert.False(t, dequeue.Offer(5, 0))
+		assert.False(t, dequeue.OfferFirst(0, time.Millisecond))
+		assert.Equal(t, 0, dequeue.RemainingCapacity())
+		assert.Equal(t, "[1 2 3 4]", fmt.Sprint(dequeue))
+
+		assert.PanicsWithError(t, errors.IllegalState("Add", test.name).Error(), func() { dequeue.Add(5) })
+		assert.PanicsWithError(t, errors.IllegalState("AddFirst", test.name).Error(), func() { dequeue.AddFirst(5) })
+		assert.PanicsWithError(t, errors.IllegalState("AddLast", test.name).Error(), func() { dequeue.AddLast(5) })
+
+		assert.Equal(t, optional.Of(1), dequeue.PeekFirst())
+		assert.Equal(t, optional.Of(4), dequeue.PeekLast())
+		assert.Equal(t, optional.Of(1), dequeue.RemoveFirst())
+		assert.Equal(t, optional.Of(4), dequeue.RemoveLast())
+		assert.Equal(t, optional.Of(2), dequeue.Poll(0))
+		assert.Equal(t, optional.Of(3), dequeue.PollLast(0))
+		assert.True(t, dequeue.Poll(time.Millisecond).Empty())
+		assert.True(t, dequeue.RemoveFirst().Empty())
+		assert.True(t, dequeue.RemoveLast().Empty())
+
+		assert.PanicsWithError(t, errors.IllegalState("AddSlice", test.name).Error(), func() { dequeue.AddSlice([]int{1, 2, 3, 4, 5}) })
+		assert.True(t, dequeue.Empty())
+		assert.True(t, dequeue.AddSlice([]int{1, 2}))
+		assert.True(t, dequeue.AddAll(vector.New(3, 4)))
+		assert.PanicsWithError(t, errors.IllegalState("AddAll", test.name).Error(), func() { dequeue.AddAll(vector.New(5)) })
+		assert.Equal(t, []int{1, 2, 3, 4}, dequeue.ToSlice())
+	}
+}
+
+func TestRemove(t *testing.T) {
+
+	for _, test := range dequeueTests {
+		dequeue := test.new(10)
+		dequeue.AddSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+		assert.True(t, dequeue.Remove(1))
+		assert.False(t, dequeue.Remove(1))
+		assert.True(t, dequeue.RemoveIf(func(e int) bool { return e%2 == 0 }))
+		assert.False(t, dequeue.RemoveIf(func(e int) bool { return e%2 == 0 }))
+		assert.True(t, dequeue.RemoveSlice([]int{3, 4}))
+		assert.False(t, dequeue.RemoveAll(vector.New(4, 12)))
+		assert.True(t, dequeue.RetainAll(vector.New(5, 9)))
+		assert.True(t, dequeue.Contains(5))
+		assert.False(t, dequeue.Contains(7))
+		assert.Equal(t, 8, dequeue.RemainingCapacity())
+
+		elements := make([]int, 0)
+		dequeue.ForEach(func(e int) { elements = append(elements, e) })
+		for e := range dequeue.All() {
+			elements = append(elements, e)
+		}
+		it := dequeue.Iterator()
+		dequeue.Clear()
+		for it.HasNext() {
+			elements = append(elements, it.Next())
+		}
+		assert.Equal(t, []int{5, 9, 5, 9, 5, 9}, elements)
+		assert.Equal(t, 0, dequeue.Len())
+	}
+}
+
+func TestBlocking(t *testing.T) {
+
+	for _, test := range dequeueTests {
+		dequeue := test.new(1)
+		dequeue.Put(1)
+
+		// A producer waits for space and a consumer waits for elements.
+		done := make(chan struct{})
+		go func() {
+			assert.Nil(t, dequeue.PutFirst(2))
+			close(done)
+		}()
+		time.Sleep(10 * time.Millisecond)
+		assert.Equal(t, []int{1}, dequeue.ToSlice())
+		e, err := dequeue.Take()
+		assert.Equal(t, 1, e)
+		assert.Nil(t, err)
+		<-done
+		e, err = dequeue.TakeLast()
+		assert.Equal(t, 2, e)
+		assert.Nil(t, err)
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			dequeue.Put(3)
+		}()
+		assert.Equal(t, optional.Of(3), dequeue.PollLast(time.Minute))
+	}
+}
+
+func TestContext(t *testing.T) {
+
+	for _, test := range dequeueTests {
+		dequeue := test.new(1)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := dequeue.TakeContext(ctx)
+		assert.Equal(t, context.DeadlineExceeded, err)
+
+		ctx, cancel = context.WithCancel(context.Background())
+		assert.Nil(t, dequeue.PutContext(ctx, 1))
+		cancel()
+		assert.Equal(t, context.Canceled, dequeue.PutContext(ctx, 2))
+		assert.Equal(t, context.Canceled, dequeue.PutFirstContext(ctx, 2))
+
+		// A done context does not prevent an operation that does not need to wait.
+		e, err := dequeue.TakeLastContext(ctx)
+		assert.Equal(t, 1, e)
+		assert.Nil(t, err)
+		_, err = dequeue.TakeLastContext(ctx)
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
+func TestClose(t *testing.T) {
+
+	for _, test := range dequeueTests {
+		dequeue := test.new(1)
+		dequeue.Put(1)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.EqualError(t, dequeue.Put(2), errors.Closed(test.name).Error())
+		}()
+		time.Sleep(10 * time.Millisecond)
+		dequeue.Close()
+		dequeue.Close()
+		wg.Wait()
+		assert.True(t, dequeue.Closed())
+
+		// The remaining elements can still be taken once the dequeue is closed.
+		assert.EqualError(t, dequeue.PutFirst(2), errors.Closed(test.name).Error())
+		assert.False(t, dequeue.Offer(2, 0))
+		assert.PanicsWithError(t, errors.IllegalState("Add", test.name).Error(), func() { dequeue.Add(2) })
+		e, err := dequeue.Take()
+		assert.Equal(t, 1, e)
+		assert.Nil(t, err)
+		_, err = dequeue.Take()
+		assert.EqualError(t, err, errors.Closed(test.name).Error())
+		assert.True(t, dequeue.Poll(time.Minute).Empty())
+	}
+}
+
+func TestProducerConsumer(t *testing.T) {
+
+	for _, test := range dequeueTests {
+		dequeue := test.new(8)
+		producers, consumers, n := 4, 4, 1000
+
+		var producerGroup sync.WaitGroup
+		for p := 0; p < producers; p++ {
+			producerGroup.Add(1)
+			go func() {
+				defer producerGroup.Done()
+				for i := 1; i <= n; i++ {
+					dequeue.Put(i)
+				}
+			}()
+		}
+
+		sums := make(chan int, consumers)
+		for c := 0; c < consumers; c++ {
+			go func() {
+				sum := 0
+				for {
+					e, err := dequeue.Take()
+					if err != nil {
+						sums <- sum
+						return
+					}
+					assert.LessOrEqual(t, dequeue.Len(), dequeue.Capacity())
+					sum += e
+				}
+			}()
+		}
+
+		producerGroup.Wait()
+		dequeue.Close()
+		total := 0
+		for c := 0; c < consumers; c++ {
+			total += <-sums
+		}
+		assert.Equal(t, producers*n*(n+1)/2, total)
+	}
+}
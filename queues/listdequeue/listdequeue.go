@@ -3,6 +3,7 @@ package listdequeue
 
 import (
 	"fmt"
+	"iter"
 
 	"github.com/phantom820/collections"
 	"github.com/phantom820/collections/iterable"
@@ -155,6 +156,11 @@ func (dequeue *ListDequeue[T]) Iterator() iterator.Iterator[T] {
 	return dequeue.list.Iterator()
 }
 
+// All returns a sequence over the elements in the dequeue from front to back.
+func (dequeue *ListDequeue[T]) All() iter.Seq[T] {
+	return dequeue.list.All()
+}
+
 // String returns the string representation of the dequeue.
 func (dequeue ListDequeue[T]) String() string {
 	return fmt.Sprint(dequeue.list)
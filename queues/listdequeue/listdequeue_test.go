@@ -1,6 +1,7 @@
 package listdequeue
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/phantom820/collections/iterator"
@@ -368,3 +369,36 @@ func TestEquals(t *testing.T) {
 	}
 
 }
+
+func TestAll(t *testing.T) {
+
+	type allTest struct {
+		input    *ListDequeue[int]
+		expected []int
+	}
+
+	allTests := []allTest{
+		{
+			input:    New[int](),
+			expected: nil,
+		},
+		{
+			input:    New(1, 2, 3, 4),
+			expected: []int{1, 2, 3, 4},
+		},
+	}
+
+	for _, test := range allTests {
+		assert.Equal(t, test.expected, slices.Collect(test.input.All()))
+	}
+
+	// breaking out of the loop early stops the sequence.
+	data := make([]int, 0)
+	for e := range New(1, 2, 3, 4).All() {
+		if e == 3 {
+			break
+		}
+		data = append(data, e)
+	}
+	assert.Equal(t, []int{1, 2}, data)
+}
@@ -0,0 +1,129 @@
+package priorityqueue
+
+// heap a slice based d-ary heap, the heap operations shared by [PriorityQueue] and [IndexedPriorityQueue]. The least element according
+// to less is at the root. The queues index their elements through moved, which is called with the new position of an element every
+// time the element is placed in the heap.
+type heap[E any] struct {
+	elements []E
+	arity    int
+	less     func(e1, e2 E) bool
+	moved    func(e E, i int)
+}
+
+// newHeap creates an empty heap in which each node has arity children.
+func newHeap[E any](arity int, less func(e1, e2 E) bool, moved func(e E, i int)) heap[E] {
+	return heap[E]{elements: make([]E, 0), arity: arity, less: less, moved: moved}
+}
+
+// len returns the number of elements in the heap.
+func (heap *heap[E]) len() int {
+	return len(heap.elements)
+}
+
+// swap swaps the elements at positions i and j.
+func (heap *heap[E]) swap(i, j int) {
+	heap.elements[i], heap.elements[j] = heap.elements[j], heap.elements[i]
+	heap.moved(heap.elements[i], i)
+	heap.moved(heap.elements[j], j)
+}
+
+// up moves the element at position i towards the root until its parent is not greater than it.
+func (heap *heap[E]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / heap.arity
+		if !heap.less(heap.elements[i], heap.elements[parent]) {
+			return
+		}
+		heap.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the element at position i away from the root until none of its children is less than it, returns true if it moved.
+func (heap *heap[E]) down(i int) bool {
+	start := i
+	for {
+		smallest := i
+		first := heap.arity*i + 1
+		for child := first; child < first+heap.arity && child < len(heap.elements); child++ {
+			if heap.less(heap.elements[child], heap.elements[smallest]) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return i != start
+		}
+		heap.swap(i, smallest)
+		i = smallest
+	}
+}
+
+// fix restores the heap order after the element at position i changed.
+func (heap *heap[E]) fix(i int) {
+	if !heap.down(i) {
+		heap.up(i)
+	}
+}
+
+// heapify restores the heap order of the whole heap in linear time.
+func (heap *heap[E]) heapify() {
+	for i, e := range heap.elements {
+		heap.moved(e, i)
+	}
+	for i := (len(heap.elements) - 2) / heap.arity; i >= 0; i-- {
+		heap.down(i)
+	}
+}
+
+// push adds the element to the heap.
+func (heap *heap[E]) push(e E) {
+	heap.elements = append(heap.elements, e)
+	heap.moved(e, len(heap.elements)-1)
+	heap.up(len(heap.elements) - 1)
+}
+
+// pushSlice adds the elements to the heap, the heap is rebuilt in linear time.
+func (heap *heap[E]) pushSlice(s []E) {
+	heap.elements = append(heap.elements, s...)
+	heap.heapify()
+}
+
+// removeAt removes the element at position i from the heap and returns it. The freed slot is zeroed so that the heap does not keep the
+// element reachable.
+func (heap *heap[E]) removeAt(i int) E {
+	last := len(heap.elements) - 1
+	e := heap.elements[i]
+	if i != last {
+		heap.swap(i, last)
+	}
+	var zero E
+	heap.elements[last] = zero
+	heap.elements = heap.elements[:last]
+	if i != last {
+		heap.fix(i)
+	}
+	return e
+}
+
+// removeIf removes the elements that satisfy the given predicate and returns true if any was removed, the heap is rebuilt in linear
+// time. The freed slots are zeroed so that the heap does not keep the removed elements reachable.
+func (heap *heap[E]) removeIf(f func(E) bool) bool {
+	retained := heap.elements[:0]
+	for _, e := range heap.elements {
+		if !f(e) {
+			retained = append(retained, e)
+		}
+	}
+	if len(retained) == len(heap.elements) {
+		return false
+	}
+	clear(heap.elements[len(retained):])
+	heap.elements = retained
+	heap.heapify()
+	return true
+}
+
+// clear removes all the elements from the heap.
+func (heap *heap[E]) clear() {
+	heap.elements = make([]E, 0)
+}
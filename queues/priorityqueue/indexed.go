@@ -0,0 +1,323 @@
+package priorityqueue
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// indexedEntry a key of an [IndexedPriorityQueue] along with its priority.
+type indexedEntry[K comparable, P any] struct {
+	key      K
+	priority P
+}
+
+// IndexedPriorityQueue a priority queue of distinct keys, each queued with a priority of its own. The position of every key in the heap
+// is indexed, so the priority of a key can be looked up, changed or the key removed without searching the heap. This is the queue
+// needed by graph algorithms such as Dijkstra's and Prim's.
+//
+// Keys can only be added along with a priority through Push, the Add methods of [collections.Queue] are unsupported.
+type IndexedPriorityQueue[K comparable, P any] struct {
+	heap      heap[indexedEntry[K, P]]
+	positions hashmap.HashMap[K, int] // The position of each key in the heap.
+	modCount  int                     // The number of structural modifications, used to detect modifications during iteration.
+}
+
+// NewIndexed creates an empty indexed priority queue backed by a binary heap in which keys with lesser priorities according to lessThan
+// are removed first.
+func NewIndexed[K comparable, P any](lessThan func(p1, p2 P) bool) *IndexedPriorityQueue[K, P] {
+	return NewIndexedDAry[K](default_arity, lessThan)
+}
+
+// NewIndexedDAry creates an empty indexed priority queue that is backed by a heap in which each node has arity children, see [NewDAry].
+// NewIndexedDAry panics if the arity is less than 2.
+func NewIndexedDAry[K comparable, P any](arity int, lessThan func(p1, p2 P) bool) *IndexedPriorityQueue[K, P] {
+	if arity < 2 {
+		panic(errors.IllegalArgument("arity", arity))
+	}
+	queue := IndexedPriorityQueue[K, P]{positions: hashmap.New[K, int]()}
+	queue.heap = newHeap(arity, func(e1, e2 indexedEntry[K, P]) bool { return lessThan(e1.priority, e2.priority) },
+		func(entry indexedEntry[K, P], i int) { queue.positions[entry.key] = i })
+	return &queue
+}
+
+// Arity returns the number of children of each node in the heap backing the queue.
+func (queue *IndexedPriorityQueue[K, P]) Arity() int {
+	return queue.heap.arity
+}
+
+// removeAt removes the key at position i from the heap.
+func (queue *IndexedPriorityQueue[K, P]) removeAt(i int) indexedEntry[K, P] {
+	queue.modCount++
+	entry := queue.heap.removeAt(i)
+	delete(queue.positions, entry.key)
+	return entry
+}
+
+// Push adds the key to the queue with the given priority, or changes the priority of the key if it is already queued. Push returns the
+// previous priority of the key as an option.
+func (queue *IndexedPriorityQueue[K, P]) Push(key K, priority P) optional.Optional[P] {
+	if i, ok := queue.positions[key]; ok {
+		previous := queue.heap.elements[i].priority
+		queue.update(i, priority)
+		return optional.Of(previous)
+	}
+	queue.modCount++
+	queue.heap.push(indexedEntry[K, P]{key: key, priority: priority})
+	return optional.Empty[P]()
+}
+
+// update changes the priority of the key at position i.
+func (queue *IndexedPriorityQueue[K, P]) update(i int, priority P) {
+	queue.modCount++
+	queue.heap.elements[i].priority = priority
+	queue.heap.fix(i)
+}
+
+// Update changes the priority of a queued key and returns true, the queue is left unchanged and false is returned if the key is not
+// queued.
+func (queue *IndexedPriorityQueue[K, P]) Update(key K, priority P) bool {
+	i, ok := queue.positions[key]
+	if !ok {
+		return false
+	}
+	queue.update(i, priority)
+	return true
+}
+
+// Priority returns the priority of the given key as an option.
+func (queue *IndexedPriorityQueue[K, P]) Priority(key K) optional.Optional[P] {
+	if i, ok := queue.positions[key]; ok {
+		return optional.Of(queue.heap.elements[i].priority)
+	}
+	return optional.Empty[P]()
+}
+
+// Add unsupported operation, keys are added with a priority through Push.
+func (queue *IndexedPriorityQueue[K, P]) Add(key K) bool {
+	panic(errors.UnsupportedOperation("Add", "IndexedPriorityQueue"))
+}
+
+// AddLast unsupported operation, keys are added with a priority through Push.
+func (queue *IndexedPriorityQueue[K, P]) AddLast(key K) optional.Optional[K] {
+	panic(errors.UnsupportedOperation("AddLast", "IndexedPriorityQueue"))
+}
+
+// AddAll unsupported operation, keys are added with a priority through Push.
+func (queue *IndexedPriorityQueue[K, P]) AddAll(iterable iterable.Iterable[K]) bool {
+	panic(errors.UnsupportedOperation("AddAll", "IndexedPriorityQueue"))
+}
+
+// AddSlice unsupported operation, keys are added with a priority through Push.
+func (queue *IndexedPriorityQueue[K, P]) AddSlice(s []K) bool {
+	panic(errors.UnsupportedOperation("AddSlice", "IndexedPriorityQueue"))
+}
+
+// PeekFirst retrieves, but does not remove, the key with the highest priority.
+func (queue *IndexedPriorityQueue[K, P]) PeekFirst() optional.Optional[K] {
+	if queue.Empty() {
+		return optional.Empty[K]()
+	}
+	return optional.Of(queue.heap.elements[0].key)
+}
+
+// PeekFirstEntry retrieves, but does not remove, the key with the highest priority along with its priority.
+func (queue *IndexedPriorityQueue[K, P]) PeekFirstEntry() optional.Optional[pair.Pair[K, P]] {
+	if queue.Empty() {
+		return optional.Empty[pair.Pair[K, P]]()
+	}
+	entry := queue.heap.elements[0]
+	return optional.Of(pair.Of(entry.key, entry.priority))
+}
+
+// RemoveFirst retrieves and removes the key with the highest priority.
+func (queue *IndexedPriorityQueue[K, P]) RemoveFirst() optional.Optional[K] {
+	if queue.Empty() {
+		return optional.Empty[K]()
+	}
+	return optional.Of(queue.removeAt(0).key)
+}
+
+// RemoveFirstEntry retrieves and removes the key with the highest priority along with its priority.
+func (queue *IndexedPriorityQueue[K, P]) RemoveFirstEntry() optional.Optional[pair.Pair[K, P]] {
+	if queue.Empty() {
+		return optional.Empty[pair.Pair[K, P]]()
+	}
+	entry := queue.removeAt(0)
+	return optional.Of(pair.Of(entry.key, entry.priority))
+}
+
+// Contains returns true if the key is queued.
+func (queue *IndexedPriorityQueue[K, P]) Contains(key K) bool {
+	return queue.positions.ContainsKey(key)
+}
+
+// Remove removes the key from the queue and returns true if the queue changed as a result.
+func (queue *IndexedPriorityQueue[K, P]) Remove(key K) bool {
+	i, ok := queue.positions[key]
+	if !ok {
+		return false
+	}
+	queue.removeAt(i)
+	return true
+}
+
+// RemoveIf removes all of the keys of the queue that satisfy the given predicate, the heap is rebuilt in linear time.
+func (queue *IndexedPriorityQueue[K, P]) RemoveIf(f func(K) bool) bool {
+	removed := queue.heap.removeIf(func(entry indexedEntry[K, P]) bool {
+		if f(entry.key) {
+			delete(queue.positions, entry.key)
+			return true
+		}
+		return false
+	})
+	if removed {
+		queue.modCount++
+	}
+	return removed
+}
+
+// RemoveAll removes all of the queue's keys that are also contained in the specified iterable.
+func (queue *IndexedPriorityQueue[K, P]) RemoveAll(iterable iterable.Iterable[K]) bool {
+	changed := false
+	it := iterable.Iterator()
+	for it.HasNext() {
+		if queue.Remove(it.Next()) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// RemoveSlice removes all of the queue's keys that are also contained in the specified slice.
+func (queue *IndexedPriorityQueue[K, P]) RemoveSlice(s []K) bool {
+	changed := false
+	for _, key := range s {
+		if queue.Remove(key) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// RetainAll retains only the keys in the queue that are contained in the specified collection.
+func (queue *IndexedPriorityQueue[K, P]) RetainAll(c collections.Collection[K]) bool {
+	return queue.RemoveIf(func(key K) bool { return !c.Contains(key) })
+}
+
+// Clear removes all of the keys from the queue.
+func (queue *IndexedPriorityQueue[K, P]) Clear() {
+	queue.modCount++
+	queue.heap.clear()
+	queue.positions.Clear()
+}
+
+// Empty returns true if the queue contains no keys.
+func (queue *IndexedPriorityQueue[K, P]) Empty() bool {
+	return queue.heap.len() == 0
+}
+
+// Len returns the number of keys in the queue.
+func (queue *IndexedPriorityQueue[K, P]) Len() int {
+	return queue.heap.len()
+}
+
+// ForEach performs the given action for each key of the queue. The keys are visited in heap order, not in priority order.
+func (queue *IndexedPriorityQueue[K, P]) ForEach(f func(K)) {
+	for _, entry := range queue.heap.elements {
+		f(entry.key)
+	}
+}
+
+// ToSlice returns a slice containing the keys of the queue in heap order, the first key has the highest priority.
+func (queue *IndexedPriorityQueue[K, P]) ToSlice() []K {
+	slice := make([]K, queue.heap.len())
+	for i, entry := range queue.heap.elements {
+		slice[i] = entry.key
+	}
+	return slice
+}
+
+// Iterator returns an iterator over the keys in the queue in heap order. The iterator is fail fast, Next panics if the queue is
+// structurally modified after iteration has started.
+func (queue *IndexedPriorityQueue[K, P]) Iterator() iterator.Iterator[K] {
+	return iterator.Map(queue.entryIterator(), func(entry indexedEntry[K, P]) K { return entry.key })
+}
+
+// entryIterator returns a fail fast iterator over the entries in the queue in heap order.
+func (queue *IndexedPriorityQueue[K, P]) entryIterator() iterator.Iterator[indexedEntry[K, P]] {
+	return &indexedQueueIterator[K, P]{queue: queue, modCount: queue.modCount}
+}
+
+// All returns a sequence over the keys in the queue in heap order. The sequence panics if the queue is structurally modified while
+// ranging over it.
+func (queue *IndexedPriorityQueue[K, P]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range queue.AllEntries() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// AllEntries returns a sequence over the keys in the queue and their priorities in heap order. The sequence panics if the queue is
+// structurally modified while ranging over it.
+func (queue *IndexedPriorityQueue[K, P]) AllEntries() iter.Seq2[K, P] {
+	return func(yield func(K, P) bool) {
+		modCount := queue.modCount
+		for _, entry := range queue.heap.elements {
+			if !yield(entry.key, entry.priority) {
+				return
+			} else if modCount != queue.modCount {
+				panic(errors.ConcurrentModification("IndexedPriorityQueue"))
+			}
+		}
+	}
+}
+
+// indexedQueueIterator iterator implementation for [IndexedPriorityQueue].
+type indexedQueueIterator[K comparable, P any] struct {
+	queue    *IndexedPriorityQueue[K, P]
+	modCount int // The expected modification count of the queue.
+	index    int
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *indexedQueueIterator[K, P]) HasNext() bool {
+	return it.index < it.queue.heap.len()
+}
+
+// Next returns the next element in the iterator.
+func (it *indexedQueueIterator[K, P]) Next() indexedEntry[K, P] {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	} else if it.modCount != it.queue.modCount {
+		panic(errors.ConcurrentModification("IndexedPriorityQueue"))
+	}
+	entry := it.queue.heap.elements[it.index]
+	it.index++
+	return entry
+}
+
+// String returns the string representation of the queue in heap order.
+func (queue *IndexedPriorityQueue[K, P]) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i, entry := range queue.heap.elements {
+		if i > 0 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", entry.key, entry.priority))
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
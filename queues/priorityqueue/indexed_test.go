@@ -0,0 +1,153 @@
+package priorityqueue
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexedPush(t *testing.T) {
+
+	queue := NewIndexed[string](lessThan)
+	assert.True(t, queue.Push("a", 3).Empty())
+	assert.True(t, queue.Push("b", 1).Empty())
+	assert.True(t, queue.Push("c", 2).Empty())
+	assert.Equal(t, optional.Of(3), queue.Push("a", 0))
+	assert.Equal(t, 3, queue.Len())
+
+	assert.Equal(t, optional.Of("a"), queue.PeekFirst())
+	assert.Equal(t, optional.Of(pair.Of("a", 0)), queue.PeekFirstEntry())
+	assert.Equal(t, optional.Of(1), queue.Priority("b"))
+	assert.True(t, queue.Priority("d").Empty())
+
+	assert.True(t, queue.Update("a", 5))
+	assert.False(t, queue.Update("d", 5))
+	assert.Equal(t, optional.Of(pair.Of("b", 1)), queue.RemoveFirstEntry())
+	assert.Equal(t, optional.Of("c"), queue.RemoveFirst())
+	assert.Equal(t, optional.Of("a"), queue.RemoveFirst())
+	assert.True(t, queue.RemoveFirst().Empty())
+	assert.True(t, queue.RemoveFirstEntry().Empty())
+	assert.True(t, queue.PeekFirstEntry().Empty())
+}
+
+func TestIndexedUnsupported(t *testing.T) {
+
+	var queue collections.Queue[string] = NewIndexed[string](lessThan)
+	assert.PanicsWithError(t, errors.UnsupportedOperation("Add", "IndexedPriorityQueue").Error(), func() { queue.Add("a") })
+	assert.PanicsWithError(t, errors.UnsupportedOperation("AddLast", "IndexedPriorityQueue").Error(), func() { queue.AddLast("a") })
+	assert.PanicsWithError(t, errors.UnsupportedOperation("AddAll", "IndexedPriorityQueue").Error(), func() { queue.AddAll(vector.New("a")) })
+	assert.PanicsWithError(t, errors.UnsupportedOperation("AddSlice", "IndexedPriorityQueue").Error(), func() { queue.AddSlice([]string{"a"}) })
+}
+
+func TestIndexedRandom(t *testing.T) {
+
+	for arity := 2; arity <= 4; arity++ {
+		testIndexedRandom(t, NewIndexedDAry[int](arity, lessThan))
+	}
+	assert.Equal(t, 2, NewIndexed[int](lessThan).Arity())
+	assert.PanicsWithError(t, errors.IllegalArgument("arity", 1).Error(), func() { NewIndexedDAry[int](1, lessThan) })
+}
+
+func testIndexedRandom(t *testing.T, queue *IndexedPriorityQueue[int, int]) {
+
+	priorities := make(map[int]int)
+	for i := 0; i < 1000; i++ {
+		key := rand.Intn(100)
+		switch rand.Intn(4) {
+		case 0, 1:
+			priority := rand.Intn(1000)
+			queue.Push(key, priority)
+			priorities[key] = priority
+		case 2:
+			_, ok := priorities[key]
+			assert.Equal(t, ok, queue.Remove(key))
+			delete(priorities, key)
+		case 3:
+			if entry := queue.RemoveFirstEntry(); !entry.Empty() {
+				for _, priority := range priorities {
+					assert.LessOrEqual(t, entry.Value().Value(), priority)
+				}
+				assert.Equal(t, priorities[entry.Value().Key()], entry.Value().Value())
+				delete(priorities, entry.Value().Key())
+			}
+		}
+		assert.Equal(t, len(priorities), queue.Len())
+		for key, i := range queue.positions {
+			assert.Equal(t, key, queue.heap.elements[i].key)
+		}
+	}
+}
+
+func TestIndexedDijkstra(t *testing.T) {
+
+	edges := map[string][]pair.Pair[string, int]{
+		"a": {pair.Of("b", 7), pair.Of("c", 9), pair.Of("f", 14)},
+		"b": {pair.Of("a", 7), pair.Of("c", 10), pair.Of("d", 15)},
+		"c": {pair.Of("a", 9), pair.Of("b", 10), pair.Of("d", 11), pair.Of("f", 2)},
+		"d": {pair.Of("b", 15), pair.Of("c", 11), pair.Of("e", 6)},
+		"e": {pair.Of("d", 6), pair.Of("f", 9)},
+		"f": {pair.Of("a", 14), pair.Of("c", 2), pair.Of("e", 9)},
+	}
+	distances := make(map[string]int)
+	queue := NewIndexed[string](lessThan)
+	queue.Push("a", 0)
+	for !queue.Empty() {
+		entry := queue.RemoveFirstEntry().Value()
+		distances[entry.Key()] = entry.Value()
+		for _, edge := range edges[entry.Key()] {
+			if _, ok := distances[edge.Key()]; ok {
+				continue
+			} else if priority := queue.Priority(edge.Key()); priority.Empty() || entry.Value()+edge.Value() < priority.Value() {
+				queue.Push(edge.Key(), entry.Value()+edge.Value())
+			}
+		}
+	}
+	assert.Equal(t, map[string]int{"a": 0, "b": 7, "c": 9, "d": 20, "e": 20, "f": 11}, distances)
+}
+
+func TestIndexedRemove(t *testing.T) {
+
+	queue := NewIndexed[int](lessThan)
+	for i := 1; i <= 10; i++ {
+		queue.Push(i, -i)
+	}
+	assert.True(t, queue.Contains(1))
+	assert.True(t, queue.Remove(1))
+	assert.False(t, queue.Contains(1))
+	assert.False(t, queue.Remove(1))
+	assert.True(t, queue.RemoveIf(func(key int) bool { return key%2 == 0 }))
+	assert.False(t, queue.RemoveIf(func(key int) bool { return key%2 == 0 }))
+	assert.True(t, queue.RemoveSlice([]int{3, 4}))
+	// Slots freed by removals must not keep keys reachable.
+	for _, entry := range queue.heap.elements[queue.Len():cap(queue.heap.elements)] {
+		assert.Equal(t, indexedEntry[int, int]{}, entry)
+	}
+	assert.False(t, queue.RemoveAll(vector.New(4, 12)))
+	assert.False(t, queue.RetainAll(vector.New(5, 7, 9)))
+	queue.Push(11, 0)
+	assert.True(t, queue.RetainAll(vector.New(5, 7, 9)))
+	assert.Equal(t, "[9=-9 7=-7 5=-5]", queue.String())
+
+	keys := make([]int, 0)
+	queue.ForEach(func(key int) { keys = append(keys, key) })
+	assert.Equal(t, []int{9, 7, 5}, keys)
+	assert.Equal(t, keys, queue.ToSlice())
+	assert.Equal(t, keys, slices.Collect(queue.All()))
+
+	it := queue.Iterator()
+	assert.Equal(t, 9, it.Next())
+	queue.Update(5, -10)
+	assert.PanicsWithError(t, errors.ConcurrentModification("IndexedPriorityQueue").Error(), func() { it.Next() })
+
+	queue.Clear()
+	assert.True(t, queue.Empty())
+	assert.False(t, queue.Contains(9))
+	assert.PanicsWithError(t, errors.NoSuchElement().Error(), func() { queue.Iterator().Next() })
+}
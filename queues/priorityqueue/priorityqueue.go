@@ -0,0 +1,351 @@
+// package priorityqueue defines a priority queue backed by a slice based d-ary heap. The front of the queue is always the least element
+// according to the ordering given by a less than function, a binary heap is used by default. An [IndexedPriorityQueue] indexes its keys
+// so that their priorities can be changed without handles.
+package priorityqueue
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+)
+
+const default_arity = 2
+
+// Handle a reference to an element of a [PriorityQueue]. A handle is returned when an element is pushed onto the queue and can be used to
+// change the priority of the element, it remains valid until the element is removed from the queue.
+type Handle[T comparable] struct {
+	value T
+	index int               // The position of the element in the heap.
+	queue *PriorityQueue[T] // The queue holding the element, nil once the element is removed.
+}
+
+// Value returns the element referenced by the handle.
+func (handle *Handle[T]) Value() T {
+	return handle.value
+}
+
+// Queued returns true if the element referenced by the handle is still in a queue.
+func (handle *Handle[T]) Queued() bool {
+	return handle.queue != nil
+}
+
+// PriorityQueue a queue in which elements are removed in the order defined by a less than function rather than in the order they were
+// added. Elements with equal priority are removed in no particular order.
+type PriorityQueue[T comparable] struct {
+	heap     heap[*Handle[T]]
+	lessThan func(e1, e2 T) bool
+	modCount int // The number of structural modifications, used to detect modifications during iteration.
+}
+
+// New creates a binary heap based priority queue with the given elements, the heap is built in linear time.
+func New[T comparable](lessThan func(e1, e2 T) bool, elements ...T) *PriorityQueue[T] {
+	return NewDAry(default_arity, lessThan, elements...)
+}
+
+// NewDAry creates a priority queue with the given elements that is backed by a heap in which each node has arity children. A higher
+// arity makes additions and priority decreases cheaper at the cost of removals. NewDAry panics if the arity is less than 2.
+func NewDAry[T comparable](arity int, lessThan func(e1, e2 T) bool, elements ...T) *PriorityQueue[T] {
+	if arity < 2 {
+		panic(errors.IllegalArgument("arity", arity))
+	}
+	queue := PriorityQueue[T]{lessThan: lessThan}
+	queue.heap = newHeap(arity, func(h1, h2 *Handle[T]) bool { return lessThan(h1.value, h2.value) },
+		func(handle *Handle[T], i int) { handle.index = i })
+	queue.AddSlice(elements)
+	return &queue
+}
+
+// Arity returns the number of children of each node in the heap backing the queue.
+func (queue *PriorityQueue[T]) Arity() int {
+	return queue.heap.arity
+}
+
+// removeAt removes the element at position i from the heap.
+func (queue *PriorityQueue[T]) removeAt(i int) T {
+	queue.modCount++
+	handle := queue.heap.removeAt(i)
+	handle.queue = nil
+	return handle.value
+}
+
+// check panics if the handle does not reference an element of the queue.
+func (queue *PriorityQueue[T]) check(handle *Handle[T]) {
+	if handle.queue != queue {
+		panic(errors.IllegalArgument("handle", handle.value))
+	}
+}
+
+// Push adds an element to the queue and returns a handle to it.
+func (queue *PriorityQueue[T]) Push(e T) *Handle[T] {
+	queue.modCount++
+	handle := &Handle[T]{value: e, queue: queue}
+	queue.heap.push(handle)
+	return handle
+}
+
+// Update replaces the element referenced by the handle and moves it to the position given by its new priority. Update panics if the
+// handle does not reference an element of the queue.
+func (queue *PriorityQueue[T]) Update(handle *Handle[T], e T) {
+	queue.check(handle)
+	queue.modCount++
+	handle.value = e
+	queue.heap.fix(handle.index)
+}
+
+// DecreaseKey replaces the element referenced by the handle with an element of equal or higher priority. DecreaseKey panics if the handle
+// does not reference an element of the queue or if the new element has a lower priority than the current one.
+func (queue *PriorityQueue[T]) DecreaseKey(handle *Handle[T], e T) {
+	queue.check(handle)
+	if queue.lessThan(handle.value, e) {
+		panic(errors.IllegalArgument("e", e))
+	}
+	queue.modCount++
+	handle.value = e
+	queue.heap.up(handle.index)
+}
+
+// RemoveHandle removes the element referenced by the handle from the queue and returns it. RemoveHandle panics if the handle does not
+// reference an element of the queue.
+func (queue *PriorityQueue[T]) RemoveHandle(handle *Handle[T]) T {
+	queue.check(handle)
+	return queue.removeAt(handle.index)
+}
+
+// Merge moves all the elements of the other queue into this queue, leaving the other queue empty. Handles to elements of the other queue
+// become handles to elements of this queue. The queues are merged in linear time.
+func (queue *PriorityQueue[T]) Merge(other *PriorityQueue[T]) {
+	if queue == other || other.Empty() {
+		return
+	}
+	queue.modCount++
+	other.modCount++
+	for _, handle := range other.heap.elements {
+		handle.queue = queue
+	}
+	queue.heap.pushSlice(other.heap.elements)
+	other.heap.clear()
+}
+
+// Add adds the given element to the queue.
+func (queue *PriorityQueue[T]) Add(e T) bool {
+	queue.Push(e)
+	return true
+}
+
+// AddLast adds the given element to the queue. The position of an element is given by its priority, so there is no back element to
+// return and the result is always empty.
+func (queue *PriorityQueue[T]) AddLast(e T) optional.Optional[T] {
+	queue.Push(e)
+	return optional.Empty[T]()
+}
+
+// PeekFirst retrieves, but does not remove, the element with the highest priority.
+func (queue *PriorityQueue[T]) PeekFirst() optional.Optional[T] {
+	if queue.Empty() {
+		return optional.Empty[T]()
+	}
+	return optional.Of(queue.heap.elements[0].value)
+}
+
+// RemoveFirst retrieves and removes the element with the highest priority.
+func (queue *PriorityQueue[T]) RemoveFirst() optional.Optional[T] {
+	if queue.Empty() {
+		return optional.Empty[T]()
+	}
+	return optional.Of(queue.removeAt(0))
+}
+
+// AddAll adds all of the elements in the specified iterable to the queue.
+func (queue *PriorityQueue[T]) AddAll(iterable iterable.Iterable[T]) bool {
+	it := iterable.Iterator()
+	changed := false
+	for it.HasNext() {
+		queue.Push(it.Next())
+		changed = true
+	}
+	return changed
+}
+
+// AddSlice adds all the elements in the slice to the queue, the heap is rebuilt in linear time.
+func (queue *PriorityQueue[T]) AddSlice(s []T) bool {
+	if len(s) == 0 {
+		return false
+	}
+	queue.modCount++
+	handles := make([]*Handle[T], len(s))
+	for i, e := range s {
+		handles[i] = &Handle[T]{value: e, queue: queue}
+	}
+	queue.heap.pushSlice(handles)
+	return true
+}
+
+// Contains returns true if the queue contains the specified element.
+func (queue *PriorityQueue[T]) Contains(e T) bool {
+	for _, handle := range queue.heap.elements {
+		if handle.value == e {
+			return true
+		}
+	}
+	return false
+}
+
+// Clear removes all of the elements from the queue.
+func (queue *PriorityQueue[T]) Clear() {
+	queue.modCount++
+	for _, handle := range queue.heap.elements {
+		handle.queue = nil
+	}
+	queue.heap.clear()
+}
+
+// Empty returns true if the queue contains no elements.
+func (queue *PriorityQueue[T]) Empty() bool {
+	return queue.heap.len() == 0
+}
+
+// Len returns the number of elements in the queue.
+func (queue *PriorityQueue[T]) Len() int {
+	return queue.heap.len()
+}
+
+// Remove removes an occurrence of the given element from the queue and returns true if the queue changed as a result.
+func (queue *PriorityQueue[T]) Remove(e T) bool {
+	for i, handle := range queue.heap.elements {
+		if handle.value == e {
+			queue.removeAt(i)
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveIf removes all of the elements of the queue that satisfy the given predicate, the heap is rebuilt in linear time.
+func (queue *PriorityQueue[T]) RemoveIf(f func(T) bool) bool {
+	removed := queue.heap.removeIf(func(handle *Handle[T]) bool {
+		if f(handle.value) {
+			handle.queue = nil
+			return true
+		}
+		return false
+	})
+	if removed {
+		queue.modCount++
+	}
+	return removed
+}
+
+// RemoveAll removes all of the queue's elements that are also contained in the specified iterable.
+func (queue *PriorityQueue[T]) RemoveAll(iterable iterable.Iterable[T]) bool {
+	elements := make(map[T]struct{})
+	it := iterable.Iterator()
+	for it.HasNext() {
+		elements[it.Next()] = struct{}{}
+	}
+	return queue.removeElements(elements)
+}
+
+// RemoveSlice removes all of the queue's elements that are also contained in the specified slice.
+func (queue *PriorityQueue[T]) RemoveSlice(s []T) bool {
+	elements := make(map[T]struct{}, len(s))
+	for _, e := range s {
+		elements[e] = struct{}{}
+	}
+	return queue.removeElements(elements)
+}
+
+// removeElements removes all of the queue's elements that are contained in the given set.
+func (queue *PriorityQueue[T]) removeElements(elements map[T]struct{}) bool {
+	return queue.RemoveIf(func(e T) bool {
+		_, ok := elements[e]
+		return ok
+	})
+}
+
+// RetainAll retains only the elements in the queue that are contained in the specified collection.
+func (queue *PriorityQueue[T]) RetainAll(c collections.Collection[T]) bool {
+	return queue.RemoveIf(func(e T) bool { return !c.Contains(e) })
+}
+
+// ForEach performs the given action for each element of the queue. The elements are visited in heap order, not in priority order.
+func (queue *PriorityQueue[T]) ForEach(f func(T)) {
+	for _, handle := range queue.heap.elements {
+		f(handle.value)
+	}
+}
+
+// ToSlice returns a slice containing the elements of the queue in heap order, the first element has the highest priority.
+func (queue *PriorityQueue[T]) ToSlice() []T {
+	slice := make([]T, queue.heap.len())
+	for i, handle := range queue.heap.elements {
+		slice[i] = handle.value
+	}
+	return slice
+}
+
+// Iterator returns an iterator over the elements in the queue in heap order. The iterator is fail fast, Next panics if the queue is
+// structurally modified after iteration has started.
+func (queue *PriorityQueue[T]) Iterator() iterator.Iterator[T] {
+	return &queueIterator[T]{queue: queue, modCount: queue.modCount}
+}
+
+// All returns a sequence over the elements in the queue in heap order. The sequence panics if the queue is structurally modified while
+// ranging over it.
+func (queue *PriorityQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		modCount := queue.modCount
+		for _, handle := range queue.heap.elements {
+			if !yield(handle.value) {
+				return
+			} else if modCount != queue.modCount {
+				panic(errors.ConcurrentModification("PriorityQueue"))
+			}
+		}
+	}
+}
+
+// Drain returns a sequence that removes the elements from the queue in priority order, stopping early leaves the remaining elements in the
+// queue.
+func (queue *PriorityQueue[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !queue.Empty() {
+			if !yield(queue.removeAt(0)) {
+				return
+			}
+		}
+	}
+}
+
+// queueIterator iterator implementation for [PriorityQueue].
+type queueIterator[T comparable] struct {
+	queue    *PriorityQueue[T]
+	modCount int // The expected modification count of the queue.
+	index    int
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *queueIterator[T]) HasNext() bool {
+	return it.index < it.queue.heap.len()
+}
+
+// Next returns the next element in the iterator.
+func (it *queueIterator[T]) Next() T {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	} else if it.modCount != it.queue.modCount {
+		panic(errors.ConcurrentModification("PriorityQueue"))
+	}
+	handle := it.queue.heap.elements[it.index]
+	it.index++
+	return handle.value
+}
+
+// String returns the string representation of the queue in heap order.
+func (queue *PriorityQueue[T]) String() string {
+	return fmt.Sprint(queue.ToSlice())
+}
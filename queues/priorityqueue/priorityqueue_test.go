@@ -0,0 +1,167 @@
+package priorityqueue
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+func lessThan(a, b int) bool {
+	return a < b
+}
+
+func drain(queue *PriorityQueue[int]) []int {
+	elements := make([]int, 0)
+	for e := range queue.Drain() {
+		elements = append(elements, e)
+	}
+	return elements
+}
+
+func TestNew(t *testing.T) {
+
+	assert.Equal(t, 2, New(lessThan).Arity())
+	assert.Equal(t, 4, NewDAry(4, lessThan).Arity())
+	assert.PanicsWithError(t, errors.IllegalArgument("arity", 1).Error(), func() { NewDAry(1, lessThan) })
+}
+
+func TestPeekFirst(t *testing.T) {
+
+	peekFirstTests := []struct {
+		input    *PriorityQueue[int]
+		expected optional.Optional[int]
+	}{
+		{input: New(lessThan), expected: optional.Empty[int]()},
+		{input: New(lessThan, 1), expected: optional.Of(1)},
+		{input: New(lessThan, 3, 1, 2), expected: optional.Of(1)},
+		{input: New(func(a, b int) bool { return a > b }, 3, 1, 2), expected: optional.Of(3)},
+	}
+
+	for _, test := range peekFirstTests {
+		assert.Equal(t, test.expected, test.input.PeekFirst())
+	}
+}
+
+func TestRemoveFirst(t *testing.T) {
+
+	for _, arity := range []int{2, 3, 4, 8} {
+		elements := rand.Perm(100)
+		queue := NewDAry(arity, lessThan, elements[:50]...)
+		for _, e := range elements[50:] {
+			assert.True(t, queue.AddLast(e).Empty())
+		}
+		assert.Equal(t, 100, queue.Len())
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, i, queue.RemoveFirst().Value())
+		}
+		assert.True(t, queue.RemoveFirst().Empty())
+		assert.True(t, queue.Empty())
+	}
+}
+
+func TestHandle(t *testing.T) {
+
+	queue := New(lessThan, 10, 20)
+	a := queue.Push(30)
+	b := queue.Push(40)
+	c := queue.Push(50)
+
+	queue.DecreaseKey(c, 5)
+	assert.Equal(t, 5, queue.PeekFirst().Value())
+	assert.Equal(t, 5, c.Value())
+	assert.PanicsWithError(t, errors.IllegalArgument("e", 60).Error(), func() { queue.DecreaseKey(c, 60) })
+
+	queue.Update(c, 60)
+	queue.Update(a, 1)
+	queue.Update(b, 15)
+	assert.Equal(t, 10, queue.RemoveHandle(queue.Push(10)))
+	assert.Equal(t, []int{1, 10, 15, 20, 60}, drain(queue))
+
+	assert.False(t, a.Queued())
+	assert.PanicsWithError(t, errors.IllegalArgument("handle", 1).Error(), func() { queue.Update(a, 2) })
+	assert.PanicsWithError(t, errors.IllegalArgument("handle", 15).Error(), func() { New(lessThan).RemoveHandle(b) })
+}
+
+func TestMerge(t *testing.T) {
+
+	queue := New(lessThan, 5, 1, 9)
+	other := NewDAry(3, lessThan, 4, 8)
+	handle := other.Push(7)
+
+	queue.Merge(other)
+	queue.Merge(queue)
+	assert.True(t, other.Empty())
+	assert.Equal(t, 6, queue.Len())
+
+	queue.DecreaseKey(handle, 0)
+	assert.PanicsWithError(t, errors.IllegalArgument("handle", 0).Error(), func() { other.Update(handle, 3) })
+	assert.Equal(t, []int{0, 1, 4, 5, 8, 9}, drain(queue))
+}
+
+func TestRemove(t *testing.T) {
+
+	queue := New(lessThan, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	handle := queue.Push(11)
+
+	assert.True(t, queue.Remove(1))
+	assert.False(t, queue.Remove(1))
+	assert.True(t, queue.RemoveIf(func(e int) bool { return e%2 == 0 }))
+	assert.False(t, queue.RemoveIf(func(e int) bool { return e%2 == 0 }))
+	assert.True(t, queue.RemoveSlice([]int{3, 4}))
+	assert.False(t, queue.RemoveAll(vector.New(4, 12)))
+	assert.True(t, queue.RetainAll(vector.New(5, 7, 11)))
+	assert.True(t, queue.Contains(5))
+	assert.False(t, queue.Contains(9))
+	for _, handle := range queue.heap.elements[queue.Len():cap(queue.heap.elements)] {
+		assert.Nil(t, handle)
+	}
+
+	queue.DecreaseKey(handle, 6)
+	assert.Equal(t, []int{5, 6, 7}, drain(queue))
+
+	queue.AddAll(vector.New(3, 2, 1))
+	queue.Clear()
+	assert.True(t, queue.Empty())
+	assert.False(t, handle.Queued())
+}
+
+func TestIterator(t *testing.T) {
+
+	queue := New(lessThan, 4, 2, 3, 1)
+	elements := make([]int, 0)
+	it := queue.Iterator()
+	for it.HasNext() {
+		elements = append(elements, it.Next())
+	}
+	assert.ElementsMatch(t, []int{1, 2, 3, 4}, elements)
+	assert.Equal(t, elements, queue.ToSlice())
+	assert.Equal(t, elements, slices.Collect(queue.All()))
+	assert.Equal(t, "[1 2 3 4]", queue.String())
+	assert.PanicsWithError(t, errors.NoSuchElement().Error(), func() { it.Next() })
+
+	it = queue.Iterator()
+	it.Next()
+	queue.Add(5)
+	assert.PanicsWithError(t, errors.ConcurrentModification("PriorityQueue").Error(), func() { it.Next() })
+	assert.PanicsWithError(t, errors.ConcurrentModification("PriorityQueue").Error(), func() {
+		for range queue.All() {
+			queue.RemoveFirst()
+		}
+	})
+}
+
+func TestDrain(t *testing.T) {
+
+	queue := New(lessThan, 3, 1, 2)
+	for e := range queue.Drain() {
+		if e == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{3}, queue.ToSlice())
+}
@@ -4,6 +4,7 @@ package vectordequeue
 
 import (
 	"fmt"
+	"iter"
 
 	"github.com/phantom820/collections"
 	"github.com/phantom820/collections/errors"
@@ -14,10 +15,11 @@ import (
 
 // VectorDequeue
 type VectorDequeue[T comparable] struct {
-	data   []T
-	head   int
-	offset int
-	len    int
+	data     []T
+	head     int
+	offset   int
+	len      int
+	modCount int // The number of structural modifications, used to detect modifications during iteration.
 }
 
 const (
@@ -41,6 +43,7 @@ func New[T comparable](elements ...T) *VectorDequeue[T] {
 
 // Add appends the specified element to the end of the list.
 func (dequeue *VectorDequeue[T]) Add(e T) bool {
+	dequeue.modCount++
 	if dequeue.Empty() {
 		dequeue.data = append(dequeue.data, e)
 		dequeue.head = 0
@@ -88,7 +91,9 @@ func (dequeue *VectorDequeue[T]) AddFirst(e T) optional.Optional[T] {
 	if dequeue.Empty() {
 		dequeue.Add(e)
 		return optional.Empty[T]()
-	} else if dequeue.head == 0 {
+	}
+	dequeue.modCount++
+	if dequeue.head == 0 {
 		dequeue.expandFront()
 		first := dequeue.data[dequeue.head]
 		dequeue.head--
@@ -108,8 +113,11 @@ func (dequeue *VectorDequeue[T]) RemoveFirst() optional.Optional[T] {
 	defer dequeue.shrink()
 	if dequeue.Empty() {
 		return optional.Empty[T]()
-	} else if dequeue.len == 1 {
+	}
+	dequeue.modCount++
+	if dequeue.len == 1 {
 		temp := dequeue.data[dequeue.head]
+		dequeue.data = dequeue.data[:0]
 		dequeue.head = -1
 		dequeue.len = 0
 		return optional.Of(temp)
@@ -140,8 +148,11 @@ func (dequeue *VectorDequeue[T]) RemoveLast() optional.Optional[T] {
 	defer dequeue.shrink()
 	if dequeue.Empty() {
 		return optional.Empty[T]()
-	} else if dequeue.len == 1 {
+	}
+	dequeue.modCount++
+	if dequeue.len == 1 {
 		temp := dequeue.data[len(dequeue.data)-1]
+		dequeue.data = dequeue.data[:0]
 		dequeue.len = 0
 		dequeue.head = -1
 		return optional.Of(temp)
@@ -187,8 +198,8 @@ func (dequeue *VectorDequeue[T]) Contains(e T) bool {
 	if dequeue.Empty() {
 		return false
 	}
-	for i := range dequeue.data[dequeue.head:] {
-		if dequeue.data[i] == e {
+	for _, element := range dequeue.data[dequeue.head:] {
+		if element == e {
 			return true
 		}
 	}
@@ -201,6 +212,7 @@ func (dequeue *VectorDequeue[T]) Clear() {
 	dequeue.data = make([]T, 0, default_capacity)
 	dequeue.offset = default_offset
 	dequeue.len = 0
+	dequeue.modCount++
 }
 
 // Empty returns true if the dequeue contains no elements.
@@ -283,15 +295,35 @@ func (dequeue *VectorDequeue[T]) Equals(other collections.Queue[T]) bool {
 
 }
 
-// Iterator returns an iterator over the elements in the dequeue.
+// Iterator returns an iterator over the elements in the dequeue. The iterator is fail fast, Next panics if the dequeue is structurally
+// modified after iteration has started.
 func (dequeue *VectorDequeue[T]) Iterator() iterator.Iterator[T] {
-	return &dequeueIterator[T]{initialized: false, initialize: func() []T { return dequeue.ToSlice() }, index: 0, data: nil}
+	return &dequeueIterator[T]{initialized: false, dequeue: dequeue, index: 0, data: nil}
+}
+
+// All returns a sequence over the elements in the dequeue from front to back. The sequence panics if the dequeue is structurally
+// modified while ranging over it.
+func (dequeue *VectorDequeue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if dequeue.Empty() {
+			return
+		}
+		modCount := dequeue.modCount
+		for _, e := range dequeue.data[dequeue.head:] {
+			if !yield(e) {
+				return
+			} else if modCount != dequeue.modCount {
+				panic(errors.ConcurrentModification("VectorDequeue"))
+			}
+		}
+	}
 }
 
-// iterator implememantation for [Vector].
+// iterator implememantation for [VectorDequeue].
 type dequeueIterator[T comparable] struct {
 	initialized bool
-	initialize  func() []T
+	dequeue     *VectorDequeue[T]
+	modCount    int // The expected modification count of the dequeue.
 	index       int
 	data        []T
 }
@@ -299,7 +331,8 @@ type dequeueIterator[T comparable] struct {
 // HasNext returns true if the iterator has more elements.
 func (it *dequeueIterator[T]) HasNext() bool {
 	if !it.initialized {
-		it.data = it.initialize()
+		it.data = it.dequeue.ToSlice()
+		it.modCount = it.dequeue.modCount
 		it.initialized = true
 	} else if it.data == nil {
 		return false
@@ -311,6 +344,8 @@ func (it *dequeueIterator[T]) HasNext() bool {
 func (it *dequeueIterator[T]) Next() T {
 	if !it.HasNext() {
 		panic(errors.NoSuchElement())
+	} else if it.modCount != it.dequeue.modCount {
+		panic(errors.ConcurrentModification("VectorDequeue"))
 	}
 	index := it.index
 	it.index++
@@ -1,6 +1,8 @@
 package vectordequeue
 
 import (
+	"github.com/phantom820/collections/errors"
+	"slices"
 	"testing"
 
 	"github.com/phantom820/collections/iterator"
@@ -364,3 +366,91 @@ func TestEquals(t *testing.T) {
 	}
 
 }
+
+func TestAll(t *testing.T) {
+
+	type allTest struct {
+		input    *VectorDequeue[int]
+		expected []int
+	}
+
+	allTests := []allTest{
+		{
+			input:    New[int](),
+			expected: nil,
+		},
+		{
+			input:    New(1, 2, 3, 4),
+			expected: []int{1, 2, 3, 4},
+		},
+	}
+
+	for _, test := range allTests {
+		assert.Equal(t, test.expected, slices.Collect(test.input.All()))
+	}
+
+	// breaking out of the loop early stops the sequence.
+	data := make([]int, 0)
+	for e := range New(1, 2, 3, 4).All() {
+		if e == 3 {
+			break
+		}
+		data = append(data, e)
+	}
+	assert.Equal(t, []int{1, 2}, data)
+}
+
+func TestConcurrentModification(t *testing.T) {
+
+	type concurrentModificationTest struct {
+		modify func(dequeue *VectorDequeue[int])
+	}
+
+	concurrentModificationTests := []concurrentModificationTest{
+		{modify: func(dequeue *VectorDequeue[int]) { dequeue.Add(4) }},
+		{modify: func(dequeue *VectorDequeue[int]) { dequeue.AddFirst(4) }},
+		{modify: func(dequeue *VectorDequeue[int]) { dequeue.RemoveFirst() }},
+		{modify: func(dequeue *VectorDequeue[int]) { dequeue.RemoveLast() }},
+		{modify: func(dequeue *VectorDequeue[int]) { dequeue.Clear() }},
+	}
+
+	for _, test := range concurrentModificationTests {
+		dequeue := New(1, 2, 3)
+		it := dequeue.Iterator()
+		it.Next()
+		test.modify(dequeue)
+		assert.PanicsWithError(t, errors.ConcurrentModification("VectorDequeue").Error(), func() { it.Next() })
+		assert.PanicsWithError(t, errors.ConcurrentModification("VectorDequeue").Error(), func() {
+			dequeue := New(1, 2, 3)
+			for range dequeue.All() {
+				test.modify(dequeue)
+			}
+		})
+	}
+}
+
+func TestReuse(t *testing.T) {
+
+	// A dequeue emptied from either end must not expose stale elements once elements are added again.
+	dequeue := New(1, 2)
+	dequeue.RemoveFirst()
+	dequeue.RemoveFirst()
+	dequeue.Add(3)
+	assert.Equal(t, []int{3}, dequeue.ToSlice())
+	assert.Equal(t, optional.Of(3), dequeue.PeekFirst())
+
+	dequeue = New(1, 2, 3)
+	dequeue.AddFirst(0)
+	for !dequeue.Empty() {
+		dequeue.RemoveLast()
+	}
+	dequeue.AddLast(5)
+	assert.Equal(t, []int{5}, dequeue.ToSlice())
+	assert.Equal(t, optional.Of(5), dequeue.PeekFirst())
+
+	// Contains only considers the elements after the front of the dequeue.
+	dequeue = New(1, 2, 3)
+	dequeue.RemoveFirst()
+	assert.False(t, dequeue.Contains(1))
+	assert.True(t, dequeue.Contains(3))
+}
@@ -0,0 +1,278 @@
+// package ranges defines collections of intervals over an ordered type. Elements are compared using a lessThan function given at
+// creation, the same way a [treemap.TreeMap] compares its keys, and the intervals are kept in a [rbt.RedBlackTree] ordered by their lower
+// bounds so that the interval containing a point is found in O(log n).
+//
+//  1. RangeSet[T] : A set of points described by disjoint ranges, ranges that overlap or are adjacent are coalesced into one.
+//  2. RangeMap[T, V] : A map from disjoint ranges to values, a range is split when part of it is mapped to another value.
+package ranges
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/phantom820/collections/trees/rbt"
+)
+
+// Range an interval over an ordered type with a lower and an upper bound, each bound is either closed (inclusive), open (exclusive) or
+// unbounded. A range does not know how its elements are ordered, the collections of this package check its bounds with their own
+// lessThan function.
+type Range[T comparable] struct {
+	lower rbt.Bound[T]
+	upper rbt.Bound[T]
+}
+
+// Of creates a range with the given bounds.
+func Of[T comparable](lower rbt.Bound[T], upper rbt.Bound[T]) Range[T] {
+	return Range[T]{lower: lower, upper: upper}
+}
+
+// Closed creates the range [lower..upper] of the points that are both greater than or equal to lower and less than or equal to upper.
+func Closed[T comparable](lower T, upper T) Range[T] {
+	return Of(rbt.InclusiveBound(lower), rbt.InclusiveBound(upper))
+}
+
+// Open creates the range (lower..upper) of the points that are both greater than lower and less than upper.
+func Open[T comparable](lower T, upper T) Range[T] {
+	return Of(rbt.ExclusiveBound(lower), rbt.ExclusiveBound(upper))
+}
+
+// ClosedOpen creates the range [lower..upper) of the points that are both greater than or equal to lower and less than upper.
+func ClosedOpen[T comparable](lower T, upper T) Range[T] {
+	return Of(rbt.InclusiveBound(lower), rbt.ExclusiveBound(upper))
+}
+
+// OpenClosed creates the range (lower..upper] of the points that are both greater than lower and less than or equal to upper.
+func OpenClosed[T comparable](lower T, upper T) Range[T] {
+	return Of(rbt.ExclusiveBound(lower), rbt.InclusiveBound(upper))
+}
+
+// AtLeast creates the range [lower..+∞) of the points that are greater than or equal to lower.
+func AtLeast[T comparable](lower T) Range[T] {
+	return Of(rbt.InclusiveBound(lower), rbt.Unbounded[T]())
+}
+
+// GreaterThan creates the range (lower..+∞) of the points that are greater than lower.
+func GreaterThan[T comparable](lower T) Range[T] {
+	return Of(rbt.ExclusiveBound(lower), rbt.Unbounded[T]())
+}
+
+// AtMost creates the range (-∞..upper] of the points that are less than or equal to upper.
+func AtMost[T comparable](upper T) Range[T] {
+	return Of(rbt.Unbounded[T](), rbt.InclusiveBound(upper))
+}
+
+// LessThan creates the range (-∞..upper) of the points that are less than upper.
+func LessThan[T comparable](upper T) Range[T] {
+	return Of(rbt.Unbounded[T](), rbt.ExclusiveBound(upper))
+}
+
+// All creates the range (-∞..+∞) of all the points.
+func All[T comparable]() Range[T] {
+	return Of(rbt.Unbounded[T](), rbt.Unbounded[T]())
+}
+
+// Lower returns the lower bound of the range.
+func (r Range[T]) Lower() rbt.Bound[T] {
+	return r.lower
+}
+
+// Upper returns the upper bound of the range.
+func (r Range[T]) Upper() rbt.Bound[T] {
+	return r.upper
+}
+
+// String returns the string representation of the range, i.e [1..5) or (-∞..5].
+func (r Range[T]) String() string {
+	var sb strings.Builder
+	if !r.lower.Bounded() {
+		sb.WriteString("(-∞")
+	} else if r.lower.Inclusive() {
+		sb.WriteString(fmt.Sprintf("[%v", r.lower.Key()))
+	} else {
+		sb.WriteString(fmt.Sprintf("(%v", r.lower.Key()))
+	}
+	sb.WriteString("..")
+	if !r.upper.Bounded() {
+		sb.WriteString("+∞)")
+	} else if r.upper.Inclusive() {
+		sb.WriteString(fmt.Sprintf("%v]", r.upper.Key()))
+	} else {
+		sb.WriteString(fmt.Sprintf("%v)", r.upper.Key()))
+	}
+	return sb.String()
+}
+
+// order compares bounds and ranges using the lessThan function of a range collection.
+type order[T comparable] struct {
+	lessThan func(e1, e2 T) bool
+}
+
+// compareLower compares two lower bounds, returning a negative number if a admits points that b does not, 0 if they are equal and a
+// positive number otherwise. An unbounded lower bound is the least and a closed bound is less than an open bound at the same point.
+func (order order[T]) compareLower(a, b rbt.Bound[T]) int {
+	if !a.Bounded() || !b.Bounded() {
+		return order.compareUnbounded(a, b)
+	} else if order.lessThan(a.Key(), b.Key()) {
+		return -1
+	} else if order.lessThan(b.Key(), a.Key()) {
+		return 1
+	} else if a.Inclusive() == b.Inclusive() {
+		return 0
+	} else if a.Inclusive() {
+		return -1
+	}
+	return 1
+}
+
+// compareUpper compares two upper bounds, returning a negative number if b admits points that a does not, 0 if they are equal and a
+// positive number otherwise. An unbounded upper bound is the greatest and a closed bound is greater than an open bound at the same point.
+func (order order[T]) compareUpper(a, b rbt.Bound[T]) int {
+	if !a.Bounded() || !b.Bounded() {
+		return -order.compareUnbounded(a, b)
+	} else if order.lessThan(a.Key(), b.Key()) {
+		return -1
+	} else if order.lessThan(b.Key(), a.Key()) {
+		return 1
+	} else if a.Inclusive() == b.Inclusive() {
+		return 0
+	} else if a.Inclusive() {
+		return 1
+	}
+	return -1
+}
+
+// compareUnbounded compares two bounds of which at least one is unbounded, the unbounded bound comes first.
+func (order order[T]) compareUnbounded(a, b rbt.Bound[T]) int {
+	if a.Bounded() == b.Bounded() {
+		return 0
+	} else if !a.Bounded() {
+		return -1
+	}
+	return 1
+}
+
+// equal returns true if neither point is less than the other, distinct points can be equal under the ordering.
+func (order order[T]) equal(a, b T) bool {
+	return !order.lessThan(a, b) && !order.lessThan(b, a)
+}
+
+// lowerLessThan orders lower bounds, this is the ordering of the trees that hold ranges by their lower bounds.
+func (order order[T]) lowerLessThan(a, b rbt.Bound[T]) bool {
+	return order.compareLower(a, b) < 0
+}
+
+// inverted returns true if the upper bound of the range is below its lower bound.
+func (order order[T]) inverted(r Range[T]) bool {
+	return r.lower.Bounded() && r.upper.Bounded() && order.lessThan(r.upper.Key(), r.lower.Key())
+}
+
+// empty returns true if no point is in the range, either the range is inverted or it is [a..a), (a..a] or (a..a).
+func (order order[T]) empty(r Range[T]) bool {
+	if !r.lower.Bounded() || !r.upper.Bounded() {
+		return false
+	} else if order.inverted(r) {
+		return true
+	}
+	return order.equal(r.lower.Key(), r.upper.Key()) && !(r.lower.Inclusive() && r.upper.Inclusive())
+}
+
+// contains returns true if the point is in the range.
+func (order order[T]) contains(r Range[T], e T) bool {
+	return order.compareLower(r.lower, rbt.InclusiveBound(e)) <= 0 && order.compareUpper(r.upper, rbt.InclusiveBound(e)) >= 0
+}
+
+// encloses returns true if every point of b is in a.
+func (order order[T]) encloses(a, b Range[T]) bool {
+	return order.compareLower(a.lower, b.lower) <= 0 && order.compareUpper(a.upper, b.upper) >= 0
+}
+
+// intersection returns the range of the points that are in both ranges, the result is empty if the ranges do not overlap.
+func (order order[T]) intersection(a, b Range[T]) Range[T] {
+	lower, upper := a.lower, a.upper
+	if order.compareLower(b.lower, lower) > 0 {
+		lower = b.lower
+	}
+	if order.compareUpper(b.upper, upper) < 0 {
+		upper = b.upper
+	}
+	return Range[T]{lower: lower, upper: upper}
+}
+
+// span returns the smallest range that encloses both ranges.
+func (order order[T]) span(a, b Range[T]) Range[T] {
+	lower, upper := a.lower, a.upper
+	if order.compareLower(b.lower, lower) < 0 {
+		lower = b.lower
+	}
+	if order.compareUpper(b.upper, upper) > 0 {
+		upper = b.upper
+	}
+	return Range[T]{lower: lower, upper: upper}
+}
+
+// overlaps returns true if some point is in both ranges.
+func (order order[T]) overlaps(a, b Range[T]) bool {
+	return !order.empty(order.intersection(a, b))
+}
+
+// gap returns true if there is a point between the end of a and the start of b, i.e [1..2) and (2..3] have a gap but [1..2) and [2..3]
+// do not.
+func (order order[T]) gap(a, b Range[T]) bool {
+	if !a.upper.Bounded() || !b.lower.Bounded() {
+		return false
+	} else if order.lessThan(a.upper.Key(), b.lower.Key()) {
+		return true
+	}
+	return order.equal(a.upper.Key(), b.lower.Key()) && !a.upper.Inclusive() && !b.lower.Inclusive()
+}
+
+// connected returns true if the union of the ranges is a range, i.e the ranges overlap or are adjacent.
+func (order order[T]) connected(a, b Range[T]) bool {
+	return !order.gap(a, b) && !order.gap(b, a)
+}
+
+// below returns the part of range a that is below range b, the bool is false if there is no such part.
+func (order order[T]) below(a, b Range[T]) (Range[T], bool) {
+	if !b.lower.Bounded() {
+		return Range[T]{}, false
+	}
+	r := order.intersection(a, Range[T]{lower: rbt.Unbounded[T](), upper: complement(b.lower)})
+	return r, !order.empty(r)
+}
+
+// above returns the part of range a that is above range b, the bool is false if there is no such part.
+func (order order[T]) above(a, b Range[T]) (Range[T], bool) {
+	if !b.upper.Bounded() {
+		return Range[T]{}, false
+	}
+	r := order.intersection(a, Range[T]{lower: complement(b.upper), upper: rbt.Unbounded[T]()})
+	return r, !order.empty(r)
+}
+
+// complement returns the bound at the same point that admits exactly the points the given bounded bound leaves out, i.e the upper
+// bound 5) for the lower bound [5.
+func complement[T comparable](bound rbt.Bound[T]) rbt.Bound[T] {
+	if bound.Inclusive() {
+		return rbt.ExclusiveBound(bound.Key())
+	}
+	return rbt.InclusiveBound(bound.Key())
+}
+
+// search returns the elements of the tree whose ranges match the given range, the tree holds elements by the lower bounds of their
+// ranges and the ranges are disjoint. Only the element before the range and the elements that start within it are visited.
+func search[T comparable, E any](order order[T], tree *rbt.RedBlackTree[rbt.Bound[T], E], rangeOf func(E) Range[T], r Range[T],
+	match func(a, b Range[T]) bool) []E {
+	start := r.lower
+	if floor := tree.Floor(r.lower); !floor.Empty() {
+		start = floor.Value().Key()
+	}
+	elements := make([]E, 0)
+	for entry := range tree.Range(rbt.InclusiveBound(start), rbt.Unbounded[rbt.Bound[T]](), false) {
+		if s := rangeOf(entry.Value()); match(s, r) {
+			elements = append(elements, entry.Value())
+		} else if order.compareLower(s.lower, r.lower) > 0 {
+			break
+		}
+	}
+	return elements
+}
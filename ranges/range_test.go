@@ -0,0 +1,154 @@
+package ranges
+
+import (
+	"testing"
+
+	"github.com/phantom820/collections/trees/rbt"
+	"github.com/stretchr/testify/assert"
+)
+
+func lessThan(a, b int) bool {
+	return a < b
+}
+
+var intOrder = order[int]{lessThan: lessThan}
+
+func TestString(t *testing.T) {
+
+	type stringTest struct {
+		input    Range[int]
+		expected string
+	}
+
+	stringTests := []stringTest{
+		{input: Closed(1, 5), expected: "[1..5]"},
+		{input: Open(1, 5), expected: "(1..5)"},
+		{input: ClosedOpen(1, 5), expected: "[1..5)"},
+		{input: OpenClosed(1, 5), expected: "(1..5]"},
+		{input: AtLeast(1), expected: "[1..+∞)"},
+		{input: GreaterThan(1), expected: "(1..+∞)"},
+		{input: AtMost(5), expected: "(-∞..5]"},
+		{input: LessThan(5), expected: "(-∞..5)"},
+		{input: All[int](), expected: "(-∞..+∞)"},
+		{input: Of(rbt.ExclusiveBound(1), rbt.Unbounded[int]()), expected: "(1..+∞)"},
+	}
+
+	for _, test := range stringTests {
+		assert.Equal(t, test.expected, test.input.String())
+	}
+	assert.Equal(t, rbt.InclusiveBound(1), Closed(1, 5).Lower())
+	assert.Equal(t, rbt.ExclusiveBound(5), ClosedOpen(1, 5).Upper())
+}
+
+func TestContains(t *testing.T) {
+
+	type containsTest struct {
+		input    Range[int]
+		point    int
+		expected bool
+	}
+
+	containsTests := []containsTest{
+		{input: Closed(1, 5), point: 1, expected: true},
+		{input: Closed(1, 5), point: 5, expected: true},
+		{input: Open(1, 5), point: 1, expected: false},
+		{input: Open(1, 5), point: 5, expected: false},
+		{input: Open(1, 5), point: 3, expected: true},
+		{input: Closed(1, 5), point: 0, expected: false},
+		{input: Closed(1, 5), point: 6, expected: false},
+		{input: AtLeast(1), point: 100, expected: true},
+		{input: LessThan(1), point: -100, expected: true},
+		{input: LessThan(1), point: 1, expected: false},
+		{input: All[int](), point: 0, expected: true},
+	}
+
+	for _, test := range containsTests {
+		assert.Equal(t, test.expected, intOrder.contains(test.input, test.point), test.input.String())
+	}
+}
+
+func TestEmpty(t *testing.T) {
+
+	assert.False(t, intOrder.empty(Closed(1, 1)))
+	assert.True(t, intOrder.empty(ClosedOpen(1, 1)))
+	assert.True(t, intOrder.empty(OpenClosed(1, 1)))
+	assert.True(t, intOrder.empty(Open(1, 1)))
+	assert.True(t, intOrder.empty(Closed(2, 1)))
+	assert.True(t, intOrder.inverted(Closed(2, 1)))
+	assert.False(t, intOrder.inverted(Open(1, 1)))
+	assert.False(t, intOrder.empty(AtMost(1)))
+	assert.False(t, intOrder.empty(All[int]()))
+}
+
+func TestEqualUnderOrdering(t *testing.T) {
+
+	type equalUnderOrderingTest struct {
+		a, b      Range[int]
+		empty     bool
+		connected bool
+	}
+
+	// Points in the same ten are equal under the ordering even though they are distinct.
+	tensOrder := order[int]{lessThan: func(a, b int) bool { return a/10 < b/10 }}
+	equalUnderOrderingTests := []equalUnderOrderingTest{
+		{a: ClosedOpen(1, 5), b: Closed(20, 30), empty: true, connected: false},
+		{a: OpenClosed(10, 15), b: Closed(10, 15), empty: true, connected: true},
+		{a: Closed(1, 5), b: Closed(5, 9), empty: false, connected: true},
+		{a: ClosedOpen(0, 12), b: Open(15, 20), empty: false, connected: false},
+		{a: ClosedOpen(0, 12), b: Closed(15, 20), empty: false, connected: true},
+	}
+
+	for _, test := range equalUnderOrderingTests {
+		assert.Equal(t, test.empty, tensOrder.empty(test.a), test.a.String())
+		assert.Equal(t, test.connected, tensOrder.connected(test.a, test.b))
+		assert.Equal(t, test.connected, tensOrder.connected(test.b, test.a))
+	}
+}
+
+func TestConnected(t *testing.T) {
+
+	type connectedTest struct {
+		a, b      Range[int]
+		connected bool
+		overlaps  bool
+	}
+
+	connectedTests := []connectedTest{
+		{a: Closed(1, 3), b: Closed(2, 4), connected: true, overlaps: true},
+		{a: ClosedOpen(1, 3), b: Closed(3, 4), connected: true, overlaps: false},
+		{a: Closed(1, 3), b: Open(3, 4), connected: true, overlaps: false},
+		{a: ClosedOpen(1, 3), b: Open(3, 4), connected: false, overlaps: false},
+		{a: Closed(1, 3), b: Closed(5, 6), connected: false, overlaps: false},
+		{a: AtMost(3), b: AtLeast(3), connected: true, overlaps: true},
+		{a: All[int](), b: Closed(5, 6), connected: true, overlaps: true},
+	}
+
+	for _, test := range connectedTests {
+		assert.Equal(t, test.connected, intOrder.connected(test.a, test.b))
+		assert.Equal(t, test.connected, intOrder.connected(test.b, test.a))
+		assert.Equal(t, test.overlaps, intOrder.overlaps(test.a, test.b))
+		assert.Equal(t, test.overlaps, intOrder.overlaps(test.b, test.a))
+	}
+}
+
+func TestIntersectionAndSpan(t *testing.T) {
+
+	assert.Equal(t, ClosedOpen(2, 3), intOrder.intersection(ClosedOpen(1, 3), Closed(2, 4)))
+	assert.Equal(t, Closed(1, 4), intOrder.span(ClosedOpen(1, 3), Closed(2, 4)))
+	assert.Equal(t, Closed(5, 6), intOrder.intersection(All[int](), Closed(5, 6)))
+	assert.Equal(t, AtLeast(1), intOrder.span(Closed(1, 2), GreaterThan(1)))
+	assert.True(t, intOrder.encloses(Closed(1, 5), Open(1, 5)))
+	assert.False(t, intOrder.encloses(Open(1, 5), Closed(1, 5)))
+	assert.True(t, intOrder.encloses(All[int](), AtMost(5)))
+
+	below, ok := intOrder.below(Closed(1, 5), Closed(3, 4))
+	assert.True(t, ok)
+	assert.Equal(t, ClosedOpen(1, 3), below)
+	above, ok := intOrder.above(Closed(1, 5), Closed(3, 4))
+	assert.True(t, ok)
+	assert.Equal(t, OpenClosed(4, 5), above)
+	_, ok = intOrder.below(Closed(1, 5), AtMost(4))
+	assert.False(t, ok)
+	_, ok = intOrder.above(Closed(1, 5), Closed(3, 5))
+	assert.False(t, ok)
+}
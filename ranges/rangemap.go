@@ -0,0 +1,188 @@
+package ranges
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/trees/rbt"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// RangeMap a map from disjoint ranges to values. Putting a range overwrites the values of the points in the range, a mapped range that
+// is only partly covered keeps its value for the points outside of the new range. Adjacent ranges are not coalesced, even if they are
+// mapped to the same value.
+type RangeMap[T comparable, V any] struct {
+	order order[T]
+	tree  *rbt.RedBlackTree[rbt.Bound[T], pair.Pair[Range[T], V]] // The ranges and their values keyed by the lower bounds of the ranges.
+}
+
+// NewRangeMap creates an empty range map. Points are compared using the lessThan function which should satisfy.
+// e1 < e2 => lessThan(e1, e2) = true and lessThan(e2, e1) = false.
+// e1 = e2 => lessThan(e1, e2) = false and lessThan(e2, e1) = false.
+func NewRangeMap[T comparable, V any](lessThan func(e1, e2 T) bool) *RangeMap[T, V] {
+	order := order[T]{lessThan: lessThan}
+	return &RangeMap[T, V]{order: order, tree: rbt.New[rbt.Bound[T], pair.Pair[Range[T], V]](order.lowerLessThan)}
+}
+
+// search returns the entries of the map whose ranges match the given range.
+func (rangeMap *RangeMap[T, V]) search(r Range[T], match func(a, b Range[T]) bool) []pair.Pair[Range[T], V] {
+	return search(rangeMap.order, rangeMap.tree, pair.Pair[Range[T], V].Key, r, match)
+}
+
+// insert adds the entry for the range to the tree.
+func (rangeMap *RangeMap[T, V]) insert(r Range[T], v V) {
+	rangeMap.tree.Insert(r.lower, pair.Of(r, v))
+}
+
+// Put maps the points of the range to the value. Panics if the upper bound of the range is below its lower bound.
+func (rangeMap *RangeMap[T, V]) Put(r Range[T], v V) {
+	if rangeMap.order.inverted(r) {
+		panic(errors.IllegalArgument("range", r))
+	} else if rangeMap.order.empty(r) {
+		return
+	}
+	rangeMap.Remove(r)
+	rangeMap.insert(r, v)
+}
+
+// Remove removes the mappings of the points of the range and returns true if the map changed. A mapped range that the range splits is
+// replaced by the parts on either side of it, which keep their value. Panics if the upper bound of the range is below its lower bound.
+func (rangeMap *RangeMap[T, V]) Remove(r Range[T]) bool {
+	if rangeMap.order.inverted(r) {
+		panic(errors.IllegalArgument("range", r))
+	}
+	overlapping := rangeMap.search(r, rangeMap.order.overlaps)
+	for _, entry := range overlapping {
+		s := entry.Key()
+		rangeMap.tree.Delete(s.lower)
+		if below, ok := rangeMap.order.below(s, r); ok {
+			rangeMap.insert(below, entry.Value())
+		}
+		if above, ok := rangeMap.order.above(s, r); ok {
+			rangeMap.insert(above, entry.Value())
+		}
+	}
+	return len(overlapping) > 0
+}
+
+// floor returns the entry of the map whose range has the greatest lower bound that is at or before the given lower bound as an option.
+func (rangeMap *RangeMap[T, V]) floor(lower rbt.Bound[T]) optional.Optional[pair.Pair[Range[T], V]] {
+	if floor := rangeMap.tree.Floor(lower); !floor.Empty() {
+		return optional.Of(floor.Value().Value())
+	}
+	return optional.Empty[pair.Pair[Range[T], V]]()
+}
+
+// Get optionally returns the value that the point is mapped to.
+func (rangeMap *RangeMap[T, V]) Get(e T) optional.Optional[V] {
+	if entry := rangeMap.GetEntry(e); !entry.Empty() {
+		return optional.Of(entry.Value().Value())
+	}
+	return optional.Empty[V]()
+}
+
+// GetEntry returns the range that contains the point paired with its value as an option.
+func (rangeMap *RangeMap[T, V]) GetEntry(e T) optional.Optional[pair.Pair[Range[T], V]] {
+	if floor := rangeMap.floor(rbt.InclusiveBound(e)); !floor.Empty() && rangeMap.order.contains(floor.Value().Key(), e) {
+		return floor
+	}
+	return optional.Empty[pair.Pair[Range[T], V]]()
+}
+
+// Contains returns true if the point is in a mapped range.
+func (rangeMap *RangeMap[T, V]) Contains(e T) bool {
+	return !rangeMap.GetEntry(e).Empty()
+}
+
+// Encloses returns true if every point of the range is in a single mapped range, so all the points are mapped to the same value.
+func (rangeMap *RangeMap[T, V]) Encloses(r Range[T]) bool {
+	floor := rangeMap.floor(r.lower)
+	return !floor.Empty() && rangeMap.order.encloses(floor.Value().Key(), r)
+}
+
+// Span returns the smallest range that encloses every mapped range as an option, the option is empty if the map is empty.
+func (rangeMap *RangeMap[T, V]) Span() optional.Optional[Range[T]] {
+	if rangeMap.tree.Empty() {
+		return optional.Empty[Range[T]]()
+	}
+	first, last := rangeMap.tree.First().Value().Value().Key(), rangeMap.tree.Last().Value().Value().Key()
+	return optional.Of(Range[T]{lower: first.lower, upper: last.upper})
+}
+
+// RangeSet returns a new range set with the points that are mapped, adjacent mapped ranges are coalesced.
+func (rangeMap *RangeMap[T, V]) RangeSet() *RangeSet[T] {
+	rangeSet := NewRangeSet(rangeMap.order.lessThan)
+	for r := range rangeMap.All() {
+		rangeSet.Add(r)
+	}
+	return rangeSet
+}
+
+// Complement returns a new range set with the points that are not mapped.
+func (rangeMap *RangeMap[T, V]) Complement() *RangeSet[T] {
+	return rangeMap.RangeSet().Complement()
+}
+
+// SubRangeMap returns a new range map with the mappings of the points that are in the given range, mapped ranges are cut down to the
+// given range.
+func (rangeMap *RangeMap[T, V]) SubRangeMap(r Range[T]) *RangeMap[T, V] {
+	subRangeMap := NewRangeMap[T, V](rangeMap.order.lessThan)
+	for _, entry := range rangeMap.search(r, rangeMap.order.overlaps) {
+		subRangeMap.insert(rangeMap.order.intersection(entry.Key(), r), entry.Value())
+	}
+	return subRangeMap
+}
+
+// Ranges returns a slice containing the mapped ranges in ascending order.
+func (rangeMap *RangeMap[T, V]) Ranges() []Range[T] {
+	ranges := make([]Range[T], 0, rangeMap.tree.Len())
+	for r := range rangeMap.All() {
+		ranges = append(ranges, r)
+	}
+	return ranges
+}
+
+// All returns a sequence over the mapped ranges and their values in ascending order of the ranges.
+func (rangeMap *RangeMap[T, V]) All() iter.Seq2[Range[T], V] {
+	return func(yield func(Range[T], V) bool) {
+		for entry := range rangeMap.tree.Range(rbt.Unbounded[rbt.Bound[T]](), rbt.Unbounded[rbt.Bound[T]](), false) {
+			if !yield(entry.Value().Key(), entry.Value().Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of mapped ranges.
+func (rangeMap *RangeMap[T, V]) Len() int {
+	return rangeMap.tree.Len()
+}
+
+// Empty returns true if the map has no mappings.
+func (rangeMap *RangeMap[T, V]) Empty() bool {
+	return rangeMap.tree.Empty()
+}
+
+// Clear removes all the mappings from the map.
+func (rangeMap *RangeMap[T, V]) Clear() {
+	rangeMap.tree.Clear()
+}
+
+// String returns the string representation of the map, i.e {[1..3)=a, (5..+∞)=b}.
+func (rangeMap *RangeMap[T, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for r, v := range rangeMap.All() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", r, v))
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
@@ -0,0 +1,161 @@
+package ranges
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeMapPut(t *testing.T) {
+
+	type putTest struct {
+		input    []pair.Pair[Range[int], string]
+		expected string
+	}
+
+	putTests := []putTest{
+		{input: []pair.Pair[Range[int], string]{}, expected: "{}"},
+		{input: []pair.Pair[Range[int], string]{pair.Of(ClosedOpen(1, 1), "a")}, expected: "{}"},
+		{input: []pair.Pair[Range[int], string]{pair.Of(Closed(5, 6), "b"), pair.Of(Closed(1, 2), "a")}, expected: "{[1..2]=a, [5..6]=b}"},
+		{input: []pair.Pair[Range[int], string]{pair.Of(Closed(1, 10), "a"), pair.Of(Closed(3, 4), "b")}, expected: "{[1..3)=a, [3..4]=b, (4..10]=a}"},
+		{input: []pair.Pair[Range[int], string]{pair.Of(Closed(1, 5), "a"), pair.Of(Closed(3, 8), "b")}, expected: "{[1..3)=a, [3..8]=b}"},
+		{input: []pair.Pair[Range[int], string]{pair.Of(Closed(1, 2), "a"), pair.Of(Closed(4, 5), "b"), pair.Of(All[int](), "c")}, expected: "{(-∞..+∞)=c}"},
+		{input: []pair.Pair[Range[int], string]{pair.Of(ClosedOpen(1, 3), "a"), pair.Of(Closed(3, 5), "a")}, expected: "{[1..3)=a, [3..5]=a}"},
+		{input: []pair.Pair[Range[int], string]{pair.Of(AtLeast(1), "a"), pair.Of(AtMost(2), "b")}, expected: "{(-∞..2]=b, (2..+∞)=a}"},
+	}
+
+	for _, test := range putTests {
+		rangeMap := NewRangeMap[int, string](lessThan)
+		for _, entry := range test.input {
+			rangeMap.Put(entry.Key(), entry.Value())
+		}
+		assert.Equal(t, test.expected, rangeMap.String())
+	}
+
+	rangeMap := NewRangeMap[int, string](lessThan)
+	assert.PanicsWithError(t, errors.IllegalArgument("range", Closed(2, 1)).Error(), func() { rangeMap.Put(Closed(2, 1), "a") })
+}
+
+func TestRangeMapRemove(t *testing.T) {
+
+	rangeMap := NewRangeMap[int, string](lessThan)
+	rangeMap.Put(Closed(1, 5), "a")
+	rangeMap.Put(Closed(8, 10), "b")
+	assert.False(t, rangeMap.Remove(Open(5, 8)))
+	assert.True(t, rangeMap.Remove(Closed(2, 9)))
+	assert.Equal(t, "{[1..2)=a, (9..10]=b}", rangeMap.String())
+	assert.True(t, rangeMap.Remove(All[int]()))
+	assert.True(t, rangeMap.Empty())
+	assert.PanicsWithError(t, errors.IllegalArgument("range", Closed(2, 1)).Error(), func() { rangeMap.Remove(Closed(2, 1)) })
+}
+
+func TestRangeMapGet(t *testing.T) {
+
+	rangeMap := NewRangeMap[int, string](lessThan)
+	rangeMap.Put(ClosedOpen(1, 3), "a")
+	rangeMap.Put(OpenClosed(3, 5), "b")
+	rangeMap.Put(AtLeast(10), "c")
+	assert.Equal(t, optional.Of("a"), rangeMap.Get(1))
+	assert.Equal(t, optional.Empty[string](), rangeMap.Get(3))
+	assert.Equal(t, optional.Of("b"), rangeMap.Get(5))
+	assert.Equal(t, optional.Empty[string](), rangeMap.Get(7))
+	assert.Equal(t, optional.Of("c"), rangeMap.Get(1000))
+	assert.Equal(t, optional.Of(pair.Of(OpenClosed(3, 5), "b")), rangeMap.GetEntry(4))
+	assert.Equal(t, optional.Empty[pair.Pair[Range[int], string]](), rangeMap.GetEntry(0))
+	assert.True(t, rangeMap.Contains(2))
+	assert.False(t, rangeMap.Contains(9))
+}
+
+func TestRangeMapEquivalentPoints(t *testing.T) {
+
+	// Points in the same ten are equal under the ordering even though they are distinct.
+	tensLessThan := func(a, b int) bool { return a/10 < b/10 }
+	rangeMap := NewRangeMap[int, string](tensLessThan)
+	rangeMap.Put(Closed(10, 25), "a")
+	rangeMap.Put(Closed(30, 30), "b")
+	assert.Equal(t, optional.Of("a"), rangeMap.Get(15))
+	assert.Equal(t, optional.Of("a"), rangeMap.Get(29))
+	assert.Equal(t, optional.Of(pair.Of(Closed(30, 30), "b")), rangeMap.GetEntry(35))
+	assert.Equal(t, optional.Empty[string](), rangeMap.Get(40))
+
+	// Putting a range with equivalent bounds replaces the mapping.
+	rangeMap.Put(Closed(15, 29), "c")
+	assert.Equal(t, "{[15..29]=c, [30..30]=b}", rangeMap.String())
+	assert.True(t, rangeMap.Remove(Closed(33, 33)))
+	assert.Equal(t, optional.Empty[string](), rangeMap.Get(30))
+	assert.Equal(t, "{[15..29]=c}", rangeMap.String())
+
+	caseInsensitive := func(a, b string) bool { return strings.ToLower(a) < strings.ToLower(b) }
+	letters := NewRangeMap[string, int](caseInsensitive)
+	letters.Put(Closed("a", "c"), 1)
+	assert.Equal(t, optional.Of(1), letters.Get("A"))
+	assert.Equal(t, optional.Of(1), letters.Get("C"))
+	assert.Equal(t, optional.Empty[int](), letters.Get("D"))
+}
+
+func TestRangeMapEnclosesAndSpan(t *testing.T) {
+
+	rangeMap := NewRangeMap[int, string](lessThan)
+	assert.False(t, rangeMap.Encloses(Closed(1, 2)))
+	assert.Equal(t, optional.Empty[Range[int]](), rangeMap.Span())
+
+	rangeMap.Put(ClosedOpen(1, 3), "a")
+	rangeMap.Put(Closed(3, 5), "b")
+	assert.True(t, rangeMap.Encloses(Closed(1, 2)))
+	assert.True(t, rangeMap.Encloses(Closed(3, 5)))
+	// The range is covered but by two entries with different values.
+	assert.False(t, rangeMap.Encloses(Closed(2, 4)))
+	assert.Equal(t, optional.Of(Closed(1, 5)), rangeMap.Span())
+}
+
+func TestRangeMapComplement(t *testing.T) {
+
+	rangeMap := NewRangeMap[int, string](lessThan)
+	assert.Equal(t, []Range[int]{All[int]()}, rangeMap.Complement().Ranges())
+
+	rangeMap.Put(ClosedOpen(1, 3), "a")
+	rangeMap.Put(Closed(3, 5), "b")
+	rangeMap.Put(AtLeast(10), "c")
+	assert.Equal(t, []Range[int]{Closed(1, 5), AtLeast(10)}, rangeMap.RangeSet().Ranges())
+	assert.Equal(t, []Range[int]{LessThan(1), Open(5, 10)}, rangeMap.Complement().Ranges())
+}
+
+func TestSubRangeMap(t *testing.T) {
+
+	rangeMap := NewRangeMap[int, string](lessThan)
+	rangeMap.Put(Closed(1, 3), "a")
+	rangeMap.Put(Closed(5, 7), "b")
+	rangeMap.Put(AtLeast(10), "c")
+	assert.Equal(t, "{[2..3]=a, [5..6]=b}", rangeMap.SubRangeMap(Closed(2, 6)).String())
+	assert.Equal(t, "{[10..12)=c}", rangeMap.SubRangeMap(Open(7, 12)).String())
+	assert.True(t, rangeMap.SubRangeMap(Open(3, 5)).Empty())
+
+	// The sub range map is a copy.
+	rangeMap.SubRangeMap(Closed(2, 6)).Put(Closed(20, 30), "d")
+	assert.Equal(t, 3, rangeMap.Len())
+}
+
+func TestRangeMapAll(t *testing.T) {
+
+	rangeMap := NewRangeMap[int, string](lessThan)
+	rangeMap.Put(Closed(5, 7), "b")
+	rangeMap.Put(Closed(1, 3), "a")
+	assert.Equal(t, []Range[int]{Closed(1, 3), Closed(5, 7)}, rangeMap.Ranges())
+
+	entries := make([]pair.Pair[Range[int], string], 0)
+	for r, v := range rangeMap.All() {
+		entries = append(entries, pair.Of(r, v))
+	}
+	assert.Equal(t, []pair.Pair[Range[int], string]{pair.Of(Closed(1, 3), "a"), pair.Of(Closed(5, 7), "b")}, entries)
+	for range rangeMap.All() {
+		break
+	}
+
+	rangeMap.Clear()
+	assert.True(t, rangeMap.Empty())
+	assert.Equal(t, 0, rangeMap.Len())
+}
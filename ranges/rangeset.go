@@ -0,0 +1,196 @@
+package ranges
+
+import (
+	"iter"
+	"strings"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/trees/rbt"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// RangeSet a set of points described by disjoint ranges. Ranges that overlap or are adjacent, i.e [1..3) and [3..5], are coalesced into
+// a single range when they are added, so the ranges of the set are always as few as possible.
+type RangeSet[T comparable] struct {
+	order order[T]
+	tree  *rbt.RedBlackTree[rbt.Bound[T], Range[T]] // The ranges keyed by their lower bounds.
+}
+
+// NewRangeSet creates a range set with the given ranges. Points are compared using the lessThan function which should satisfy.
+// e1 < e2 => lessThan(e1, e2) = true and lessThan(e2, e1) = false.
+// e1 = e2 => lessThan(e1, e2) = false and lessThan(e2, e1) = false.
+func NewRangeSet[T comparable](lessThan func(e1, e2 T) bool, ranges ...Range[T]) *RangeSet[T] {
+	order := order[T]{lessThan: lessThan}
+	rangeSet := RangeSet[T]{order: order, tree: rbt.New[rbt.Bound[T], Range[T]](order.lowerLessThan)}
+	for _, r := range ranges {
+		rangeSet.Add(r)
+	}
+	return &rangeSet
+}
+
+// search returns the ranges of the set that match the given range.
+func (rangeSet *RangeSet[T]) search(r Range[T], match func(a, b Range[T]) bool) []Range[T] {
+	return search(rangeSet.order, rangeSet.tree, func(s Range[T]) Range[T] { return s }, r, match)
+}
+
+// Add adds the points of the range to the set and returns true if the set changed. The range is coalesced with the ranges of the set that
+// it overlaps or is adjacent to. Panics if the upper bound of the range is below its lower bound.
+func (rangeSet *RangeSet[T]) Add(r Range[T]) bool {
+	if rangeSet.order.inverted(r) {
+		panic(errors.IllegalArgument("range", r))
+	} else if rangeSet.order.empty(r) || rangeSet.Encloses(r) {
+		return false
+	}
+	span := r
+	for _, s := range rangeSet.search(r, rangeSet.order.connected) {
+		rangeSet.tree.Delete(s.lower)
+		span = rangeSet.order.span(span, s)
+	}
+	rangeSet.tree.Insert(span.lower, span)
+	return true
+}
+
+// Remove removes the points of the range from the set and returns true if the set changed. A range of the set that the range splits is
+// replaced by the parts on either side of it. Panics if the upper bound of the range is below its lower bound.
+func (rangeSet *RangeSet[T]) Remove(r Range[T]) bool {
+	if rangeSet.order.inverted(r) {
+		panic(errors.IllegalArgument("range", r))
+	}
+	overlapping := rangeSet.search(r, rangeSet.order.overlaps)
+	for _, s := range overlapping {
+		rangeSet.tree.Delete(s.lower)
+		if below, ok := rangeSet.order.below(s, r); ok {
+			rangeSet.tree.Insert(below.lower, below)
+		}
+		if above, ok := rangeSet.order.above(s, r); ok {
+			rangeSet.tree.Insert(above.lower, above)
+		}
+	}
+	return len(overlapping) > 0
+}
+
+// floor returns the range of the set with the greatest lower bound that is at or before the given lower bound as an option.
+func (rangeSet *RangeSet[T]) floor(lower rbt.Bound[T]) optional.Optional[Range[T]] {
+	if floor := rangeSet.tree.Floor(lower); !floor.Empty() {
+		return optional.Of(floor.Value().Value())
+	}
+	return optional.Empty[Range[T]]()
+}
+
+// Contains returns true if the point is in a range of the set.
+func (rangeSet *RangeSet[T]) Contains(e T) bool {
+	return !rangeSet.RangeContaining(e).Empty()
+}
+
+// RangeContaining returns the range of the set that contains the point as an option.
+func (rangeSet *RangeSet[T]) RangeContaining(e T) optional.Optional[Range[T]] {
+	if floor := rangeSet.floor(rbt.InclusiveBound(e)); !floor.Empty() && rangeSet.order.contains(floor.Value(), e) {
+		return floor
+	}
+	return optional.Empty[Range[T]]()
+}
+
+// Encloses returns true if every point of the range is in a single range of the set.
+func (rangeSet *RangeSet[T]) Encloses(r Range[T]) bool {
+	floor := rangeSet.floor(r.lower)
+	return !floor.Empty() && rangeSet.order.encloses(floor.Value(), r)
+}
+
+// Span returns the smallest range that encloses every range of the set as an option, the option is empty if the set is empty.
+func (rangeSet *RangeSet[T]) Span() optional.Optional[Range[T]] {
+	if rangeSet.tree.Empty() {
+		return optional.Empty[Range[T]]()
+	}
+	first, last := rangeSet.tree.First().Value().Value(), rangeSet.tree.Last().Value().Value()
+	return optional.Of(Range[T]{lower: first.lower, upper: last.upper})
+}
+
+// Complement returns a new range set with the points that are not in the set.
+func (rangeSet *RangeSet[T]) Complement() *RangeSet[T] {
+	complementSet := NewRangeSet(rangeSet.order.lessThan)
+	lower := rbt.Unbounded[T]()
+	for _, r := range rangeSet.Ranges() {
+		if r.lower.Bounded() {
+			gap := Range[T]{lower: lower, upper: complement(r.lower)}
+			complementSet.tree.Insert(gap.lower, gap)
+		}
+		if !r.upper.Bounded() {
+			return complementSet
+		}
+		lower = complement(r.upper)
+	}
+	gap := Range[T]{lower: lower, upper: rbt.Unbounded[T]()}
+	complementSet.tree.Insert(gap.lower, gap)
+	return complementSet
+}
+
+// SubRangeSet returns a new range set with the points of the set that are in the given range.
+func (rangeSet *RangeSet[T]) SubRangeSet(r Range[T]) *RangeSet[T] {
+	subRangeSet := NewRangeSet(rangeSet.order.lessThan)
+	for _, s := range rangeSet.search(r, rangeSet.order.overlaps) {
+		intersection := rangeSet.order.intersection(s, r)
+		subRangeSet.tree.Insert(intersection.lower, intersection)
+	}
+	return subRangeSet
+}
+
+// Ranges returns a slice containing the disjoint ranges of the set in ascending order.
+func (rangeSet *RangeSet[T]) Ranges() []Range[T] {
+	return rangeSet.tree.Values()
+}
+
+// All returns a sequence over the disjoint ranges of the set in ascending order.
+func (rangeSet *RangeSet[T]) All() iter.Seq[Range[T]] {
+	return func(yield func(Range[T]) bool) {
+		for entry := range rangeSet.tree.Range(rbt.Unbounded[rbt.Bound[T]](), rbt.Unbounded[rbt.Bound[T]](), false) {
+			if !yield(entry.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of disjoint ranges in the set.
+func (rangeSet *RangeSet[T]) Len() int {
+	return rangeSet.tree.Len()
+}
+
+// Empty returns true if the set has no points.
+func (rangeSet *RangeSet[T]) Empty() bool {
+	return rangeSet.tree.Empty()
+}
+
+// Clear removes all the ranges from the set.
+func (rangeSet *RangeSet[T]) Clear() {
+	rangeSet.tree.Clear()
+}
+
+// Equals returns true if both sets have the same points.
+func (rangeSet *RangeSet[T]) Equals(other *RangeSet[T]) bool {
+	if rangeSet == other {
+		return true
+	} else if rangeSet.Len() != other.Len() {
+		return false
+	}
+	ranges := other.Ranges()
+	for i, r := range rangeSet.Ranges() {
+		if rangeSet.order.compareLower(r.lower, ranges[i].lower) != 0 || rangeSet.order.compareUpper(r.upper, ranges[i].upper) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the string representation of the set, i.e {[1..3), (5..+∞)}.
+func (rangeSet *RangeSet[T]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	for i, r := range rangeSet.Ranges() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(r.String())
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
@@ -0,0 +1,268 @@
+package ranges
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeSetAdd(t *testing.T) {
+
+	type addTest struct {
+		input    []Range[int]
+		expected []Range[int]
+	}
+
+	addTests := []addTest{
+		{input: []Range[int]{}, expected: []Range[int]{}},
+		{input: []Range[int]{ClosedOpen(1, 1)}, expected: []Range[int]{}},
+		{input: []Range[int]{Closed(5, 6), Closed(1, 2)}, expected: []Range[int]{Closed(1, 2), Closed(5, 6)}},
+		{input: []Range[int]{Closed(1, 3), Closed(2, 5)}, expected: []Range[int]{Closed(1, 5)}},
+		{input: []Range[int]{ClosedOpen(1, 3), Closed(3, 5)}, expected: []Range[int]{Closed(1, 5)}},
+		{input: []Range[int]{ClosedOpen(1, 3), OpenClosed(3, 5)}, expected: []Range[int]{ClosedOpen(1, 3), OpenClosed(3, 5)}},
+		{input: []Range[int]{Closed(1, 2), Closed(4, 5), Closed(7, 8), Closed(2, 7)}, expected: []Range[int]{Closed(1, 8)}},
+		{input: []Range[int]{Closed(1, 2), Closed(4, 5), AtMost(4)}, expected: []Range[int]{AtMost(5)}},
+		{input: []Range[int]{Closed(1, 2), GreaterThan(0)}, expected: []Range[int]{GreaterThan(0)}},
+		{input: []Range[int]{AtMost(1), AtLeast(1)}, expected: []Range[int]{All[int]()}},
+		{input: []Range[int]{Closed(1, 10), Closed(3, 4)}, expected: []Range[int]{Closed(1, 10)}},
+	}
+
+	for _, test := range addTests {
+		rangeSet := NewRangeSet(lessThan, test.input...)
+		assert.Equal(t, test.expected, rangeSet.Ranges())
+		assert.Equal(t, len(test.expected), rangeSet.Len())
+	}
+
+	rangeSet := NewRangeSet(lessThan, Closed(1, 5))
+	assert.False(t, rangeSet.Add(Closed(2, 3)))
+	assert.True(t, rangeSet.Add(Closed(5, 6)))
+	assert.False(t, rangeSet.Add(Open(6, 6)))
+	assert.PanicsWithError(t, errors.IllegalArgument("range", Closed(2, 1)).Error(), func() { rangeSet.Add(Closed(2, 1)) })
+}
+
+func TestRangeSetRemove(t *testing.T) {
+
+	type removeTest struct {
+		input    []Range[int]
+		remove   Range[int]
+		removed  bool
+		expected []Range[int]
+	}
+
+	removeTests := []removeTest{
+		{input: []Range[int]{}, remove: Closed(1, 2), removed: false, expected: []Range[int]{}},
+		{input: []Range[int]{Closed(1, 10)}, remove: Closed(3, 4), removed: true, expected: []Range[int]{ClosedOpen(1, 3), OpenClosed(4, 10)}},
+		{input: []Range[int]{Closed(1, 10)}, remove: Open(3, 4), removed: true, expected: []Range[int]{Closed(1, 3), Closed(4, 10)}},
+		{input: []Range[int]{Closed(1, 10)}, remove: AtMost(5), removed: true, expected: []Range[int]{OpenClosed(5, 10)}},
+		{input: []Range[int]{Closed(1, 10)}, remove: All[int](), removed: true, expected: []Range[int]{}},
+		{input: []Range[int]{Closed(1, 2), Closed(4, 5), Closed(7, 8)}, remove: Open(2, 7), removed: true, expected: []Range[int]{Closed(1, 2), Closed(7, 8)}},
+		{input: []Range[int]{Closed(1, 2), Closed(4, 5)}, remove: Open(2, 4), removed: false, expected: []Range[int]{Closed(1, 2), Closed(4, 5)}},
+		{input: []Range[int]{AtLeast(1)}, remove: Closed(3, 4), removed: true, expected: []Range[int]{ClosedOpen(1, 3), GreaterThan(4)}},
+	}
+
+	for _, test := range removeTests {
+		rangeSet := NewRangeSet(lessThan, test.input...)
+		assert.Equal(t, test.removed, rangeSet.Remove(test.remove))
+		assert.Equal(t, test.expected, rangeSet.Ranges())
+	}
+}
+
+func TestRangeSetContains(t *testing.T) {
+
+	rangeSet := NewRangeSet(lessThan, ClosedOpen(1, 3), OpenClosed(3, 5), AtLeast(10))
+	assert.True(t, rangeSet.Contains(1))
+	assert.True(t, rangeSet.Contains(2))
+	assert.False(t, rangeSet.Contains(3))
+	assert.True(t, rangeSet.Contains(5))
+	assert.False(t, rangeSet.Contains(0))
+	assert.False(t, rangeSet.Contains(7))
+	assert.True(t, rangeSet.Contains(1000))
+	assert.Equal(t, optional.Of(OpenClosed(3, 5)), rangeSet.RangeContaining(4))
+	assert.Equal(t, optional.Empty[Range[int]](), rangeSet.RangeContaining(3))
+}
+
+func TestRangeSetEncloses(t *testing.T) {
+
+	rangeSet := NewRangeSet(lessThan, ClosedOpen(1, 3), OpenClosed(3, 5), AtLeast(10))
+	assert.True(t, rangeSet.Encloses(Closed(1, 2)))
+	assert.True(t, rangeSet.Encloses(ClosedOpen(1, 3)))
+	assert.False(t, rangeSet.Encloses(Closed(1, 3)))
+	assert.False(t, rangeSet.Encloses(Closed(2, 4)))
+	assert.True(t, rangeSet.Encloses(AtLeast(20)))
+	assert.False(t, rangeSet.Encloses(AtMost(2)))
+	assert.False(t, NewRangeSet[int](lessThan).Encloses(Closed(1, 2)))
+}
+
+func TestRangeSetSpan(t *testing.T) {
+
+	assert.Equal(t, optional.Empty[Range[int]](), NewRangeSet[int](lessThan).Span())
+	assert.Equal(t, optional.Of(Closed(1, 5)), NewRangeSet(lessThan, OpenClosed(3, 5), ClosedOpen(1, 3)).Span())
+	assert.Equal(t, optional.Of(GreaterThan(1)), NewRangeSet(lessThan, AtLeast(10), Open(1, 3)).Span())
+}
+
+func TestRangeSetComplement(t *testing.T) {
+
+	type complementTest struct {
+		input    []Range[int]
+		expected []Range[int]
+	}
+
+	complementTests := []complementTest{
+		{input: []Range[int]{}, expected: []Range[int]{All[int]()}},
+		{input: []Range[int]{All[int]()}, expected: []Range[int]{}},
+		{input: []Range[int]{Closed(1, 5)}, expected: []Range[int]{LessThan(1), GreaterThan(5)}},
+		{input: []Range[int]{ClosedOpen(1, 3), OpenClosed(3, 5), AtLeast(10)}, expected: []Range[int]{LessThan(1), Closed(3, 3), Open(5, 10)}},
+		{input: []Range[int]{AtMost(1), Open(3, 5)}, expected: []Range[int]{OpenClosed(1, 3), AtLeast(5)}},
+	}
+
+	for _, test := range complementTests {
+		rangeSet := NewRangeSet(lessThan, test.input...)
+		complement := rangeSet.Complement()
+		assert.Equal(t, test.expected, complement.Ranges())
+		assert.True(t, complement.Complement().Equals(rangeSet))
+	}
+}
+
+func TestSubRangeSet(t *testing.T) {
+
+	rangeSet := NewRangeSet(lessThan, Closed(1, 3), Closed(5, 7), AtLeast(10))
+	assert.Equal(t, []Range[int]{Closed(2, 3), Closed(5, 6)}, rangeSet.SubRangeSet(Closed(2, 6)).Ranges())
+	assert.Equal(t, []Range[int]{ClosedOpen(10, 12)}, rangeSet.SubRangeSet(Open(7, 12)).Ranges())
+	assert.Equal(t, rangeSet.Ranges(), rangeSet.SubRangeSet(All[int]()).Ranges())
+	assert.True(t, rangeSet.SubRangeSet(Open(3, 5)).Empty())
+
+	// The sub range set is a copy.
+	rangeSet.SubRangeSet(Closed(2, 6)).Add(Closed(20, 30))
+	assert.Equal(t, 3, rangeSet.Len())
+}
+
+func TestRangeSetEquals(t *testing.T) {
+
+	a := NewRangeSet(lessThan, Closed(1, 3), Closed(5, 7))
+	assert.True(t, a.Equals(a))
+	assert.True(t, a.Equals(NewRangeSet(lessThan, Closed(5, 7), Closed(1, 2), Closed(2, 3))))
+	assert.False(t, a.Equals(NewRangeSet(lessThan, Closed(1, 3), ClosedOpen(5, 7))))
+	assert.False(t, a.Equals(NewRangeSet(lessThan, Closed(1, 3))))
+
+	// Bounds are compared with the ordering of the set.
+	tensLessThan := func(a, b int) bool { return a/10 < b/10 }
+	assert.True(t, NewRangeSet(tensLessThan, Closed(10, 25)).Equals(NewRangeSet(tensLessThan, Closed(15, 29))))
+	assert.False(t, NewRangeSet(tensLessThan, Closed(10, 25)).Equals(NewRangeSet(tensLessThan, ClosedOpen(15, 29))))
+}
+
+func TestRangeSetEquivalentPoints(t *testing.T) {
+
+	// Points in the same ten are equal under the ordering even though they are distinct.
+	tensLessThan := func(a, b int) bool { return a/10 < b/10 }
+
+	type equivalentPointsTest struct {
+		input    []Range[int]
+		remove   Range[int]
+		expected []Range[int]
+		contains []int
+		missing  []int
+	}
+
+	equivalentPointsTests := []equivalentPointsTest{
+		{input: []Range[int]{Closed(10, 25)}, remove: Open(50, 50), expected: []Range[int]{Closed(10, 25)},
+			contains: []int{10, 15, 22, 29}, missing: []int{9, 30}},
+		{input: []Range[int]{Closed(10, 25), Closed(15, 29)}, remove: Open(50, 50), expected: []Range[int]{Closed(10, 25)},
+			contains: []int{19, 29}, missing: []int{30}},
+		{input: []Range[int]{ClosedOpen(0, 12), Closed(15, 30)}, remove: Open(50, 50), expected: []Range[int]{Closed(0, 30)},
+			contains: []int{5, 17, 39}, missing: []int{40}},
+		{input: []Range[int]{Closed(0, 39)}, remove: Closed(15, 18), expected: []Range[int]{ClosedOpen(0, 15), OpenClosed(18, 39)},
+			contains: []int{9, 20, 39}, missing: []int{10, 12, 19}},
+	}
+
+	for _, test := range equivalentPointsTests {
+		rangeSet := NewRangeSet(tensLessThan, test.input...)
+		rangeSet.Remove(test.remove)
+		assert.Equal(t, test.expected, rangeSet.Ranges())
+		for _, e := range test.contains {
+			assert.True(t, rangeSet.Contains(e), e)
+		}
+		for _, e := range test.missing {
+			assert.False(t, rangeSet.Contains(e), e)
+		}
+	}
+
+	caseInsensitive := func(a, b string) bool { return strings.ToLower(a) < strings.ToLower(b) }
+	rangeSet := NewRangeSet(caseInsensitive, Closed("a", "c"))
+	assert.True(t, rangeSet.Contains("A"))
+	assert.True(t, rangeSet.Contains("C"))
+	assert.False(t, rangeSet.Add(Closed("B", "C")))
+	assert.Equal(t, []Range[string]{Closed("a", "c")}, rangeSet.Ranges())
+}
+
+func TestRangeSetString(t *testing.T) {
+
+	rangeSet := NewRangeSet[int](lessThan)
+	assert.Equal(t, "{}", rangeSet.String())
+	rangeSet.Add(GreaterThan(5))
+	rangeSet.Add(ClosedOpen(1, 3))
+	assert.Equal(t, "{[1..3), (5..+∞)}", rangeSet.String())
+
+	ranges := make([]Range[int], 0)
+	for r := range rangeSet.All() {
+		ranges = append(ranges, r)
+	}
+	assert.Equal(t, rangeSet.Ranges(), ranges)
+	for range rangeSet.All() {
+		break
+	}
+
+	rangeSet.Clear()
+	assert.True(t, rangeSet.Empty())
+}
+
+func TestRangeSetRandomOperations(t *testing.T) {
+
+	// The points of the set are checked against a map of the points that were last added or removed.
+	random := rand.New(rand.NewSource(7))
+	randomRange := func() Range[int] {
+		a, b := random.Intn(100), random.Intn(100)
+		if b < a {
+			a, b = b, a
+		}
+		switch random.Intn(4) {
+		case 0:
+			return Closed(a, b)
+		case 1:
+			return Open(a, b)
+		case 2:
+			return ClosedOpen(a, b)
+		default:
+			return OpenClosed(a, b)
+		}
+	}
+
+	rangeSet := NewRangeSet[int](lessThan)
+	points := make(map[int]bool)
+	for i := 0; i < 1000; i++ {
+		r := randomRange()
+		add := random.Intn(3) > 0
+		if add {
+			rangeSet.Add(r)
+		} else {
+			rangeSet.Remove(r)
+		}
+		for p := -1; p <= 100; p++ {
+			if intOrder.contains(r, p) {
+				points[p] = add
+			}
+		}
+		complement := rangeSet.Complement()
+		for p := -1; p <= 100; p++ {
+			assert.Equal(t, points[p], rangeSet.Contains(p))
+			assert.Equal(t, !points[p], complement.Contains(p))
+		}
+		ranges := rangeSet.Ranges()
+		for j := 1; j < len(ranges); j++ {
+			assert.False(t, intOrder.connected(ranges[j-1], ranges[j]))
+		}
+	}
+}
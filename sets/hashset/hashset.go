@@ -3,9 +3,11 @@ package hashset
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
 	"github.com/phantom820/collections/iterable"
 	"github.com/phantom820/collections/iterator"
 	"github.com/phantom820/collections/maps/hashmap"
@@ -14,12 +16,13 @@ import (
 
 // HashSet implementation of a set backed by a HashMap.
 type HashSet[T comparable] struct {
-	hashmap hashmap.HashMap[T, struct{}]
+	hashmap  hashmap.HashMap[T, struct{}]
+	modCount int // The number of structural modifications, used to detect modifications during iteration.
 }
 
 // New creates a mutable set with the given elements.
 func New[T comparable](elements ...T) *HashSet[T] {
-	set := HashSet[T]{hashmap.New[T, struct{}]()}
+	set := HashSet[T]{hashmap: hashmap.New[T, struct{}]()}
 	for _, e := range elements {
 		set.Add(e)
 	}
@@ -28,7 +31,7 @@ func New[T comparable](elements ...T) *HashSet[T] {
 
 // Of creates an immutable set with the given elements.
 func Of[T comparable](elements ...T) ImmutableHashSet[T] {
-	set := HashSet[T]{hashmap.New[T, struct{}]()}
+	set := HashSet[T]{hashmap: hashmap.New[T, struct{}]()}
 	for i := range elements {
 		set.Add(elements[i])
 	}
@@ -37,8 +40,11 @@ func Of[T comparable](elements ...T) ImmutableHashSet[T] {
 
 // Add adds the specified element to this set if it is not already present.
 func (set *HashSet[T]) Add(e T) bool {
-	value := set.hashmap.PutIfAbsent(e, struct{}{})
-	return value.Empty()
+	if value := set.hashmap.PutIfAbsent(e, struct{}{}); value.Empty() {
+		set.modCount++
+		return true
+	}
+	return false
 }
 
 // AddAll adds all of the elements in the specified iterable to the set.
@@ -62,16 +68,20 @@ func (set *HashSet[T]) AddSlice(s []T) bool {
 
 // Remove removes the specified element from this set if it is present.
 func (set *HashSet[T]) Remove(e T) bool {
-	n := set.Len()
-	_ = set.hashmap.Remove(e)
-	return n != set.Len()
+	if set.hashmap.Remove(e).Empty() {
+		return false
+	}
+	set.modCount++
+	return true
 }
 
 // RemoveIf removes all of the elements of this collection that satisfy the given predicate.
 func (set *HashSet[T]) RemoveIf(f func(T) bool) bool {
-	n := set.hashmap.Len()
-	set.hashmap.RemoveIf(f)
-	return n != set.hashmap.Len()
+	if set.hashmap.RemoveIf(f) {
+		set.modCount++
+		return true
+	}
+	return false
 }
 
 // RetainAll retains only the elements in the set that are contained in the specified collection.
@@ -119,6 +129,7 @@ func (set *HashSet[T]) RemoveSlice(s []T) bool {
 // Clear removes all of the elements from the set.
 func (set *HashSet[T]) Clear() {
 	set.hashmap.Clear()
+	set.modCount++
 }
 
 // Contains returns true if the set contains the specified element.
@@ -171,26 +182,73 @@ func (set *HashSet[T]) ForEach(f func(T)) {
 	}
 }
 
-// Iterator returns an iterator over the elements in the set.
+// Iterator returns an iterator over the elements in the set. The iterator is fail fast, Next panics if the set is structurally
+// modified after iteration has started.
 func (set *HashSet[T]) Iterator() iterator.Iterator[T] {
-	return &setIterator[T]{iterator: set.hashmap.Iterator()}
+	return &setIterator[T]{iterator: set.hashmap.RemovableIterator(), set: set}
+}
+
+// RemovableIterator returns an iterator over the elements in the set that can remove the last returned element from the set.
+func (set *HashSet[T]) RemovableIterator() iterator.RemovableIterator[T] {
+	return &setIterator[T]{iterator: set.hashmap.RemovableIterator(), set: set}
+}
+
+// All returns a sequence over the elements in the set. The sequence panics if the set is structurally modified while ranging over it.
+func (set *HashSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		modCount := set.modCount
+		for e := range set.hashmap {
+			if !yield(e) {
+				return
+			} else if modCount != set.modCount {
+				panic(errors.ConcurrentModification("HashSet"))
+			}
+		}
+	}
 }
 
 // setIterator implememantation for [HashSet].
 type setIterator[T comparable] struct {
-	iterator iterator.Iterator[pair.Pair[T, struct{}]]
+	initialized bool
+	iterator    iterator.RemovableIterator[pair.Pair[T, struct{}]]
+	set         *HashSet[T]
+	modCount    int  // The expected modification count of the set.
+	removable   bool // Whether the last returned element can be removed.
 }
 
 // HasNext returns true if the iterator has more elements.
 func (it *setIterator[T]) HasNext() bool {
+	if !it.initialized {
+		it.modCount = it.set.modCount
+		it.initialized = true
+	}
 	return it.iterator.HasNext()
 }
 
 // Next returns the next element in the iterator.
-func (it setIterator[T]) Next() T {
+func (it *setIterator[T]) Next() T {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	} else if it.modCount != it.set.modCount {
+		panic(errors.ConcurrentModification("HashSet"))
+	}
+	it.removable = true
 	return it.iterator.Next().Key()
 }
 
+// Remove removes the last element returned by Next from the set. Remove can only be called once per call to Next.
+func (it *setIterator[T]) Remove() {
+	if !it.removable {
+		panic(errors.IllegalState("Remove", "HashSet"))
+	} else if it.modCount != it.set.modCount {
+		panic(errors.ConcurrentModification("HashSet"))
+	}
+	it.iterator.Remove()
+	it.removable = false
+	it.set.modCount++
+	it.modCount = it.set.modCount
+}
+
 // ToSlice returns a slice containing all the elements in the set.
 func (set *HashSet[T]) ToSlice() []T {
 	slice := make([]T, set.Len())
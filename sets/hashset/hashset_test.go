@@ -1,6 +1,8 @@
 package hashset
 
 import (
+	"github.com/phantom820/collections/errors"
+	"slices"
 	"testing"
 
 	"github.com/phantom820/collections"
@@ -549,3 +551,73 @@ func TestString(t *testing.T) {
 	assert.Equal(t, "{}", New[int]().String())
 	assert.Equal(t, "{1}", New(1).String())
 }
+
+func TestAll(t *testing.T) {
+
+	assert.Nil(t, slices.Collect(New[int]().All()))
+	assert.ElementsMatch(t, []int{1, 2, 3, 4}, slices.Collect(New(1, 2, 3, 4).All()))
+
+	count := 0
+	for range New(1, 2, 3, 4).All() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestConcurrentModification(t *testing.T) {
+
+	type concurrentModificationTest struct {
+		modify func(set *HashSet[int])
+	}
+
+	concurrentModificationTests := []concurrentModificationTest{
+		{modify: func(set *HashSet[int]) { set.Add(4) }},
+		{modify: func(set *HashSet[int]) { set.Remove(2) }},
+		{modify: func(set *HashSet[int]) { set.Remove(1); set.Add(1) }},
+		{modify: func(set *HashSet[int]) { set.RemoveIf(func(e int) bool { return e > 1 }) }},
+		{modify: func(set *HashSet[int]) { set.Clear() }},
+	}
+
+	for _, test := range concurrentModificationTests {
+		set := New(1, 2, 3)
+		it := set.Iterator()
+		it.Next()
+		test.modify(set)
+		assert.PanicsWithError(t, errors.ConcurrentModification("HashSet").Error(), func() { it.Next() })
+		assert.PanicsWithError(t, errors.ConcurrentModification("HashSet").Error(), func() {
+			set := New(1, 2, 3)
+			for range set.All() {
+				test.modify(set)
+			}
+		})
+	}
+
+	// adding an element that is already present is not a structural modification.
+	set := New(1, 2)
+	it := set.Iterator()
+	it.Next()
+	set.Add(1)
+	assert.NotPanics(t, func() { it.Next() })
+}
+
+func TestRemovableIterator(t *testing.T) {
+
+	set := New(1, 2, 3, 4, 5)
+	it := set.RemovableIterator()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "HashSet").Error(), func() { it.Remove() })
+	for it.HasNext() {
+		if it.Next()%2 == 0 {
+			it.Remove()
+		}
+	}
+	assert.ElementsMatch(t, []int{1, 3, 5}, set.ToSlice())
+	assert.Equal(t, 3, set.Len())
+
+	it = set.RemovableIterator()
+	it.Next()
+	it.Remove()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "HashSet").Error(), func() { it.Remove() })
+	set.Add(6)
+	assert.PanicsWithError(t, errors.ConcurrentModification("HashSet").Error(), func() { it.Next() })
+}
@@ -2,6 +2,7 @@ package hashset
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/phantom820/collections"
@@ -45,6 +46,11 @@ func (set ImmutableHashSet[T]) Iterator() iterator.Iterator[T] {
 	return set.hashSet.Iterator()
 }
 
+// All returns a sequence over the elements in the set.
+func (set ImmutableHashSet[T]) All() iter.Seq[T] {
+	return set.hashSet.All()
+}
+
 // Equals returns true if the set is equivalent to the given set. Two sets are equal if they are the same reference or have the same size and contain
 // the same elements.
 func (set ImmutableHashSet[T]) Equals(otherSet collections.Set[T]) bool {
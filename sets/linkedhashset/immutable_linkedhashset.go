@@ -2,6 +2,7 @@ package linkedhashset
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/phantom820/collections"
@@ -45,6 +46,11 @@ func (set ImmutableLinkedHashSet[T]) Iterator() iterator.Iterator[T] {
 	return set.linkedHashSet.Iterator()
 }
 
+// All returns a sequence over the elements in the set. Elements are yielded following their insertion order.
+func (set ImmutableLinkedHashSet[T]) All() iter.Seq[T] {
+	return set.linkedHashSet.All()
+}
+
 // ToSlice returns a slice containing all the elements in the set.
 func (set ImmutableLinkedHashSet[T]) ToSlice() []T {
 	return set.linkedHashSet.ToSlice()
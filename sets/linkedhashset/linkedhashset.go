@@ -3,6 +3,7 @@ package linkedhashset
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/phantom820/collections"
@@ -174,12 +175,22 @@ func (set LinkedHashSet[T]) ForEach(f func(T)) {
 
 // Iterator returns an iterator over the elements in the set.
 func (set *LinkedHashSet[T]) Iterator() iterator.Iterator[T] {
-	return &setIterator[T]{mapIterator: set.linkedHashMap.Iterator()}
+	return &setIterator[T]{mapIterator: set.linkedHashMap.RemovableIterator()}
+}
+
+// RemovableIterator returns an iterator over the elements in the set that can remove the last returned element from the set.
+func (set *LinkedHashSet[T]) RemovableIterator() iterator.RemovableIterator[T] {
+	return &setIterator[T]{mapIterator: set.linkedHashMap.RemovableIterator()}
+}
+
+// All returns a sequence over the elements in the set. Elements are yielded following their insertion order.
+func (set *LinkedHashSet[T]) All() iter.Seq[T] {
+	return set.linkedHashMap.AllKeys()
 }
 
 // setIterator implememantation for [LinkedHashSet].
 type setIterator[T comparable] struct {
-	mapIterator iterator.Iterator[pair.Pair[T, struct{}]]
+	mapIterator iterator.RemovableIterator[pair.Pair[T, struct{}]]
 }
 
 // HasNext returns true if the iterator has more elements.
@@ -192,6 +203,11 @@ func (it setIterator[T]) Next() T {
 	return it.mapIterator.Next().Key()
 }
 
+// Remove removes the last element returned by Next from the set. Remove can only be called once per call to Next.
+func (it *setIterator[T]) Remove() {
+	it.mapIterator.Remove()
+}
+
 // ToSlice returns a slice containing all the elements in the set.
 func (set *LinkedHashSet[T]) ToSlice() []T {
 	slice := make([]T, set.Len())
@@ -1,6 +1,8 @@
 package linkedhashset
 
 import (
+	"github.com/phantom820/collections/errors"
+	"slices"
 	"testing"
 
 	"github.com/phantom820/collections"
@@ -550,3 +552,39 @@ func TestString(t *testing.T) {
 	assert.Equal(t, "{1}", New(1).String())
 	assert.Equal(t, "{1, 2, 3}", New(1, 2, 3).String())
 }
+
+func TestAll(t *testing.T) {
+
+	assert.Nil(t, slices.Collect(New[int]().All()))
+	assert.Equal(t, []int{4, 2, 3, 1}, slices.Collect(New(4, 2, 3, 1).All()))
+
+	data := make([]int, 0)
+	for e := range New(4, 2, 3, 1).All() {
+		if e == 3 {
+			break
+		}
+		data = append(data, e)
+	}
+	assert.Equal(t, []int{4, 2}, data)
+}
+
+func TestRemovableIterator(t *testing.T) {
+
+	set := New(1, 2, 3, 4, 5)
+	it := set.RemovableIterator()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "LinkedHashMap").Error(), func() { it.Remove() })
+	for it.HasNext() {
+		if it.Next()%2 == 0 {
+			it.Remove()
+		}
+	}
+	assert.Equal(t, []int{1, 3, 5}, set.ToSlice())
+	assert.Equal(t, 3, set.Len())
+
+	it = set.RemovableIterator()
+	it.Next()
+	it.Remove()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "LinkedHashMap").Error(), func() { it.Remove() })
+	set.Add(6)
+	assert.PanicsWithError(t, errors.ConcurrentModification("LinkedHashMap").Error(), func() { it.Next() })
+}
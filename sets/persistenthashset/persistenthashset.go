@@ -0,0 +1,182 @@
+// package persistenthashset defines an immutable set in which every modification produces a new version of the set that shares all of
+// its unchanged parts with the version it was derived from.
+package persistenthashset
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/maps/persistenthashmap"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// PersistentHashSet an immutable set backed by a [persistenthashmap.PersistentHashMap]. With and Without take O(log32 n) time and
+// leave the receiver unchanged. The zero value is an empty set. The mutating methods of [collections.Set] are unsupported and panic.
+type PersistentHashSet[T comparable] struct {
+	hashMap persistenthashmap.PersistentHashMap[T, struct{}]
+}
+
+// New creates a set with the given elements.
+func New[T comparable](elements ...T) PersistentHashSet[T] {
+	set := PersistentHashSet[T]{}
+	for _, e := range elements {
+		set = set.With(e)
+	}
+	return set
+}
+
+// With returns a new set that contains the given element.
+func (set PersistentHashSet[T]) With(e T) PersistentHashSet[T] {
+	if set.hashMap.ContainsKey(e) {
+		return set
+	}
+	return PersistentHashSet[T]{hashMap: set.hashMap.Assoc(e, struct{}{})}
+}
+
+// Without returns a new set that does not contain the given element.
+func (set PersistentHashSet[T]) Without(e T) PersistentHashSet[T] {
+	return PersistentHashSet[T]{hashMap: set.hashMap.Without(e)}
+}
+
+// Contains returns true if the set contains the specified element.
+func (set PersistentHashSet[T]) Contains(e T) bool {
+	return set.hashMap.ContainsKey(e)
+}
+
+// ContainsAll returns true if the set contains all of the elements of the specified iterable.
+func (set PersistentHashSet[T]) ContainsAll(iterable iterable.Iterable[T]) bool {
+	it := iterable.Iterator()
+	for it.HasNext() {
+		if !set.Contains(it.Next()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of elements in the set.
+func (set PersistentHashSet[T]) Len() int {
+	return set.hashMap.Len()
+}
+
+// Empty returns true if the set contains no elements.
+func (set PersistentHashSet[T]) Empty() bool {
+	return set.hashMap.Empty()
+}
+
+// ForEach performs the given action for each element of the set.
+func (set PersistentHashSet[T]) ForEach(f func(T)) {
+	for e := range set.hashMap.AllKeys() {
+		f(e)
+	}
+}
+
+// Iterator returns an iterator over the elements in the set.
+func (set PersistentHashSet[T]) Iterator() iterator.Iterator[T] {
+	return &setIterator[T]{set.hashMap.Iterator()}
+}
+
+// All returns a sequence over the elements in the set.
+func (set PersistentHashSet[T]) All() iter.Seq[T] {
+	return set.hashMap.AllKeys()
+}
+
+// Equals returns true if the set is equivalent to the given set. Two sets are equal if they have the same size and contain the same
+// elements.
+func (set PersistentHashSet[T]) Equals(otherSet collections.Set[T]) bool {
+	if set.Len() != otherSet.Len() {
+		return false
+	}
+	for e := range set.All() {
+		if !otherSet.Contains(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// ToSlice returns a slice containing all the elements in the set.
+func (set PersistentHashSet[T]) ToSlice() []T {
+	return set.hashMap.Keys()
+}
+
+// String returns the string representation of the set.
+func (set PersistentHashSet[T]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for e := range set.All() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprint(e))
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// setIterator iterator implementation for [PersistentHashSet].
+type setIterator[T comparable] struct {
+	iterator iterator.Iterator[pair.Pair[T, struct{}]]
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *setIterator[T]) HasNext() bool {
+	return it.iterator.HasNext()
+}
+
+// Next returns the next element in the iterator.
+func (it *setIterator[T]) Next() T {
+	return it.iterator.Next().Key()
+}
+
+// Add unsupported operation, see With.
+func (set PersistentHashSet[T]) Add(e T) bool {
+	panic(errors.UnsupportedOperation("Add", "PersistentHashSet"))
+}
+
+// AddAll unsupported operation.
+func (set PersistentHashSet[T]) AddAll(iterable iterable.Iterable[T]) bool {
+	panic(errors.UnsupportedOperation("AddAll", "PersistentHashSet"))
+}
+
+// AddSlice unsupported operation.
+func (set PersistentHashSet[T]) AddSlice(s []T) bool {
+	panic(errors.UnsupportedOperation("AddSlice", "PersistentHashSet"))
+}
+
+// Clear unsupported operation.
+func (set PersistentHashSet[T]) Clear() {
+	panic(errors.UnsupportedOperation("Clear", "PersistentHashSet"))
+}
+
+// Remove unsupported operation, see Without.
+func (set PersistentHashSet[T]) Remove(e T) bool {
+	panic(errors.UnsupportedOperation("Remove", "PersistentHashSet"))
+}
+
+// RemoveIf unsupported operation.
+func (set PersistentHashSet[T]) RemoveIf(f func(T) bool) bool {
+	panic(errors.UnsupportedOperation("RemoveIf", "PersistentHashSet"))
+}
+
+// RemoveAll unsupported operation.
+func (set PersistentHashSet[T]) RemoveAll(iterable iterable.Iterable[T]) bool {
+	panic(errors.UnsupportedOperation("RemoveAll", "PersistentHashSet"))
+}
+
+// RemoveSlice unsupported operation.
+func (set PersistentHashSet[T]) RemoveSlice(s []T) bool {
+	panic(errors.UnsupportedOperation("RemoveSlice", "PersistentHashSet"))
+}
+
+// RetainAll unsupported operation.
+func (set PersistentHashSet[T]) RetainAll(c collections.Collection[T]) bool {
+	panic(errors.UnsupportedOperation("RetainAll", "PersistentHashSet"))
+}
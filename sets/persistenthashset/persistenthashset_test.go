@@ -0,0 +1,125 @@
+package persistenthashset
+
+import (
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+
+	var zero PersistentHashSet[int]
+	assert.True(t, zero.Empty())
+	assert.Equal(t, 0, zero.Len())
+	assert.Equal(t, "{}", zero.String())
+
+	set := New(1, 2, 2, 3)
+	assert.Equal(t, 3, set.Len())
+	assert.ElementsMatch(t, []int{1, 2, 3}, set.ToSlice())
+}
+
+func TestWithAndWithout(t *testing.T) {
+
+	base := New[int]()
+	for i := 0; i < 1000; i++ {
+		base = base.With(i)
+	}
+	assert.Equal(t, 1000, base.Len())
+	assert.Equal(t, base, base.With(0))
+
+	set := base
+	for i := 0; i < 1000; i += 2 {
+		set = set.Without(i)
+	}
+	assert.Equal(t, 500, set.Len())
+	assert.Equal(t, set, set.Without(0))
+	for i := 0; i < 1000; i++ {
+		assert.True(t, base.Contains(i))
+		assert.Equal(t, i%2 == 1, set.Contains(i))
+	}
+}
+
+func TestPointerElements(t *testing.T) {
+
+	type node struct{ value int }
+
+	nodes := []*node{{value: 1}, {value: 2}, {value: 3}}
+	set := New(nodes...)
+	for _, node := range nodes {
+		node.value *= 10
+	}
+	for _, node := range nodes {
+		assert.True(t, set.Contains(node))
+	}
+	assert.False(t, set.Contains(&node{value: 10}))
+	assert.Equal(t, 3, set.With(nodes[0]).Len())
+	assert.Equal(t, 2, set.Without(nodes[0]).Len())
+}
+
+func TestContainsAll(t *testing.T) {
+
+	set := New(1, 2, 3)
+	assert.True(t, set.ContainsAll(vector.Of(1, 3)))
+	assert.True(t, set.ContainsAll(vector.Of[int]()))
+	assert.False(t, set.ContainsAll(vector.Of(1, 4)))
+}
+
+func TestEquals(t *testing.T) {
+
+	set := New(1, 2, 3)
+	assert.True(t, set.Equals(set))
+	assert.True(t, set.Equals(hashset.New(1, 2, 3)))
+	assert.True(t, hashset.New(1, 2, 3).Equals(set))
+	assert.False(t, set.Equals(set.Without(1)))
+	assert.False(t, set.Equals(set.Without(1).With(4)))
+}
+
+func TestIteration(t *testing.T) {
+
+	set := New(1, 2, 3)
+
+	it := set.Iterator()
+	assert.ElementsMatch(t, []int{1, 2, 3}, iterator.ToSlice(it))
+	assert.PanicsWithError(t, errors.NoSuchElement().Error(), func() { it.Next() })
+
+	slice := make([]int, 0)
+	for e := range set.All() {
+		slice = append(slice, e)
+	}
+	assert.ElementsMatch(t, []int{1, 2, 3}, slice)
+
+	slice = make([]int, 0)
+	set.ForEach(func(e int) { slice = append(slice, e) })
+	assert.ElementsMatch(t, []int{1, 2, 3}, slice)
+}
+
+func TestString(t *testing.T) {
+
+	assert.Equal(t, "{1}", New(1).String())
+	assert.Contains(t, []string{"{1, 2}", "{2, 1}"}, New(1, 2).String())
+}
+
+func TestUnsupportedOperations(t *testing.T) {
+
+	set := New(1, 2, 3)
+	operations := map[string]func(){
+		"Add":         func() { set.Add(4) },
+		"AddAll":      func() { set.AddAll(New(4)) },
+		"AddSlice":    func() { set.AddSlice([]int{4}) },
+		"Clear":       func() { set.Clear() },
+		"Remove":      func() { set.Remove(1) },
+		"RemoveIf":    func() { set.RemoveIf(func(int) bool { return true }) },
+		"RemoveAll":   func() { set.RemoveAll(New(1)) },
+		"RemoveSlice": func() { set.RemoveSlice([]int{1}) },
+		"RetainAll":   func() { set.RetainAll(New(1)) },
+	}
+
+	for operation, f := range operations {
+		assert.PanicsWithError(t, errors.UnsupportedOperation(operation, "PersistentHashSet").Error(), f)
+	}
+	assert.ElementsMatch(t, []int{1, 2, 3}, set.ToSlice())
+}
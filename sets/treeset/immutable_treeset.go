@@ -2,6 +2,7 @@ package treeset
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/phantom820/collections"
@@ -45,6 +46,11 @@ func (set ImmutableTreeSet[T]) Iterator() iterator.Iterator[T] {
 	return set.treeSet.Iterator()
 }
 
+// All returns a sequence over the elements in the set in sorted order.
+func (set ImmutableTreeSet[T]) All() iter.Seq[T] {
+	return set.treeSet.All()
+}
+
 // ToSlice returns a slice containing all the elements in the set.
 func (set ImmutableTreeSet[T]) ToSlice() []T {
 	return set.treeSet.ToSlice()
@@ -3,6 +3,7 @@ package treeset
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/phantom820/collections"
@@ -12,9 +13,17 @@ import (
 	"github.com/phantom820/collections/types/pair"
 )
 
+// navigableMap the map backing a [TreeSet], either a [treemap.TreeMap] or a range view of one.
+type navigableMap[T comparable] interface {
+	collections.NavigableMap[T, struct{}]
+	RemovableIterator() iterator.RemovableIterator[pair.Pair[T, struct{}]]
+	At(i int) pair.Pair[T, struct{}]
+	Rank(k T) int
+}
+
 // TreeSet implementation of a set backed by a [TreeMap].
 type TreeSet[T comparable] struct {
-	treeMap  *treemap.TreeMap[T, struct{}]
+	treeMap  navigableMap[T]
 	lessThan func(e1, e2 T) bool
 }
 
@@ -69,9 +78,7 @@ func (set *TreeSet[T]) AddSlice(s []T) bool {
 
 // Remove removes the specified element from this set if it is present.
 func (set *TreeSet[T]) Remove(e T) bool {
-	n := set.Len()
-	set.treeMap.Remove(e)
-	return n != set.Len()
+	return !set.treeMap.Remove(e).Empty()
 }
 
 // RemoveIf removes all of the elements of this collection that satisfy the given predicate.
@@ -162,7 +169,7 @@ func (set TreeSet[T]) Len() int {
 
 // Empty returns true if the set contains no elements.
 func (set TreeSet[T]) Empty() bool {
-	return set.treeMap.Len() == 0
+	return set.treeMap.Empty()
 }
 
 // Equals returns true if the set is equivalent to the given set. Two sets are equal if they are the same reference or have the same size and contain
@@ -192,12 +199,22 @@ func (set TreeSet[T]) ForEach(f func(T)) {
 
 // Iterator returns an iterator over the elements in the set.
 func (set *TreeSet[T]) Iterator() iterator.Iterator[T] {
-	return &setIterator[T]{mapIterator: set.treeMap.Iterator()}
+	return &setIterator[T]{mapIterator: set.treeMap.RemovableIterator()}
+}
+
+// RemovableIterator returns an iterator over the elements in the set that can remove the last returned element from the set.
+func (set *TreeSet[T]) RemovableIterator() iterator.RemovableIterator[T] {
+	return &setIterator[T]{mapIterator: set.treeMap.RemovableIterator()}
+}
+
+// All returns a sequence over the elements in the set in sorted order.
+func (set *TreeSet[T]) All() iter.Seq[T] {
+	return set.treeMap.AllKeys()
 }
 
 // setIterator implememantation for [HashSet].
 type setIterator[T comparable] struct {
-	mapIterator iterator.Iterator[pair.Pair[T, struct{}]]
+	mapIterator iterator.RemovableIterator[pair.Pair[T, struct{}]]
 }
 
 // HasNext returns true if the iterator has more elements.
@@ -210,6 +227,47 @@ func (it setIterator[T]) Next() T {
 	return it.mapIterator.Next().Key()
 }
 
+// Remove removes the last element returned by Next from the set. Remove can only be called once per call to Next.
+func (it *setIterator[T]) Remove() {
+	it.mapIterator.Remove()
+}
+
+// At returns the element with the given index in the sorted order of the set, the smallest element has index 0. At panics if the index is
+// out of bounds.
+func (set *TreeSet[T]) At(i int) T {
+	return set.treeMap.At(i).Key()
+}
+
+// Rank returns the number of elements in the set that are strictly less than the given element. The element does not have to be in the
+// set.
+func (set *TreeSet[T]) Rank(e T) int {
+	return set.treeMap.Rank(e)
+}
+
+// SubSet returns a view of the portion of the set with elements ranging from fromElement to toElement. The view is backed by the set,
+// changes to the set are reflected in the view and vice versa. SubSet panics if fromElement is greater than toElement and the view
+// panics on attempts to add an element outside of its range.
+func (set *TreeSet[T]) SubSet(fromElement T, fromInclusive bool, toElement T, toInclusive bool) *TreeSet[T] {
+	subMap := set.treeMap.SubMap(fromElement, fromInclusive, toElement, toInclusive)
+	return &TreeSet[T]{treeMap: subMap.(navigableMap[T]), lessThan: set.lessThan}
+}
+
+// HeadSet returns a view of the portion of the set with elements less than toElement, or equal to it if inclusive is true. The view is
+// backed by the set, changes to the set are reflected in the view and vice versa. The view panics on attempts to add an element outside
+// of its range.
+func (set *TreeSet[T]) HeadSet(toElement T, inclusive bool) *TreeSet[T] {
+	headMap := set.treeMap.HeadMap(toElement, inclusive)
+	return &TreeSet[T]{treeMap: headMap.(navigableMap[T]), lessThan: set.lessThan}
+}
+
+// TailSet returns a view of the portion of the set with elements greater than fromElement, or equal to it if inclusive is true. The view
+// is backed by the set, changes to the set are reflected in the view and vice versa. The view panics on attempts to add an element
+// outside of its range.
+func (set *TreeSet[T]) TailSet(fromElement T, inclusive bool) *TreeSet[T] {
+	tailMap := set.treeMap.TailMap(fromElement, inclusive)
+	return &TreeSet[T]{treeMap: tailMap.(navigableMap[T]), lessThan: set.lessThan}
+}
+
 // String returns the string representation of a set.
 func (set TreeSet[T]) String() string {
 	var sb strings.Builder
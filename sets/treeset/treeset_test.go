@@ -1,9 +1,12 @@
 package treeset
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
 	"github.com/phantom820/collections/queues/vectordequeue"
 	"github.com/stretchr/testify/assert"
 )
@@ -455,3 +458,86 @@ func TestString(t *testing.T) {
 	assert.Equal(t, "{}", New(lessThanInt).String())
 	assert.Equal(t, "{1, 2, 3}", New(lessThanInt, 1, 2, 3).String())
 }
+
+func TestAll(t *testing.T) {
+
+	assert.Nil(t, slices.Collect(New(lessThanInt).All()))
+	assert.Equal(t, []int{1, 2, 3, 4}, slices.Collect(New(lessThanInt, 4, 2, 3, 1).All()))
+
+	data := make([]int, 0)
+	for e := range New(lessThanInt, 4, 2, 3, 1).All() {
+		if e == 3 {
+			break
+		}
+		data = append(data, e)
+	}
+	assert.Equal(t, []int{1, 2}, data)
+}
+
+func TestRemovableIterator(t *testing.T) {
+
+	set := New(lessThanInt, 5, 4, 3, 2, 1)
+	it := set.RemovableIterator()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "TreeMap").Error(), func() { it.Remove() })
+	for it.HasNext() {
+		if it.Next()%2 == 0 {
+			it.Remove()
+		}
+	}
+	assert.Equal(t, []int{1, 3, 5}, set.ToSlice())
+	assert.Equal(t, 3, set.Len())
+
+	it = set.RemovableIterator()
+	it.Next()
+	it.Remove()
+	assert.PanicsWithError(t, errors.IllegalState("Remove", "TreeMap").Error(), func() { it.Remove() })
+	set.Add(6)
+	assert.PanicsWithError(t, errors.ConcurrentModification("TreeMap").Error(), func() { it.Next() })
+}
+
+func TestSubSet(t *testing.T) {
+
+	set := New(lessThanInt, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	subSet := set.SubSet(3, true, 7, false)
+	headSet := set.HeadSet(3, false)
+	tailSet := set.TailSet(8, true)
+
+	assert.Equal(t, []int{3, 4, 5, 6}, subSet.ToSlice())
+	assert.Equal(t, []int{1, 2}, headSet.ToSlice())
+	assert.Equal(t, []int{8, 9, 10}, tailSet.ToSlice())
+	assert.Equal(t, 4, subSet.Len())
+	assert.Equal(t, "{3, 4, 5, 6}", fmt.Sprint(subSet))
+	assert.True(t, subSet.Contains(3))
+	assert.False(t, subSet.Contains(7))
+
+	// The views are backed by the set.
+	set.Remove(4)
+	assert.True(t, subSet.Remove(5))
+	assert.False(t, subSet.Remove(8))
+	assert.Equal(t, []int{1, 2, 3, 6, 7, 8, 9, 10}, set.ToSlice())
+	headSet.Clear()
+	assert.True(t, headSet.Empty())
+	assert.Equal(t, []int{3, 6, 7, 8, 9, 10}, set.ToSlice())
+	assert.True(t, tailSet.Add(11))
+	assert.True(t, set.Contains(11))
+	assert.Equal(t, []int{6}, subSet.TailSet(3, false).ToSlice())
+
+	assert.PanicsWithError(t, errors.IllegalArgument("key", 7).Error(), func() { subSet.Add(7) })
+	assert.PanicsWithError(t, errors.IllegalArgument("key", 2).Error(), func() { tailSet.Add(2) })
+}
+
+func TestOrderStatistics(t *testing.T) {
+
+	set := New(lessThanInt, 50, 10, 40, 20, 30)
+	assert.Equal(t, 10, set.At(0))
+	assert.Equal(t, 30, set.At(2))
+	assert.Equal(t, 50, set.At(4))
+	assert.Equal(t, 2, set.Rank(25))
+	assert.Equal(t, 2, set.Rank(30))
+	assert.Equal(t, 5, set.Rank(60))
+	assert.PanicsWithError(t, errors.IndexOutOfBounds(5, 5).Error(), func() { set.At(5) })
+
+	tailSet := set.TailSet(20, false)
+	assert.Equal(t, 40, tailSet.At(1))
+	assert.Equal(t, 1, tailSet.Rank(35))
+}
@@ -0,0 +1,158 @@
+// package liststack defines an implementation of a stack that is backed by [ForwardList].
+package liststack
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/lists/forwardlist"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// ListStack a stack backed by a singly linked list, the top of the stack is the front of the list.
+type ListStack[T comparable] struct {
+	list *forwardlist.ForwardList[T]
+}
+
+// New creates a [ForwardList] based stack with the given elements pushed in order, the last element is at the top.
+func New[T comparable](elements ...T) *ListStack[T] {
+	stack := ListStack[T]{list: forwardlist.New[T]()}
+	stack.AddSlice(elements)
+	return &stack
+}
+
+// Push adds an element to the top of the stack and returns the previous top element as an option.
+func (stack *ListStack[T]) Push(e T) optional.Optional[T] {
+	if stack.Empty() {
+		stack.list.Add(e)
+		return optional.Empty[T]()
+	}
+	top := stack.list.At(0)
+	stack.list.AddAt(0, e)
+	return optional.Of(top)
+}
+
+// Peek returns the top element of the stack as an option.
+func (stack *ListStack[T]) Peek() optional.Optional[T] {
+	if stack.Empty() {
+		return optional.Empty[T]()
+	}
+	return optional.Of(stack.list.At(0))
+}
+
+// Pop returns and removes the top element of the stack as an option.
+func (stack *ListStack[T]) Pop() optional.Optional[T] {
+	if stack.Empty() {
+		return optional.Empty[T]()
+	}
+	return optional.Of(stack.list.RemoveAt(0))
+}
+
+// Add pushes the element onto the stack and returns true.
+func (stack *ListStack[T]) Add(e T) bool {
+	stack.Push(e)
+	return true
+}
+
+// AddAll pushes all of the elements in the specified iterable onto the stack in iteration order.
+func (stack *ListStack[T]) AddAll(iterable iterable.Iterable[T]) bool {
+	return stack.AddSlice(iterator.ToSlice(iterable.Iterator()))
+}
+
+// AddSlice pushes all of the elements in the slice onto the stack in order.
+func (stack *ListStack[T]) AddSlice(s []T) bool {
+	for _, e := range s {
+		stack.Push(e)
+	}
+	return len(s) > 0
+}
+
+// Clear removes all of the elements from the stack.
+func (stack *ListStack[T]) Clear() {
+	stack.list.Clear()
+}
+
+// Contains returns true if the stack contains the specified element.
+func (stack *ListStack[T]) Contains(e T) bool {
+	return stack.list.Contains(e)
+}
+
+// Empty returns true if the stack contains no elements.
+func (stack *ListStack[T]) Empty() bool {
+	return stack.list.Empty()
+}
+
+// Len returns the number of elements in the stack.
+func (stack *ListStack[T]) Len() int {
+	return stack.list.Len()
+}
+
+// Remove removes the occurence of the element closest to the top of the stack and returns true if the stack changed as a result.
+func (stack *ListStack[T]) Remove(e T) bool {
+	return stack.list.Remove(e)
+}
+
+// RemoveIf removes all of the elements of the stack that satisfy the given predicate.
+func (stack *ListStack[T]) RemoveIf(f func(T) bool) bool {
+	return stack.list.RemoveIf(f)
+}
+
+// RemoveAll removes all of the stack's elements that are also contained in the specified iterable.
+func (stack *ListStack[T]) RemoveAll(iterable iterable.Iterable[T]) bool {
+	return stack.list.RemoveAll(iterable)
+}
+
+// RemoveSlice removes all of the stack's elements that are also contained in the specified slice.
+func (stack *ListStack[T]) RemoveSlice(s []T) bool {
+	return stack.list.RemoveSlice(s)
+}
+
+// RetainAll retains only the elements in the stack that are contained in the specified collection.
+func (stack *ListStack[T]) RetainAll(c collections.Collection[T]) bool {
+	return stack.list.RetainAll(c)
+}
+
+// ForEach performs the given action for each element of the stack from top to bottom.
+func (stack *ListStack[T]) ForEach(f func(T)) {
+	stack.list.ForEach(f)
+}
+
+// ToSlice returns a slice containing the elements of the stack from top to bottom.
+func (stack *ListStack[T]) ToSlice() []T {
+	return stack.list.ToSlice()
+}
+
+// Equals returns true if the stack is equivalent to the given stack. Two stacks are equal if they have the same size and contain the
+// same elements in the same order.
+func (stack *ListStack[T]) Equals(other collections.Stack[T]) bool {
+	if stack == other {
+		return true
+	} else if stack.Len() != other.Len() {
+		return false
+	}
+	it := other.Iterator()
+	for e := range stack.list.All() {
+		if e != it.Next() {
+			return false
+		}
+	}
+	return true
+}
+
+// Iterator returns an iterator over the elements of the stack from top to bottom.
+func (stack *ListStack[T]) Iterator() iterator.Iterator[T] {
+	return stack.list.Iterator()
+}
+
+// All returns a sequence over the elements of the stack from top to bottom.
+func (stack *ListStack[T]) All() iter.Seq[T] {
+	return stack.list.All()
+}
+
+// String returns the string representation of the stack from top to bottom.
+func (stack *ListStack[T]) String() string {
+	return fmt.Sprint(stack.list)
+}
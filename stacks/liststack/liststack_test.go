@@ -0,0 +1,176 @@
+package liststack
+
+import (
+	"testing"
+
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+func data(n int) []int {
+	data := make([]int, n)
+	for i := range data {
+		data[i] = i + 1
+	}
+	return data
+}
+
+func TestNew(t *testing.T) {
+
+	stack := New[int]()
+	assert.NotNil(t, stack)
+	assert.NotNil(t, stack.list)
+	assert.True(t, stack.Empty())
+	assert.Equal(t, []int{3, 2, 1}, New(1, 2, 3).ToSlice())
+}
+
+func TestPushPeekPop(t *testing.T) {
+
+	stack := New[int]()
+	data := data(1000)
+
+	for i, e := range data {
+		if i == 0 {
+			assert.Equal(t, optional.Empty[int](), stack.Push(e))
+		} else {
+			assert.Equal(t, optional.Of(data[i-1]), stack.Push(e))
+		}
+		assert.Equal(t, optional.Of(e), stack.Peek())
+	}
+	assert.Equal(t, len(data), stack.Len())
+
+	for i := len(data) - 1; i >= 0; i-- {
+		assert.Equal(t, optional.Of(data[i]), stack.Pop())
+	}
+	assert.True(t, stack.Empty())
+	assert.Equal(t, optional.Empty[int](), stack.Pop())
+	assert.Equal(t, optional.Empty[int](), stack.Peek())
+}
+
+func TestAdd(t *testing.T) {
+
+	stack := New[int]()
+	assert.True(t, stack.Add(1))
+	assert.True(t, stack.AddSlice([]int{2, 3}))
+	assert.False(t, stack.AddSlice([]int{}))
+	assert.True(t, stack.AddAll(vector.Of(4, 5)))
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, stack.ToSlice())
+}
+
+func TestContains(t *testing.T) {
+
+	containsTests := []struct {
+		input    *ListStack[int]
+		element  int
+		expected bool
+	}{
+		{
+			input:    New[int](),
+			element:  1,
+			expected: false,
+		},
+		{
+			input:    New(1, 2, 3),
+			element:  4,
+			expected: false,
+		},
+		{
+			input:    New(1, 2, 3),
+			element:  2,
+			expected: true,
+		},
+	}
+
+	for _, test := range containsTests {
+		assert.Equal(t, test.expected, test.input.Contains(test.element))
+	}
+}
+
+func TestRemove(t *testing.T) {
+
+	removeTests := []struct {
+		input    *ListStack[int]
+		element  int
+		expected []int
+		removed  bool
+	}{
+		{
+			input:    New[int](),
+			element:  1,
+			expected: []int{},
+			removed:  false,
+		},
+		{
+			input:    New(1, 2, 1, 3),
+			element:  1,
+			expected: []int{3, 2, 1},
+			removed:  true,
+		},
+		{
+			input:    New(1, 2, 3),
+			element:  4,
+			expected: []int{3, 2, 1},
+			removed:  false,
+		},
+	}
+
+	for _, test := range removeTests {
+		assert.Equal(t, test.removed, test.input.Remove(test.element))
+		assert.Equal(t, test.expected, test.input.ToSlice())
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+
+	stack := New(1, 2, 3, 4, 5, 6)
+	assert.True(t, stack.RemoveIf(func(e int) bool { return e%2 == 0 }))
+	assert.False(t, stack.RemoveIf(func(e int) bool { return e%2 == 0 }))
+	assert.Equal(t, []int{5, 3, 1}, stack.ToSlice())
+
+	assert.True(t, stack.RemoveSlice([]int{3}))
+	assert.False(t, stack.RemoveSlice([]int{3}))
+	assert.True(t, stack.RemoveAll(vector.Of(5)))
+	assert.Equal(t, []int{1}, stack.ToSlice())
+
+	stack.AddSlice([]int{7, 8})
+	assert.True(t, stack.RetainAll(hashset.New(1, 8)))
+	assert.False(t, stack.RetainAll(hashset.New(1, 8)))
+	assert.Equal(t, []int{8, 1}, stack.ToSlice())
+
+	stack.Clear()
+	assert.True(t, stack.Empty())
+}
+
+func TestEquals(t *testing.T) {
+
+	stack := New(1, 2, 3)
+	assert.True(t, stack.Equals(stack))
+	assert.True(t, stack.Equals(New(1, 2, 3)))
+	assert.False(t, stack.Equals(New(1, 2)))
+	assert.False(t, stack.Equals(New(3, 2, 1)))
+}
+
+func TestIteration(t *testing.T) {
+
+	stack := New(1, 2, 3)
+	assert.Equal(t, []int{3, 2, 1}, iterator.ToSlice(stack.Iterator()))
+
+	elements := make([]int, 0)
+	for e := range stack.All() {
+		elements = append(elements, e)
+	}
+	assert.Equal(t, []int{3, 2, 1}, elements)
+
+	elements = make([]int, 0)
+	stack.ForEach(func(e int) { elements = append(elements, e) })
+	assert.Equal(t, []int{3, 2, 1}, elements)
+}
+
+func TestString(t *testing.T) {
+
+	assert.Equal(t, "[]", New[int]().String())
+	assert.Equal(t, "[3 2 1]", New(1, 2, 3).String())
+}
@@ -0,0 +1,207 @@
+// package persistentstack defines an immutable stack in which every modification produces a new version of the stack that shares
+// all of its unchanged nodes with the version it was derived from.
+package persistentstack
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// node a node of a [PersistentStack], nodes are never modified once created.
+type node[T comparable] struct {
+	value T
+	next  *node[T]
+}
+
+// PersistentStack an immutable stack. With and Without return new versions in O(1) and leave the receiver unchanged, versions share
+// their nodes so any number of them can be kept cheaply and used from multiple goroutines. The zero value is an empty stack. The
+// mutating methods of [collections.Stack] are unsupported and panic.
+type PersistentStack[T comparable] struct {
+	top *node[T]
+	len int
+}
+
+// New creates a stack with the given elements pushed in order, the last element is at the top.
+func New[T comparable](elements ...T) PersistentStack[T] {
+	stack := PersistentStack[T]{}
+	for _, e := range elements {
+		stack = stack.With(e)
+	}
+	return stack
+}
+
+// With returns a new stack with the given element pushed onto the top of this stack.
+func (stack PersistentStack[T]) With(e T) PersistentStack[T] {
+	return PersistentStack[T]{top: &node[T]{value: e, next: stack.top}, len: stack.len + 1}
+}
+
+// Without returns a new stack with the top element of this stack removed, an empty stack is returned as is.
+func (stack PersistentStack[T]) Without() PersistentStack[T] {
+	if stack.Empty() {
+		return stack
+	}
+	return PersistentStack[T]{top: stack.top.next, len: stack.len - 1}
+}
+
+// Peek returns the top element of the stack as an option.
+func (stack PersistentStack[T]) Peek() optional.Optional[T] {
+	if stack.Empty() {
+		return optional.Empty[T]()
+	}
+	return optional.Of(stack.top.value)
+}
+
+// Contains returns true if the stack contains the specified element.
+func (stack PersistentStack[T]) Contains(e T) bool {
+	for x := range stack.All() {
+		if x == e {
+			return true
+		}
+	}
+	return false
+}
+
+// Empty returns true if the stack contains no elements.
+func (stack PersistentStack[T]) Empty() bool {
+	return stack.len == 0
+}
+
+// Len returns the number of elements in the stack.
+func (stack PersistentStack[T]) Len() int {
+	return stack.len
+}
+
+// ForEach performs the given action for each element of the stack from top to bottom.
+func (stack PersistentStack[T]) ForEach(f func(T)) {
+	for e := range stack.All() {
+		f(e)
+	}
+}
+
+// ToSlice returns a slice containing the elements of the stack from top to bottom.
+func (stack PersistentStack[T]) ToSlice() []T {
+	slice := make([]T, 0, stack.len)
+	for e := range stack.All() {
+		slice = append(slice, e)
+	}
+	return slice
+}
+
+// Equals returns true if the stack is equivalent to the given stack. Two stacks are equal if they have the same size and contain the
+// same elements in the same order.
+func (stack PersistentStack[T]) Equals(other collections.Stack[T]) bool {
+	if stack.Len() != other.Len() {
+		return false
+	} else if otherStack, ok := other.(PersistentStack[T]); ok && stack.top == otherStack.top {
+		return true
+	}
+	it := other.Iterator()
+	for e := range stack.All() {
+		if e != it.Next() {
+			return false
+		}
+	}
+	return true
+}
+
+// Iterator returns an iterator over the elements of the stack from top to bottom.
+func (stack PersistentStack[T]) Iterator() iterator.Iterator[T] {
+	return &stackIterator[T]{node: stack.top}
+}
+
+// All returns a sequence over the elements of the stack from top to bottom.
+func (stack PersistentStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := stack.top; node != nil; node = node.next {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the string representation of the stack from top to bottom.
+func (stack PersistentStack[T]) String() string {
+	return fmt.Sprint(stack.ToSlice())
+}
+
+// Push unsupported operation.
+func (stack PersistentStack[T]) Push(e T) optional.Optional[T] {
+	panic(errors.UnsupportedOperation("Push", "PersistentStack"))
+}
+
+// Pop unsupported operation.
+func (stack PersistentStack[T]) Pop() optional.Optional[T] {
+	panic(errors.UnsupportedOperation("Pop", "PersistentStack"))
+}
+
+// Add unsupported operation.
+func (stack PersistentStack[T]) Add(e T) bool {
+	panic(errors.UnsupportedOperation("Add", "PersistentStack"))
+}
+
+// AddAll unsupported operation.
+func (stack PersistentStack[T]) AddAll(iterable iterable.Iterable[T]) bool {
+	panic(errors.UnsupportedOperation("AddAll", "PersistentStack"))
+}
+
+// AddSlice unsupported operation.
+func (stack PersistentStack[T]) AddSlice(s []T) bool {
+	panic(errors.UnsupportedOperation("AddSlice", "PersistentStack"))
+}
+
+// Clear unsupported operation.
+func (stack PersistentStack[T]) Clear() {
+	panic(errors.UnsupportedOperation("Clear", "PersistentStack"))
+}
+
+// Remove unsupported operation.
+func (stack PersistentStack[T]) Remove(e T) bool {
+	panic(errors.UnsupportedOperation("Remove", "PersistentStack"))
+}
+
+// RemoveIf unsupported operation.
+func (stack PersistentStack[T]) RemoveIf(f func(T) bool) bool {
+	panic(errors.UnsupportedOperation("RemoveIf", "PersistentStack"))
+}
+
+// RemoveAll unsupported operation.
+func (stack PersistentStack[T]) RemoveAll(iterable iterable.Iterable[T]) bool {
+	panic(errors.UnsupportedOperation("RemoveAll", "PersistentStack"))
+}
+
+// RemoveSlice unsupported operation.
+func (stack PersistentStack[T]) RemoveSlice(s []T) bool {
+	panic(errors.UnsupportedOperation("RemoveSlice", "PersistentStack"))
+}
+
+// RetainAll unsupported operation.
+func (stack PersistentStack[T]) RetainAll(c collections.Collection[T]) bool {
+	panic(errors.UnsupportedOperation("RetainAll", "PersistentStack"))
+}
+
+// stackIterator iterator implementation for [PersistentStack].
+type stackIterator[T comparable] struct {
+	node *node[T]
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *stackIterator[T]) HasNext() bool {
+	return it.node != nil
+}
+
+// Next returns the next element in the iterator.
+func (it *stackIterator[T]) Next() T {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	}
+	value := it.node.value
+	it.node = it.node.next
+	return value
+}
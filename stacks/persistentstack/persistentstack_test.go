@@ -0,0 +1,157 @@
+package persistentstack
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/phantom820/collections/stacks/vectorstack"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+
+	var zero PersistentStack[int]
+	assert.True(t, zero.Empty())
+	assert.True(t, New[int]().Empty())
+	assert.Equal(t, []int{3, 2, 1}, New(1, 2, 3).ToSlice())
+	assert.Equal(t, 3, New(1, 2, 3).Len())
+}
+
+func TestWithWithout(t *testing.T) {
+
+	empty := New[int]()
+	a := empty.With(1)
+	b := a.With(2)
+	c := a.With(3)
+
+	// Every version is unaffected by the versions derived from it and b and c share the node of a.
+	assert.True(t, empty.Empty())
+	assert.Equal(t, []int{1}, a.ToSlice())
+	assert.Equal(t, []int{2, 1}, b.ToSlice())
+	assert.Equal(t, []int{3, 1}, c.ToSlice())
+	assert.Same(t, a.top, b.top.next)
+	assert.Same(t, a.top, c.top.next)
+
+	assert.Equal(t, optional.Of(2), b.Peek())
+	assert.Equal(t, a, b.Without())
+	assert.Equal(t, empty, a.Without())
+	assert.Equal(t, empty, empty.Without())
+	assert.Equal(t, optional.Empty[int](), empty.Peek())
+	assert.Equal(t, 2, b.Len())
+}
+
+func TestContains(t *testing.T) {
+
+	containsTests := []struct {
+		input    PersistentStack[int]
+		element  int
+		expected bool
+	}{
+		{
+			input:    New[int](),
+			element:  1,
+			expected: false,
+		},
+		{
+			input:    New(1, 2, 3),
+			element:  4,
+			expected: false,
+		},
+		{
+			input:    New(1, 2, 3),
+			element:  1,
+			expected: true,
+		},
+	}
+
+	for _, test := range containsTests {
+		assert.Equal(t, test.expected, test.input.Contains(test.element))
+	}
+}
+
+func TestEquals(t *testing.T) {
+
+	stack := New(1, 2, 3)
+	assert.True(t, stack.Equals(stack))
+	assert.True(t, stack.Equals(New(1, 2, 3)))
+	assert.True(t, stack.Equals(vectorstack.New(1, 2, 3)))
+	assert.False(t, stack.Equals(New(1, 2)))
+	assert.False(t, stack.Equals(New(3, 2, 1)))
+}
+
+func TestIteration(t *testing.T) {
+
+	stack := New(1, 2, 3)
+	it := stack.Iterator()
+	assert.Equal(t, []int{3, 2, 1}, iterator.ToSlice(it))
+	assert.PanicsWithError(t, errors.NoSuchElement().Error(), func() { it.Next() })
+
+	elements := make([]int, 0)
+	for e := range stack.All() {
+		elements = append(elements, e)
+	}
+	assert.Equal(t, []int{3, 2, 1}, elements)
+
+	for range stack.All() {
+		break
+	}
+
+	elements = make([]int, 0)
+	stack.ForEach(func(e int) { elements = append(elements, e) })
+	assert.Equal(t, []int{3, 2, 1}, elements)
+	assert.Equal(t, "[3 2 1]", stack.String())
+	assert.Equal(t, "[]", New[int]().String())
+}
+
+func TestConcurrentVersions(t *testing.T) {
+
+	base := New(1, 2, 3)
+
+	// Versions can be derived from a shared stack by many goroutines without synchronization.
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			stack := base
+			for i := 0; i < 100; i++ {
+				stack = stack.With(g)
+			}
+			for i := 0; i < 100; i++ {
+				assert.Equal(t, optional.Of(g), stack.Peek())
+				stack = stack.Without()
+			}
+			assert.Equal(t, base, stack)
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, []int{3, 2, 1}, base.ToSlice())
+}
+
+func TestUnsupportedOperations(t *testing.T) {
+
+	stack := New(1, 2, 3)
+	operations := map[string]func(){
+		"Push":        func() { stack.Push(4) },
+		"Pop":         func() { stack.Pop() },
+		"Add":         func() { stack.Add(4) },
+		"AddAll":      func() { stack.AddAll(vector.Of(4)) },
+		"AddSlice":    func() { stack.AddSlice([]int{4}) },
+		"Clear":       func() { stack.Clear() },
+		"Remove":      func() { stack.Remove(1) },
+		"RemoveIf":    func() { stack.RemoveIf(func(int) bool { return true }) },
+		"RemoveAll":   func() { stack.RemoveAll(vector.Of(1)) },
+		"RemoveSlice": func() { stack.RemoveSlice([]int{1}) },
+		"RetainAll":   func() { stack.RetainAll(hashset.New(1)) },
+	}
+
+	for operation, f := range operations {
+		assert.PanicsWithError(t, errors.UnsupportedOperation(operation, "PersistentStack").Error(), f)
+	}
+	assert.Equal(t, []int{3, 2, 1}, stack.ToSlice())
+}
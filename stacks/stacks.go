@@ -0,0 +1,127 @@
+// package stacks defines adapters that let other containers be used as a [collections.Stack].
+package stacks
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// DequeueStack a view of a dequeue as a stack, the top of the stack is the front of the dequeue. The view is backed by the dequeue,
+// changes to either are visible in the other.
+type DequeueStack[T comparable] struct {
+	dequeue collections.Dequeue[T]
+}
+
+// AsStack returns a view of the given dequeue as a stack whose top is the front of the dequeue.
+func AsStack[T comparable](dequeue collections.Dequeue[T]) *DequeueStack[T] {
+	return &DequeueStack[T]{dequeue: dequeue}
+}
+
+// Push adds an element to the top of the stack and returns the previous top element as an option.
+func (stack *DequeueStack[T]) Push(e T) optional.Optional[T] {
+	return stack.dequeue.AddFirst(e)
+}
+
+// Peek returns the top element of the stack as an option.
+func (stack *DequeueStack[T]) Peek() optional.Optional[T] {
+	return stack.dequeue.PeekFirst()
+}
+
+// Pop returns and removes the top element of the stack as an option.
+func (stack *DequeueStack[T]) Pop() optional.Optional[T] {
+	return stack.dequeue.RemoveFirst()
+}
+
+// Add pushes the element onto the stack and returns true.
+func (stack *DequeueStack[T]) Add(e T) bool {
+	stack.Push(e)
+	return true
+}
+
+// AddAll pushes all of the elements in the specified iterable onto the stack in iteration order.
+func (stack *DequeueStack[T]) AddAll(iterable iterable.Iterable[T]) bool {
+	return stack.AddSlice(iterator.ToSlice(iterable.Iterator()))
+}
+
+// AddSlice pushes all of the elements in the slice onto the stack in order.
+func (stack *DequeueStack[T]) AddSlice(s []T) bool {
+	for _, e := range s {
+		stack.Push(e)
+	}
+	return len(s) > 0
+}
+
+// Clear removes all of the elements from the stack.
+func (stack *DequeueStack[T]) Clear() {
+	stack.dequeue.Clear()
+}
+
+// Contains returns true if the stack contains the specified element.
+func (stack *DequeueStack[T]) Contains(e T) bool {
+	return stack.dequeue.Contains(e)
+}
+
+// Empty returns true if the stack contains no elements.
+func (stack *DequeueStack[T]) Empty() bool {
+	return stack.dequeue.Empty()
+}
+
+// Len returns the number of elements in the stack.
+func (stack *DequeueStack[T]) Len() int {
+	return stack.dequeue.Len()
+}
+
+// Remove removes the occurence of the element closest to the top of the stack and returns true if the stack changed as a result.
+func (stack *DequeueStack[T]) Remove(e T) bool {
+	return stack.dequeue.Remove(e)
+}
+
+// RemoveIf removes all of the elements of the stack that satisfy the given predicate.
+func (stack *DequeueStack[T]) RemoveIf(f func(T) bool) bool {
+	return stack.dequeue.RemoveIf(f)
+}
+
+// RemoveAll removes all of the stack's elements that are also contained in the specified iterable.
+func (stack *DequeueStack[T]) RemoveAll(iterable iterable.Iterable[T]) bool {
+	return stack.dequeue.RemoveAll(iterable)
+}
+
+// RemoveSlice removes all of the stack's elements that are also contained in the specified slice.
+func (stack *DequeueStack[T]) RemoveSlice(s []T) bool {
+	return stack.dequeue.RemoveSlice(s)
+}
+
+// RetainAll retains only the elements in the stack that are contained in the specified collection.
+func (stack *DequeueStack[T]) RetainAll(c collections.Collection[T]) bool {
+	return stack.dequeue.RetainAll(c)
+}
+
+// ForEach performs the given action for each element of the stack from top to bottom.
+func (stack *DequeueStack[T]) ForEach(f func(T)) {
+	stack.dequeue.ForEach(f)
+}
+
+// ToSlice returns a slice containing the elements of the stack from top to bottom.
+func (stack *DequeueStack[T]) ToSlice() []T {
+	return stack.dequeue.ToSlice()
+}
+
+// Iterator returns an iterator over the elements of the stack from top to bottom.
+func (stack *DequeueStack[T]) Iterator() iterator.Iterator[T] {
+	return stack.dequeue.Iterator()
+}
+
+// All returns a sequence over the elements of the stack from top to bottom.
+func (stack *DequeueStack[T]) All() iter.Seq[T] {
+	return stack.dequeue.All()
+}
+
+// String returns the string representation of the stack from top to bottom.
+func (stack *DequeueStack[T]) String() string {
+	return fmt.Sprint(stack.dequeue)
+}
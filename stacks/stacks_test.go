@@ -0,0 +1,100 @@
+package stacks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/lists/vector"
+	"github.com/phantom820/collections/queues/listdequeue"
+	"github.com/phantom820/collections/queues/vectordequeue"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/phantom820/collections/stacks/liststack"
+	"github.com/phantom820/collections/stacks/persistentstack"
+	"github.com/phantom820/collections/stacks/vectorstack"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsStack(t *testing.T) {
+
+	stacks := []collections.Stack[int]{
+		vectorstack.New[int](),
+		liststack.New[int](),
+		persistentstack.New[int](),
+		AsStack[int](listdequeue.New[int]()),
+		AsStack[int](vectordequeue.New[int]()),
+	}
+	for _, stack := range stacks {
+		assert.True(t, stack.Empty())
+	}
+}
+
+func TestAsStack(t *testing.T) {
+
+	dequeue := listdequeue.New(3, 2, 1)
+	stack := AsStack[int](dequeue)
+
+	// The top of the stack is the front of the dequeue and changes are visible through both.
+	assert.Equal(t, optional.Of(3), stack.Peek())
+	assert.Equal(t, optional.Of(3), stack.Push(4))
+	assert.Equal(t, optional.Of(4), dequeue.PeekFirst())
+	dequeue.AddFirst(5)
+	assert.Equal(t, optional.Of(5), stack.Pop())
+	assert.Equal(t, optional.Of(4), stack.Pop())
+	assert.Equal(t, 3, stack.Len())
+	assert.Equal(t, []int{3, 2, 1}, stack.ToSlice())
+	assert.Equal(t, []int{3, 2, 1}, iterator.ToSlice(stack.Iterator()))
+	assert.Equal(t, fmt.Sprint(dequeue), stack.String())
+
+	assert.True(t, stack.Add(4))
+	assert.True(t, stack.AddSlice([]int{5, 6}))
+	assert.False(t, stack.AddSlice([]int{}))
+	assert.True(t, stack.AddAll(vector.Of(7)))
+	assert.Equal(t, []int{7, 6, 5, 4, 3, 2, 1}, stack.ToSlice())
+	assert.True(t, stack.Contains(7))
+	assert.False(t, stack.Contains(8))
+
+	assert.True(t, stack.Remove(7))
+	assert.True(t, stack.RemoveIf(func(e int) bool { return e == 6 }))
+	assert.True(t, stack.RemoveSlice([]int{5}))
+	assert.True(t, stack.RemoveAll(vector.Of(4)))
+	assert.True(t, stack.RetainAll(hashset.New(1, 3)))
+	assert.Equal(t, []int{3, 1}, dequeue.ToSlice())
+
+	elements := make([]int, 0)
+	stack.ForEach(func(e int) { elements = append(elements, e) })
+	for e := range stack.All() {
+		elements = append(elements, e)
+	}
+	assert.Equal(t, []int{3, 1, 3, 1}, elements)
+
+	stack.Clear()
+	assert.True(t, stack.Empty())
+	assert.True(t, dequeue.Empty())
+	assert.True(t, stack.Pop().Empty())
+	assert.True(t, stack.Peek().Empty())
+}
+
+func TestStacks(t *testing.T) {
+
+	constructors := map[string]func() collections.Stack[int]{
+		"VectorStack":  func() collections.Stack[int] { return vectorstack.New[int]() },
+		"ListStack":    func() collections.Stack[int] { return liststack.New[int]() },
+		"DequeueStack": func() collections.Stack[int] { return AsStack[int](vectordequeue.New[int]()) },
+	}
+
+	// All implementations agree on which end is the top.
+	for name, constructor := range constructors {
+		stack := constructor()
+		for i := 1; i <= 100; i++ {
+			stack.Push(i)
+		}
+		for i := 100; i >= 1; i-- {
+			assert.Equal(t, optional.Of(i), stack.Peek(), name)
+			assert.Equal(t, optional.Of(i), stack.Pop(), name)
+		}
+		assert.True(t, stack.Empty(), name)
+	}
+}
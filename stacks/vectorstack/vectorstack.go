@@ -0,0 +1,225 @@
+// package vectorstack defines an implementation of a stack that is backed by a slice.
+package vectorstack
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/errors"
+	"github.com/phantom820/collections/iterable"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// VectorStack a stack backed by a slice, the top of the stack is the end of the slice.
+type VectorStack[T comparable] struct {
+	data     []T
+	modCount int // The number of structural modifications, used to detect modifications during iteration.
+}
+
+// New creates a stack with the given elements pushed in order, the last element is at the top.
+func New[T comparable](elements ...T) *VectorStack[T] {
+	stack := VectorStack[T]{data: make([]T, 0, len(elements))}
+	stack.AddSlice(elements)
+	return &stack
+}
+
+// Push adds an element to the top of the stack and returns the previous top element as an option.
+func (stack *VectorStack[T]) Push(e T) optional.Optional[T] {
+	top := stack.Peek()
+	stack.data = append(stack.data, e)
+	stack.modCount++
+	return top
+}
+
+// Peek returns the top element of the stack as an option.
+func (stack *VectorStack[T]) Peek() optional.Optional[T] {
+	if stack.Empty() {
+		return optional.Empty[T]()
+	}
+	return optional.Of(stack.data[len(stack.data)-1])
+}
+
+// Pop returns and removes the top element of the stack as an option.
+func (stack *VectorStack[T]) Pop() optional.Optional[T] {
+	if stack.Empty() {
+		return optional.Empty[T]()
+	}
+	n := len(stack.data) - 1
+	e := stack.data[n]
+	var zero T
+	stack.data[n] = zero
+	stack.data = stack.data[:n]
+	stack.modCount++
+	return optional.Of(e)
+}
+
+// Add pushes the element onto the stack and returns true.
+func (stack *VectorStack[T]) Add(e T) bool {
+	stack.Push(e)
+	return true
+}
+
+// AddAll pushes all of the elements in the specified iterable onto the stack in iteration order.
+func (stack *VectorStack[T]) AddAll(iterable iterable.Iterable[T]) bool {
+	return stack.AddSlice(iterator.ToSlice(iterable.Iterator()))
+}
+
+// AddSlice pushes all of the elements in the slice onto the stack in order.
+func (stack *VectorStack[T]) AddSlice(s []T) bool {
+	if len(s) == 0 {
+		return false
+	}
+	stack.data = append(stack.data, s...)
+	stack.modCount++
+	return true
+}
+
+// Clear removes all of the elements from the stack.
+func (stack *VectorStack[T]) Clear() {
+	stack.data = make([]T, 0)
+	stack.modCount++
+}
+
+// Contains returns true if the stack contains the specified element.
+func (stack *VectorStack[T]) Contains(e T) bool {
+	return slices.Contains(stack.data, e)
+}
+
+// Empty returns true if the stack contains no elements.
+func (stack *VectorStack[T]) Empty() bool {
+	return len(stack.data) == 0
+}
+
+// Len returns the number of elements in the stack.
+func (stack *VectorStack[T]) Len() int {
+	return len(stack.data)
+}
+
+// Remove removes the occurence of the element closest to the top of the stack and returns true if the stack changed as a result.
+func (stack *VectorStack[T]) Remove(e T) bool {
+	for i := len(stack.data) - 1; i >= 0; i-- {
+		if stack.data[i] == e {
+			stack.data = slices.Delete(stack.data, i, i+1)
+			stack.modCount++
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveIf removes all of the elements of the stack that satisfy the given predicate.
+func (stack *VectorStack[T]) RemoveIf(f func(T) bool) bool {
+	n := len(stack.data)
+	stack.data = slices.DeleteFunc(stack.data, f)
+	if n == len(stack.data) {
+		return false
+	}
+	stack.modCount++
+	return true
+}
+
+// RemoveAll removes all of the stack's elements that are also contained in the specified iterable.
+func (stack *VectorStack[T]) RemoveAll(iterable iterable.Iterable[T]) bool {
+	return stack.RemoveSlice(iterator.ToSlice(iterable.Iterator()))
+}
+
+// RemoveSlice removes all of the stack's elements that are also contained in the specified slice.
+func (stack *VectorStack[T]) RemoveSlice(s []T) bool {
+	elements := make(map[T]struct{}, len(s))
+	for _, e := range s {
+		elements[e] = struct{}{}
+	}
+	return stack.RemoveIf(func(e T) bool {
+		_, ok := elements[e]
+		return ok
+	})
+}
+
+// RetainAll retains only the elements in the stack that are contained in the specified collection.
+func (stack *VectorStack[T]) RetainAll(c collections.Collection[T]) bool {
+	return stack.RemoveIf(func(e T) bool { return !c.Contains(e) })
+}
+
+// ForEach performs the given action for each element of the stack from top to bottom.
+func (stack *VectorStack[T]) ForEach(f func(T)) {
+	for i := len(stack.data) - 1; i >= 0; i-- {
+		f(stack.data[i])
+	}
+}
+
+// ToSlice returns a slice containing the elements of the stack from top to bottom.
+func (stack *VectorStack[T]) ToSlice() []T {
+	slice := slices.Clone(stack.data)
+	slices.Reverse(slice)
+	return slice
+}
+
+// Equals returns true if the stack is equivalent to the given stack. Two stacks are equal if they have the same size and contain the
+// same elements in the same order.
+func (stack *VectorStack[T]) Equals(other collections.Stack[T]) bool {
+	if stack == other {
+		return true
+	} else if stack.Len() != other.Len() {
+		return false
+	}
+	it := other.Iterator()
+	for i := len(stack.data) - 1; i >= 0; i-- {
+		if stack.data[i] != it.Next() {
+			return false
+		}
+	}
+	return true
+}
+
+// Iterator returns an iterator over the elements of the stack from top to bottom. The iterator is fail fast, Next panics if the stack
+// is structurally modified after iteration has started.
+func (stack *VectorStack[T]) Iterator() iterator.Iterator[T] {
+	return &stackIterator[T]{stack: stack, index: len(stack.data) - 1, modCount: stack.modCount}
+}
+
+// All returns a sequence over the elements of the stack from top to bottom. The sequence panics if the stack is structurally modified
+// while ranging over it.
+func (stack *VectorStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		modCount := stack.modCount
+		for i := len(stack.data) - 1; i >= 0; i-- {
+			if !yield(stack.data[i]) {
+				return
+			} else if modCount != stack.modCount {
+				panic(errors.ConcurrentModification("VectorStack"))
+			}
+		}
+	}
+}
+
+// stackIterator iterator implementation for [VectorStack].
+type stackIterator[T comparable] struct {
+	stack    *VectorStack[T]
+	index    int
+	modCount int // The expected modification count of the stack.
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *stackIterator[T]) HasNext() bool {
+	return it.index >= 0
+}
+
+// Next returns the next element in the iterator.
+func (it *stackIterator[T]) Next() T {
+	if !it.HasNext() {
+		panic(errors.NoSuchElement())
+	} else if it.modCount != it.stack.modCount {
+		panic(errors.ConcurrentModification("VectorStack"))
+	}
+	e := it.stack.data[it.index]
+	it.index--
+	return e
+}
+
+// String returns the string representation of the stack from top to bottom.
+func (stack *VectorStack[T]) String() string {
+	return fmt.Sprint(stack.ToSlice())
+}
@@ -0,0 +1,41 @@
+package table
+
+import (
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/maps/linkedhashmap"
+	"github.com/phantom820/collections/sets/hashset"
+	"github.com/phantom820/collections/sets/linkedhashset"
+)
+
+// HashTable a [Table] that keeps its rows and the columns of each row in a [hashmap.HashMap], cells are iterated on in no particular
+// order.
+type HashTable[R comparable, C comparable, V comparable] struct {
+	table[R, C, V]
+}
+
+// NewHashTable creates an empty table.
+func NewHashTable[R comparable, C comparable, V comparable]() *HashTable[R, C, V] {
+	table := HashTable[R, C, V]{}
+	table.rows = hashmap.New[R, collections.Map[C, V]]()
+	table.newRow = func() collections.Map[C, V] { return hashmap.New[C, V]() }
+	table.newRowKeySet = func() collections.Set[R] { return hashset.New[R]() }
+	table.newColumnKeySet = func() collections.Set[C] { return hashset.New[C]() }
+	return &table
+}
+
+// LinkedTable a [Table] that keeps its rows and the columns of each row in a [linkedhashmap.LinkedHashMap], so both are iterated on in
+// the order in which they were first added.
+type LinkedTable[R comparable, C comparable, V comparable] struct {
+	table[R, C, V]
+}
+
+// NewLinkedTable creates an empty table.
+func NewLinkedTable[R comparable, C comparable, V comparable]() *LinkedTable[R, C, V] {
+	table := LinkedTable[R, C, V]{}
+	table.rows = linkedhashmap.New[R, collections.Map[C, V]]()
+	table.newRow = func() collections.Map[C, V] { return linkedhashmap.New[C, V]() }
+	table.newRowKeySet = func() collections.Set[R] { return linkedhashset.New[R]() }
+	table.newColumnKeySet = func() collections.Set[C] { return linkedhashset.New[C]() }
+	return &table
+}
@@ -0,0 +1,298 @@
+// package table defines maps with two keys, a row key and a column key, that are mapped to a single value. The rows are kept in the
+// map types of this module and each row maps its column keys to values.
+//
+//  1. HashTable[R, C, V] : Rows and the columns of each row are kept in a [hashmap.HashMap] with no particular ordering.
+//  2. LinkedTable[R, C, V] : Rows and the columns of each row are kept in a [linkedhashmap.LinkedHashMap] and are iterated on in the
+//     order in which they were first added.
+//  3. TreeTable[R, C, V] : Rows and the columns of each row are kept in a [treemap.TreeMap] and are iterated on in sorted order.
+package table
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/sets/linkedhashset"
+	"github.com/phantom820/collections/types/optional"
+)
+
+// Cell a row key, column key and value of a table.
+type Cell[R any, C any, V any] struct {
+	rowKey    R
+	columnKey C
+	value     V
+}
+
+// RowKey returns the row key of the cell.
+func (cell Cell[R, C, V]) RowKey() R {
+	return cell.rowKey
+}
+
+// ColumnKey returns the column key of the cell.
+func (cell Cell[R, C, V]) ColumnKey() C {
+	return cell.columnKey
+}
+
+// Value returns the value of the cell.
+func (cell Cell[R, C, V]) Value() V {
+	return cell.value
+}
+
+// CellOf creates a cell with the given row key, column key and value.
+func CellOf[R any, C any, V any](rowKey R, columnKey C, value V) Cell[R, C, V] {
+	return Cell[R, C, V]{rowKey: rowKey, columnKey: columnKey, value: value}
+}
+
+// Table a collection that maps a pair of keys, a row key and a column key, to a single value. The views returned by Row and Column are
+// backed by the table, changes to a view are reflected in the table and vice versa.
+type Table[R comparable, C comparable, V comparable] interface {
+	Put(r R, c C, v V) optional.Optional[V]  // Maps the row and column keys to the value and optionally returns the previously bound value.
+	Get(r R, c C) optional.Optional[V]       // Optionally returns the value mapped to the row and column keys.
+	Remove(r R, c C) optional.Optional[V]    // Removes the mapping of the row and column keys and optionally returns the value that was bound.
+	Contains(r R, c C) bool                  // Returns true if the table has a mapping for the row and column keys.
+	ContainsRow(r R) bool                    // Returns true if the table has a mapping with the row key.
+	ContainsColumn(c C) bool                 // Returns true if the table has a mapping with the column key.
+	ContainsValue(v V) bool                  // Returns true if any pair of keys is mapped to the value.
+	Row(r R) collections.Map[C, V]           // Returns a view of the mappings with the row key, from column key to value.
+	Column(c C) collections.Map[R, V]        // Returns a view of the mappings with the column key, from row key to value.
+	RowKeySet() collections.Set[R]           // Returns a set containing the row keys of the table.
+	ColumnKeySet() collections.Set[C]        // Returns a set containing the column keys of the table.
+	CellSet() collections.Set[Cell[R, C, V]] // Returns a set containing the cells of the table.
+	All() iter.Seq[Cell[R, C, V]]            // Returns a sequence over the cells of the table.
+	ForEach(f func(r R, c C, v V))           // Performs the given action for each cell of the table.
+	Len() int                                // Returns the number of cells in the table.
+	Empty() bool                             // Returns true if the table has no cells.
+	Clear()                                  // Removes all the cells from the table.
+	Equals(table Table[R, C, V]) bool        // Returns true if both tables have the same cells.
+}
+
+// table the implementation of [Table] shared by the hash, linked and tree tables.
+type table[R comparable, C comparable, V comparable] struct {
+	rows            collections.Map[R, collections.Map[C, V]]
+	newRow          func() collections.Map[C, V] // Creates the map that holds the columns of a row.
+	newRowKeySet    func() collections.Set[R]    // Creates the set returned by RowKeySet.
+	newColumnKeySet func() collections.Set[C]    // Creates the set returned by ColumnKeySet.
+	len             int
+}
+
+// row returns the map holding the columns of the row, the row is only in the table while it has at least one column.
+func (table *table[R, C, V]) row(r R) (collections.Map[C, V], bool) {
+	if row := table.rows.Get(r); !row.Empty() {
+		return row.Value(), true
+	}
+	return nil, false
+}
+
+// Put maps the row and column keys to the value and optionally returns the previously bound value.
+func (table *table[R, C, V]) Put(r R, c C, v V) optional.Optional[V] {
+	row, ok := table.row(r)
+	if !ok {
+		row = table.newRow()
+		table.rows.Put(r, row)
+	}
+	previous := row.Put(c, v)
+	if previous.Empty() {
+		table.len++
+	}
+	return previous
+}
+
+// Get optionally returns the value mapped to the row and column keys.
+func (table *table[R, C, V]) Get(r R, c C) optional.Optional[V] {
+	if row, ok := table.row(r); ok {
+		return row.Get(c)
+	}
+	return optional.Empty[V]()
+}
+
+// Remove removes the mapping of the row and column keys and optionally returns the value that was bound. A row is removed from the
+// table along with its last column.
+func (table *table[R, C, V]) Remove(r R, c C) optional.Optional[V] {
+	row, ok := table.row(r)
+	if !ok {
+		return optional.Empty[V]()
+	}
+	previous := row.Remove(c)
+	if !previous.Empty() {
+		table.len--
+		if row.Empty() {
+			table.rows.Remove(r)
+		}
+	}
+	return previous
+}
+
+// Contains returns true if the table has a mapping for the row and column keys.
+func (table *table[R, C, V]) Contains(r R, c C) bool {
+	row, ok := table.row(r)
+	return ok && row.ContainsKey(c)
+}
+
+// ContainsRow returns true if the table has a mapping with the row key.
+func (table *table[R, C, V]) ContainsRow(r R) bool {
+	return table.rows.ContainsKey(r)
+}
+
+// ContainsColumn returns true if the table has a mapping with the column key. This checks each of the rows.
+func (table *table[R, C, V]) ContainsColumn(c C) bool {
+	for row := range table.rows.AllValues() {
+		if row.ContainsKey(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsValue returns true if any pair of keys is mapped to the value.
+func (table *table[R, C, V]) ContainsValue(v V) bool {
+	for cell := range table.All() {
+		if cell.value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Row returns a view of the mappings with the row key, from column key to value. The view looks up the row on every operation, so it
+// stays valid while the row is removed and added back.
+func (table *table[R, C, V]) Row(r R) collections.Map[C, V] {
+	return &mapView[C, V]{
+		get:    func(c C) optional.Optional[V] { return table.Get(r, c) },
+		put:    func(c C, v V) optional.Optional[V] { return table.Put(r, c, v) },
+		remove: func(c C) optional.Optional[V] { return table.Remove(r, c) },
+		len: func() int {
+			if row, ok := table.row(r); ok {
+				return row.Len()
+			}
+			return 0
+		},
+		all: func(yield func(C, V) bool) {
+			if row, ok := table.row(r); ok {
+				for c, v := range row.All() {
+					if !yield(c, v) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+// Column returns a view of the mappings with the column key, from row key to value. Rows are visited in the order of iteration of the
+// table and the view checks each of them on every operation that is not a lookup of a single row key, so Len takes a lookup per row.
+func (table *table[R, C, V]) Column(c C) collections.Map[R, V] {
+	return &mapView[R, V]{
+		get:    func(r R) optional.Optional[V] { return table.Get(r, c) },
+		put:    func(r R, v V) optional.Optional[V] { return table.Put(r, c, v) },
+		remove: func(r R) optional.Optional[V] { return table.Remove(r, c) },
+		len: func() int {
+			count := 0
+			for row := range table.rows.AllValues() {
+				if row.ContainsKey(c) {
+					count++
+				}
+			}
+			return count
+		},
+		all: func(yield func(R, V) bool) {
+			for r, row := range table.rows.All() {
+				if v := row.Get(c); !v.Empty() && !yield(r, v.Value()) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// RowKeySet returns a set containing the row keys of the table.
+func (table *table[R, C, V]) RowKeySet() collections.Set[R] {
+	set := table.newRowKeySet()
+	for r := range table.rows.AllKeys() {
+		set.Add(r)
+	}
+	return set
+}
+
+// ColumnKeySet returns a set containing the column keys of the table.
+func (table *table[R, C, V]) ColumnKeySet() collections.Set[C] {
+	set := table.newColumnKeySet()
+	for cell := range table.All() {
+		set.Add(cell.columnKey)
+	}
+	return set
+}
+
+// CellSet returns a set containing the cells of the table, in the iteration order of the table.
+func (table *table[R, C, V]) CellSet() collections.Set[Cell[R, C, V]] {
+	set := linkedhashset.New[Cell[R, C, V]]()
+	for cell := range table.All() {
+		set.Add(cell)
+	}
+	return set
+}
+
+// All returns a sequence over the cells of the table, the cells of a row are visited together.
+func (table *table[R, C, V]) All() iter.Seq[Cell[R, C, V]] {
+	return func(yield func(Cell[R, C, V]) bool) {
+		for r, row := range table.rows.All() {
+			for c, v := range row.All() {
+				if !yield(CellOf(r, c, v)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ForEach performs the given action for each cell of the table.
+func (table *table[R, C, V]) ForEach(f func(r R, c C, v V)) {
+	for cell := range table.All() {
+		f(cell.rowKey, cell.columnKey, cell.value)
+	}
+}
+
+// Len returns the number of cells in the table.
+func (table *table[R, C, V]) Len() int {
+	return table.len
+}
+
+// Empty returns true if the table has no cells.
+func (table *table[R, C, V]) Empty() bool {
+	return table.len == 0
+}
+
+// Clear removes all the cells from the table.
+func (table *table[R, C, V]) Clear() {
+	table.rows.Clear()
+	table.len = 0
+}
+
+// Equals returns true if both tables have the same cells.
+func (table *table[R, C, V]) Equals(other Table[R, C, V]) bool {
+	if table.len != other.Len() {
+		return false
+	}
+	for cell := range table.All() {
+		if v := other.Get(cell.rowKey, cell.columnKey); v.Empty() || v.Value() != cell.value {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the string representation of the table, each row key is followed by the mappings of the row.
+func (table *table[R, C, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for r := range table.rows.AllKeys() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", r, table.Row(r)))
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
@@ -0,0 +1,422 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/phantom820/collections/types/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+func lessThan(a, b string) bool {
+	return a < b
+}
+
+// putCells adds the cells to the table and returns it.
+func putCells[T Table[string, string, int]](table T, cells ...Cell[string, string, int]) T {
+	for _, cell := range cells {
+		table.Put(cell.RowKey(), cell.ColumnKey(), cell.Value())
+	}
+	return table
+}
+
+func TestNew(t *testing.T) {
+
+	for _, table := range []Table[string, string, int]{NewHashTable[string, string, int](), NewLinkedTable[string, string, int](),
+		NewTreeTable[string, string, int](lessThan, lessThan)} {
+		assert.NotNil(t, table)
+		assert.True(t, table.Empty())
+		assert.Equal(t, 0, table.Len())
+		assert.Equal(t, []string{}, table.RowKeySet().ToSlice())
+		assert.Equal(t, []string{}, table.ColumnKeySet().ToSlice())
+		assert.Equal(t, []Cell[string, string, int]{}, table.CellSet().ToSlice())
+	}
+}
+
+func TestPut(t *testing.T) {
+
+	type putTest struct {
+		input    Table[string, string, int]
+		cell     Cell[string, string, int]
+		previous optional.Optional[int]
+		expected int
+	}
+
+	putTests := []putTest{
+		{input: NewHashTable[string, string, int](), cell: CellOf("a", "x", 1), previous: optional.Empty[int](), expected: 1},
+		{input: putCells(NewLinkedTable[string, string, int](), CellOf("a", "x", 1)), cell: CellOf("a", "y", 2),
+			previous: optional.Empty[int](), expected: 2},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), CellOf("a", "x", 1)), cell: CellOf("b", "x", 3),
+			previous: optional.Empty[int](), expected: 2},
+		{input: putCells(NewHashTable[string, string, int](), CellOf("a", "x", 1), CellOf("a", "y", 2)), cell: CellOf("a", "x", 4),
+			previous: optional.Of(1), expected: 2},
+		{input: putCells(NewLinkedTable[string, string, int](), CellOf("a", "x", 1)), cell: CellOf("a", "x", 4),
+			previous: optional.Of(1), expected: 1},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), CellOf("a", "x", 1), CellOf("b", "x", 3)),
+			cell: CellOf("b", "x", 4), previous: optional.Of(3), expected: 2},
+	}
+
+	for _, test := range putTests {
+		assert.Equal(t, test.previous, test.input.Put(test.cell.RowKey(), test.cell.ColumnKey(), test.cell.Value()))
+		assert.Equal(t, optional.Of(test.cell.Value()), test.input.Get(test.cell.RowKey(), test.cell.ColumnKey()))
+		assert.Equal(t, test.expected, test.input.Len())
+	}
+}
+
+func TestGet(t *testing.T) {
+
+	type getTest struct {
+		input    Table[string, string, int]
+		rowKey   string
+		colKey   string
+		expected optional.Optional[int]
+	}
+
+	cells := []Cell[string, string, int]{CellOf("a", "x", 1), CellOf("a", "y", 2), CellOf("b", "x", 3)}
+	getTests := []getTest{
+		{input: NewHashTable[string, string, int](), rowKey: "a", colKey: "x", expected: optional.Empty[int]()},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), rowKey: "a", colKey: "y", expected: optional.Of(2)},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), rowKey: "b", colKey: "x", expected: optional.Of(3)},
+		{input: putCells(NewHashTable[string, string, int](), cells...), rowKey: "b", colKey: "y", expected: optional.Empty[int]()},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), rowKey: "c", colKey: "x", expected: optional.Empty[int]()},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), rowKey: "c", colKey: "z",
+			expected: optional.Empty[int]()},
+	}
+
+	for _, test := range getTests {
+		assert.Equal(t, test.expected, test.input.Get(test.rowKey, test.colKey))
+	}
+}
+
+func TestRemove(t *testing.T) {
+
+	type removeTest struct {
+		input       Table[string, string, int]
+		rowKey      string
+		colKey      string
+		expected    optional.Optional[int]
+		containsRow bool
+		len         int
+	}
+
+	cells := []Cell[string, string, int]{CellOf("a", "x", 1), CellOf("a", "y", 2)}
+	removeTests := []removeTest{
+		{input: NewHashTable[string, string, int](), rowKey: "a", colKey: "x", expected: optional.Empty[int](), containsRow: false, len: 0},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), rowKey: "a", colKey: "z", expected: optional.Empty[int](),
+			containsRow: true, len: 2},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), rowKey: "b", colKey: "x",
+			expected: optional.Empty[int](), containsRow: true, len: 2},
+		{input: putCells(NewHashTable[string, string, int](), cells...), rowKey: "a", colKey: "x", expected: optional.Of(1),
+			containsRow: true, len: 1},
+		// A row is removed with its last column.
+		{input: putCells(NewLinkedTable[string, string, int](), CellOf("a", "y", 2)), rowKey: "a", colKey: "y", expected: optional.Of(2),
+			containsRow: false, len: 0},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), CellOf("a", "y", 2)), rowKey: "a", colKey: "y",
+			expected: optional.Of(2), containsRow: false, len: 0},
+	}
+
+	for _, test := range removeTests {
+		assert.Equal(t, test.expected, test.input.Remove(test.rowKey, test.colKey))
+		assert.False(t, test.input.Contains(test.rowKey, test.colKey))
+		assert.Equal(t, test.containsRow, test.input.ContainsRow("a"))
+		assert.Equal(t, test.len, test.input.Len())
+		assert.Equal(t, test.len == 0, test.input.Empty())
+	}
+}
+
+func TestContains(t *testing.T) {
+
+	type containsTest struct {
+		input    Table[string, string, int]
+		rowKey   string
+		colKey   string
+		expected bool
+	}
+
+	cells := []Cell[string, string, int]{CellOf("a", "x", 1), CellOf("b", "y", 2)}
+	containsTests := []containsTest{
+		{input: NewHashTable[string, string, int](), rowKey: "a", colKey: "x", expected: false},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), rowKey: "a", colKey: "x", expected: true},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), rowKey: "a", colKey: "y", expected: false},
+		{input: putCells(NewHashTable[string, string, int](), cells...), rowKey: "b", colKey: "y", expected: true},
+	}
+
+	for _, test := range containsTests {
+		assert.Equal(t, test.expected, test.input.Contains(test.rowKey, test.colKey))
+	}
+}
+
+func TestContainsRow(t *testing.T) {
+
+	type containsRowTest struct {
+		input    Table[string, string, int]
+		rowKey   string
+		expected bool
+	}
+
+	cells := []Cell[string, string, int]{CellOf("a", "x", 1), CellOf("b", "y", 2)}
+	containsRowTests := []containsRowTest{
+		{input: NewHashTable[string, string, int](), rowKey: "a", expected: false},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), rowKey: "b", expected: true},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), rowKey: "x", expected: false},
+		{input: putCells(NewHashTable[string, string, int](), cells...), rowKey: "a", expected: true},
+	}
+
+	for _, test := range containsRowTests {
+		assert.Equal(t, test.expected, test.input.ContainsRow(test.rowKey))
+	}
+}
+
+func TestContainsColumn(t *testing.T) {
+
+	type containsColumnTest struct {
+		input    Table[string, string, int]
+		colKey   string
+		expected bool
+	}
+
+	cells := []Cell[string, string, int]{CellOf("a", "x", 1), CellOf("b", "y", 2)}
+	containsColumnTests := []containsColumnTest{
+		{input: NewHashTable[string, string, int](), colKey: "x", expected: false},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), colKey: "y", expected: true},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), colKey: "a", expected: false},
+		{input: putCells(NewHashTable[string, string, int](), cells...), colKey: "x", expected: true},
+	}
+
+	for _, test := range containsColumnTests {
+		assert.Equal(t, test.expected, test.input.ContainsColumn(test.colKey))
+	}
+}
+
+func TestContainsValue(t *testing.T) {
+
+	type containsValueTest struct {
+		input    Table[string, string, int]
+		value    int
+		expected bool
+	}
+
+	cells := []Cell[string, string, int]{CellOf("a", "x", 1), CellOf("b", "y", 2)}
+	containsValueTests := []containsValueTest{
+		{input: NewHashTable[string, string, int](), value: 1, expected: false},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), value: 2, expected: true},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), value: 3, expected: false},
+		{input: putCells(NewHashTable[string, string, int](), cells...), value: 1, expected: true},
+	}
+
+	for _, test := range containsValueTests {
+		assert.Equal(t, test.expected, test.input.ContainsValue(test.value))
+	}
+}
+
+func TestRowKeySet(t *testing.T) {
+
+	type rowKeySetTest struct {
+		input    Table[string, string, int]
+		ordered  bool
+		expected []string
+	}
+
+	cells := []Cell[string, string, int]{CellOf("b", "y", 1), CellOf("a", "y", 2), CellOf("b", "x", 3)}
+	rowKeySetTests := []rowKeySetTest{
+		{input: putCells(NewHashTable[string, string, int](), cells...), ordered: false, expected: []string{"a", "b"}},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), ordered: true, expected: []string{"b", "a"}},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), ordered: true, expected: []string{"a", "b"}},
+	}
+
+	for _, test := range rowKeySetTests {
+		if test.ordered {
+			assert.Equal(t, test.expected, test.input.RowKeySet().ToSlice())
+		} else {
+			assert.ElementsMatch(t, test.expected, test.input.RowKeySet().ToSlice())
+		}
+
+		// The set is a copy.
+		test.input.RowKeySet().Add("c")
+		assert.False(t, test.input.ContainsRow("c"))
+	}
+}
+
+func TestColumnKeySet(t *testing.T) {
+
+	type columnKeySetTest struct {
+		input    Table[string, string, int]
+		ordered  bool
+		expected []string
+	}
+
+	cells := []Cell[string, string, int]{CellOf("b", "y", 1), CellOf("a", "y", 2), CellOf("b", "x", 3)}
+	columnKeySetTests := []columnKeySetTest{
+		{input: putCells(NewHashTable[string, string, int](), cells...), ordered: false, expected: []string{"x", "y"}},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), ordered: true, expected: []string{"y", "x"}},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), ordered: true, expected: []string{"x", "y"}},
+	}
+
+	for _, test := range columnKeySetTests {
+		if test.ordered {
+			assert.Equal(t, test.expected, test.input.ColumnKeySet().ToSlice())
+		} else {
+			assert.ElementsMatch(t, test.expected, test.input.ColumnKeySet().ToSlice())
+		}
+
+		// The set is a copy.
+		test.input.ColumnKeySet().Add("z")
+		assert.False(t, test.input.ContainsColumn("z"))
+	}
+}
+
+func TestCellSet(t *testing.T) {
+
+	type cellSetTest struct {
+		input    Table[string, string, int]
+		ordered  bool
+		expected []Cell[string, string, int]
+	}
+
+	cells := []Cell[string, string, int]{CellOf("b", "y", 1), CellOf("a", "y", 2), CellOf("b", "x", 3)}
+	cellSetTests := []cellSetTest{
+		{input: putCells(NewHashTable[string, string, int](), cells...), ordered: false, expected: cells},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), ordered: true,
+			expected: []Cell[string, string, int]{CellOf("b", "y", 1), CellOf("b", "x", 3), CellOf("a", "y", 2)}},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), ordered: true,
+			expected: []Cell[string, string, int]{CellOf("a", "y", 2), CellOf("b", "x", 3), CellOf("b", "y", 1)}},
+	}
+
+	for _, test := range cellSetTests {
+		if test.ordered {
+			assert.Equal(t, test.expected, test.input.CellSet().ToSlice())
+		} else {
+			assert.ElementsMatch(t, test.expected, test.input.CellSet().ToSlice())
+		}
+	}
+}
+
+func TestForEach(t *testing.T) {
+
+	type forEachTest struct {
+		input    Table[string, string, int]
+		expected []Cell[string, string, int]
+	}
+
+	cells := []Cell[string, string, int]{CellOf("b", "y", 1), CellOf("a", "x", 2), CellOf("b", "x", 3)}
+	forEachTests := []forEachTest{
+		{input: NewHashTable[string, string, int](), expected: []Cell[string, string, int]{}},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), expected: cells},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), expected: cells},
+	}
+
+	for _, test := range forEachTests {
+		forEachCells := make([]Cell[string, string, int], 0)
+		test.input.ForEach(func(r, c string, v int) { forEachCells = append(forEachCells, CellOf(r, c, v)) })
+		assert.ElementsMatch(t, test.expected, forEachCells)
+	}
+}
+
+func TestAll(t *testing.T) {
+
+	type allTest struct {
+		input    Table[string, string, int]
+		expected []Cell[string, string, int]
+	}
+
+	cells := []Cell[string, string, int]{CellOf("b", "y", 1), CellOf("a", "x", 2), CellOf("b", "x", 3)}
+	allTests := []allTest{
+		{input: NewHashTable[string, string, int](), expected: []Cell[string, string, int]{}},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), expected: cells},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), expected: cells},
+	}
+
+	for _, test := range allTests {
+		allCells := make([]Cell[string, string, int], 0)
+		for cell := range test.input.All() {
+			allCells = append(allCells, cell)
+		}
+		assert.ElementsMatch(t, test.expected, allCells)
+
+		count := 0
+		for range test.input.All() {
+			count++
+			break
+		}
+		assert.Equal(t, min(1, len(test.expected)), count)
+	}
+}
+
+func TestClear(t *testing.T) {
+
+	type clearTest struct {
+		input Table[string, string, int]
+	}
+
+	cells := []Cell[string, string, int]{CellOf("a", "x", 1), CellOf("b", "y", 2)}
+	clearTests := []clearTest{
+		{input: NewHashTable[string, string, int]()},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...)},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...)},
+	}
+
+	for _, test := range clearTests {
+		test.input.Clear()
+		assert.True(t, test.input.Empty())
+		assert.Equal(t, 0, test.input.Len())
+		assert.False(t, test.input.Contains("a", "x"))
+		assert.False(t, test.input.ContainsRow("b"))
+	}
+}
+
+func TestEquals(t *testing.T) {
+
+	type equalsTest struct {
+		a        Table[string, string, int]
+		b        Table[string, string, int]
+		expected bool
+	}
+
+	equalsTests := []equalsTest{
+		{
+			a:        NewHashTable[string, string, int](),
+			b:        NewTreeTable[string, string, int](lessThan, lessThan),
+			expected: true,
+		},
+		{
+			a:        putCells(NewHashTable[string, string, int](), CellOf("a", "x", 1), CellOf("b", "y", 2)),
+			b:        putCells(NewTreeTable[string, string, int](lessThan, lessThan), CellOf("b", "y", 2), CellOf("a", "x", 1)),
+			expected: true,
+		},
+		{
+			a:        putCells(NewLinkedTable[string, string, int](), CellOf("a", "x", 1), CellOf("b", "y", 2)),
+			b:        putCells(NewHashTable[string, string, int](), CellOf("a", "x", 3), CellOf("b", "y", 2)),
+			expected: false,
+		},
+		{
+			a:        putCells(NewTreeTable[string, string, int](lessThan, lessThan), CellOf("a", "x", 1), CellOf("b", "y", 2)),
+			b:        putCells(NewLinkedTable[string, string, int](), CellOf("b", "y", 2)),
+			expected: false,
+		},
+	}
+
+	for _, test := range equalsTests {
+		assert.True(t, test.a.Equals(test.a))
+		assert.Equal(t, test.expected, test.a.Equals(test.b))
+		assert.Equal(t, test.expected, test.b.Equals(test.a))
+	}
+}
+
+func TestString(t *testing.T) {
+
+	type stringTest struct {
+		input    interface{ String() string }
+		expected string
+	}
+
+	cells := []Cell[string, string, int]{CellOf("b", "y", 1), CellOf("a", "x", 2), CellOf("b", "x", 3)}
+	stringTests := []stringTest{
+		{input: NewHashTable[string, string, int](), expected: "{}"},
+		{input: putCells(NewHashTable[string, string, int](), CellOf("a", "x", 2)), expected: "{a={x=2}}"},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), expected: "{b={y=1, x=3}, a={x=2}}"},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), expected: "{a={x=2}, b={x=3, y=1}}"},
+	}
+
+	for _, test := range stringTests {
+		assert.Equal(t, test.expected, test.input.String())
+	}
+}
@@ -0,0 +1,22 @@
+package table
+
+import (
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/maps/treemap"
+	"github.com/phantom820/collections/sets/treeset"
+)
+
+// TreeTable a [Table] that keeps its rows and the columns of each row in a [treemap.TreeMap], so both are iterated on in sorted order.
+type TreeTable[R comparable, C comparable, V comparable] struct {
+	table[R, C, V]
+}
+
+// NewTreeTable creates an empty table whose row keys are compared using rowLessThan and column keys using columnLessThan.
+func NewTreeTable[R comparable, C comparable, V comparable](rowLessThan func(r1, r2 R) bool, columnLessThan func(c1, c2 C) bool) *TreeTable[R, C, V] {
+	table := TreeTable[R, C, V]{}
+	table.rows = treemap.New[R, collections.Map[C, V]](rowLessThan)
+	table.newRow = func() collections.Map[C, V] { return treemap.New[C, V](columnLessThan) }
+	table.newRowKeySet = func() collections.Set[R] { return treeset.New(rowLessThan) }
+	table.newColumnKeySet = func() collections.Set[C] { return treeset.New(columnLessThan) }
+	return &table
+}
@@ -0,0 +1,193 @@
+package table
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/phantom820/collections"
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+)
+
+// mapView a [collections.Map] view of a row or a column of a table. The view is defined by the lookups, modifications and sequence it
+// is given, these go through the table so changes to the view are reflected in the table and vice versa.
+type mapView[K comparable, V comparable] struct {
+	get    func(k K) optional.Optional[V]
+	put    func(k K, v V) optional.Optional[V]
+	remove func(k K) optional.Optional[V]
+	len    func() int // Counts the mappings of the view without going through all of them.
+	all    iter.Seq2[K, V]
+}
+
+// ContainsKey returns true if the view contains a mapping for the specified key.
+func (view *mapView[K, V]) ContainsKey(k K) bool {
+	return !view.get(k).Empty()
+}
+
+// ContainsValue returns true if the view maps one or more keys to the specified value.
+func (view *mapView[K, V]) ContainsValue(v V, equals func(v1, v2 V) bool) bool {
+	for _, value := range view.all {
+		if equals(value, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// ForEach performs the given action for each key, value mapping in the view.
+func (view *mapView[K, V]) ForEach(f func(K, V)) {
+	for k, v := range view.all {
+		f(k, v)
+	}
+}
+
+// Clear removes all of the mappings of the view from the table.
+func (view *mapView[K, V]) Clear() {
+	view.RemoveIf(func(K) bool { return true })
+}
+
+// Get optionally returns the value associated with a key.
+func (view *mapView[K, V]) Get(k K) optional.Optional[V] {
+	return view.get(k)
+}
+
+// GetIf returns the values mapped by keys that match the given predicate.
+func (view *mapView[K, V]) GetIf(f func(K) bool) []V {
+	values := make([]V, 0)
+	for k, v := range view.all {
+		if f(k) {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// Put adds a new key/value pair to the view and optionally returns previously bound value.
+func (view *mapView[K, V]) Put(k K, v V) optional.Optional[V] {
+	return view.put(k, v)
+}
+
+// PutIfAbsent adds a new key/value pair to the view if the key is not already bound and optionally returns the bound value.
+func (view *mapView[K, V]) PutIfAbsent(k K, v V) optional.Optional[V] {
+	if value := view.get(k); !value.Empty() {
+		return value
+	}
+	return view.put(k, v)
+}
+
+// Len returns the size of the view.
+func (view *mapView[K, V]) Len() int {
+	return view.len()
+}
+
+// Remove removes a key from the view, returning the value associated previously with that key as an option.
+func (view *mapView[K, V]) Remove(k K) optional.Optional[V] {
+	return view.remove(k)
+}
+
+// RemoveIf removes all the key, value mappings in which the key matches the given predicate.
+func (view *mapView[K, V]) RemoveIf(f func(K) bool) bool {
+	keys := make([]K, 0)
+	for k := range view.all {
+		if f(k) {
+			keys = append(keys, k)
+		}
+	}
+	for _, k := range keys {
+		view.remove(k)
+	}
+	return len(keys) > 0
+}
+
+// Keys returns a slice containing the keys in the view.
+func (view *mapView[K, V]) Keys() []K {
+	keys := make([]K, 0)
+	for k := range view.all {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns a slice containing the values in the view.
+func (view *mapView[K, V]) Values() []V {
+	values := make([]V, 0)
+	for _, v := range view.all {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Empty returns true if the view has no elements.
+func (view *mapView[K, V]) Empty() bool {
+	for range view.all {
+		return false
+	}
+	return true
+}
+
+// Equals return true if the view is equal to the given map. Two maps are equal if they contain the same key, value pairs.
+func (view *mapView[K, V]) Equals(other collections.Map[K, V], equals func(V, V) bool) bool {
+	if view.Len() != other.Len() {
+		return false
+	}
+	for k, v := range view.all {
+		if value := other.Get(k); value.Empty() || !equals(v, value.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// All returns a sequence over the key, value pairs in the view.
+func (view *mapView[K, V]) All() iter.Seq2[K, V] {
+	return view.all
+}
+
+// AllKeys returns a sequence over the keys in the view.
+func (view *mapView[K, V]) AllKeys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range view.all {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// AllValues returns a sequence over the values in the view.
+func (view *mapView[K, V]) AllValues() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range view.all {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Iterator returns an iterator over a snapshot of the key, value pairs in the view.
+func (view *mapView[K, V]) Iterator() iterator.Iterator[pair.Pair[K, V]] {
+	entries := make([]pair.Pair[K, V], 0)
+	for k, v := range view.all {
+		entries = append(entries, pair.Of(k, v))
+	}
+	return iterator.Of(entries...)
+}
+
+// String returns the string representation of the view.
+func (view *mapView[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for k, v := range view.all {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", k, v))
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
@@ -0,0 +1,213 @@
+package table
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/phantom820/collections/iterator"
+	"github.com/phantom820/collections/maps/hashmap"
+	"github.com/phantom820/collections/types/optional"
+	"github.com/phantom820/collections/types/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func equals(v1, v2 int) bool {
+	return v1 == v2
+}
+
+func TestRow(t *testing.T) {
+
+	type rowTest struct {
+		input    Table[string, string, int]
+		rowKey   string
+		ordered  bool
+		expected []pair.Pair[string, int]
+	}
+
+	cells := []Cell[string, string, int]{CellOf("a", "y", 2), CellOf("a", "x", 1), CellOf("b", "x", 3)}
+	rowTests := []rowTest{
+		{input: NewHashTable[string, string, int](), rowKey: "a", ordered: true, expected: []pair.Pair[string, int]{}},
+		{input: putCells(NewHashTable[string, string, int](), cells...), rowKey: "a", ordered: false,
+			expected: []pair.Pair[string, int]{pair.Of("x", 1), pair.Of("y", 2)}},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), rowKey: "a", ordered: true,
+			expected: []pair.Pair[string, int]{pair.Of("y", 2), pair.Of("x", 1)}},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), rowKey: "a", ordered: true,
+			expected: []pair.Pair[string, int]{pair.Of("x", 1), pair.Of("y", 2)}},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), rowKey: "c", ordered: true,
+			expected: []pair.Pair[string, int]{}},
+	}
+
+	for _, test := range rowTests {
+		row := test.input.Row(test.rowKey)
+		if test.ordered {
+			assert.Equal(t, test.expected, iterator.ToSlice(row.Iterator()))
+		} else {
+			assert.ElementsMatch(t, test.expected, iterator.ToSlice(row.Iterator()))
+		}
+		expected := hashmap.New(test.expected...)
+		assert.Equal(t, len(test.expected), row.Len())
+		assert.Equal(t, len(test.expected) == 0, row.Empty())
+		assert.True(t, row.Equals(expected, equals))
+		assert.Equal(t, map[string]int(expected), maps.Collect(row.All()))
+		assert.ElementsMatch(t, expected.Keys(), row.Keys())
+		assert.ElementsMatch(t, expected.Values(), row.Values())
+		for _, entry := range test.expected {
+			assert.Equal(t, optional.Of(entry.Value()), row.Get(entry.Key()))
+			assert.True(t, row.ContainsKey(entry.Key()))
+			assert.True(t, row.ContainsValue(entry.Value(), equals))
+			assert.Equal(t, []int{entry.Value()}, row.GetIf(func(c string) bool { return c == entry.Key() }))
+		}
+		assert.Equal(t, optional.Empty[int](), row.Get("z"))
+		assert.False(t, row.ContainsValue(3, equals))
+	}
+}
+
+func TestRowModification(t *testing.T) {
+
+	type rowModificationTest struct {
+		input Table[string, string, int]
+	}
+
+	cells := []Cell[string, string, int]{CellOf("a", "x", 1), CellOf("a", "y", 2), CellOf("b", "x", 3)}
+	rowModificationTests := []rowModificationTest{
+		{input: putCells(NewHashTable[string, string, int](), cells...)},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...)},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...)},
+	}
+
+	for _, test := range rowModificationTests {
+		table, row := test.input, test.input.Row("a")
+
+		// Changes through the view are reflected in the table and vice versa.
+		assert.Equal(t, optional.Empty[int](), row.Put("z", 4))
+		assert.Equal(t, optional.Of(4), table.Get("a", "z"))
+		assert.Equal(t, optional.Of(4), row.PutIfAbsent("z", 5))
+		table.Put("a", "w", 5)
+		assert.Equal(t, 4, row.Len())
+		assert.Equal(t, 5, table.Len())
+		assert.Equal(t, optional.Of(1), row.Remove("x"))
+		assert.False(t, table.Contains("a", "x"))
+		assert.True(t, row.RemoveIf(func(c string) bool { return c == "y" }))
+		assert.Equal(t, 3, table.Len())
+
+		row.Clear()
+		assert.True(t, row.Empty())
+		assert.Equal(t, 0, row.Len())
+		assert.False(t, table.ContainsRow("a"))
+		assert.Equal(t, 1, table.Len())
+
+		// The view stays valid while the row is absent.
+		row.Put("x", 6)
+		assert.True(t, table.ContainsRow("a"))
+		assert.Equal(t, 1, row.Len())
+		assert.Equal(t, "{x=6}", row.(*mapView[string, int]).String())
+	}
+}
+
+func TestColumn(t *testing.T) {
+
+	type columnTest struct {
+		input    Table[string, string, int]
+		colKey   string
+		ordered  bool
+		expected []pair.Pair[string, int]
+	}
+
+	cells := []Cell[string, string, int]{CellOf("b", "x", 3), CellOf("a", "y", 2), CellOf("a", "x", 1)}
+	columnTests := []columnTest{
+		{input: NewHashTable[string, string, int](), colKey: "x", ordered: true, expected: []pair.Pair[string, int]{}},
+		{input: putCells(NewHashTable[string, string, int](), cells...), colKey: "x", ordered: false,
+			expected: []pair.Pair[string, int]{pair.Of("a", 1), pair.Of("b", 3)}},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...), colKey: "x", ordered: true,
+			expected: []pair.Pair[string, int]{pair.Of("b", 3), pair.Of("a", 1)}},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), colKey: "x", ordered: true,
+			expected: []pair.Pair[string, int]{pair.Of("a", 1), pair.Of("b", 3)}},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...), colKey: "y", ordered: true,
+			expected: []pair.Pair[string, int]{pair.Of("a", 2)}},
+	}
+
+	for _, test := range columnTests {
+		column := test.input.Column(test.colKey)
+		if test.ordered {
+			assert.Equal(t, test.expected, iterator.ToSlice(column.Iterator()))
+		} else {
+			assert.ElementsMatch(t, test.expected, iterator.ToSlice(column.Iterator()))
+		}
+		expected := hashmap.New(test.expected...)
+		assert.Equal(t, len(test.expected), column.Len())
+		assert.Equal(t, len(test.expected) == 0, column.Empty())
+		assert.True(t, column.Equals(expected, equals))
+		assert.ElementsMatch(t, expected.Keys(), column.Keys())
+		assert.ElementsMatch(t, expected.Values(), column.Values())
+		for _, entry := range test.expected {
+			assert.Equal(t, optional.Of(entry.Value()), column.Get(entry.Key()))
+		}
+		assert.Equal(t, optional.Empty[int](), column.Get("c"))
+	}
+}
+
+func TestColumnModification(t *testing.T) {
+
+	type columnModificationTest struct {
+		input Table[string, string, int]
+	}
+
+	cells := []Cell[string, string, int]{CellOf("a", "x", 1), CellOf("a", "y", 2), CellOf("b", "x", 3)}
+	columnModificationTests := []columnModificationTest{
+		{input: putCells(NewHashTable[string, string, int](), cells...)},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...)},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...)},
+	}
+
+	for _, test := range columnModificationTests {
+		table, column := test.input, test.input.Column("x")
+
+		// Changes through the view are reflected in the table and vice versa.
+		column.Put("c", 4)
+		assert.Equal(t, optional.Of(4), table.Get("c", "x"))
+		table.Remove("a", "x")
+		assert.False(t, column.ContainsKey("a"))
+		assert.Equal(t, 2, column.Len())
+		assert.Equal(t, 3, table.Len())
+
+		column.Clear()
+		assert.True(t, column.Empty())
+		assert.False(t, table.ContainsColumn("x"))
+		assert.False(t, table.ContainsRow("b"))
+		assert.Equal(t, 1, table.Len())
+		assert.True(t, table.Contains("a", "y"))
+	}
+}
+
+func TestViewString(t *testing.T) {
+
+	type viewStringTest struct {
+		input    Table[string, string, int]
+		view     func(Table[string, string, int]) any
+		expected string
+	}
+
+	cells := []Cell[string, string, int]{CellOf("b", "y", 1), CellOf("a", "y", 2), CellOf("a", "x", 3)}
+	viewStringTests := []viewStringTest{
+		{input: NewHashTable[string, string, int](),
+			view:     func(table Table[string, string, int]) any { return table.Row("a") },
+			expected: "{}",
+		},
+		{input: putCells(NewLinkedTable[string, string, int](), cells...),
+			view:     func(table Table[string, string, int]) any { return table.Row("a") },
+			expected: "{y=2, x=3}",
+		},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...),
+			view:     func(table Table[string, string, int]) any { return table.Row("a") },
+			expected: "{x=3, y=2}",
+		},
+		{input: putCells(NewTreeTable[string, string, int](lessThan, lessThan), cells...),
+			view:     func(table Table[string, string, int]) any { return table.Column("y") },
+			expected: "{a=2, b=1}",
+		},
+	}
+
+	for _, test := range viewStringTests {
+		assert.Equal(t, test.expected, test.view(test.input).(*mapView[string, int]).String())
+	}
+}
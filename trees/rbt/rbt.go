@@ -3,6 +3,7 @@ package rbt
 import (
 	"errors"
 	"fmt"
+	"iter"
 	"math"
 	"strings"
 
@@ -27,6 +28,7 @@ type redBlackNode[K comparable, V any] struct {
 	color  bool                // Color of the node.
 	key    K                   // Key of the node.
 	value  V                   // Value of the node.
+	size   int                 // Number of nodes in the subtree rooted at the node, the sentinel has size 0.
 }
 
 // Color return the color of the node, false -> black and true -> red.
@@ -36,7 +38,7 @@ func (node *redBlackNode[K, V]) Color() bool {
 
 // newRedBlackNode creates and returns a red black node with the specified key and value.
 func newRedBlackNode[K comparable, V any](key K, value V, sentinel *redBlackNode[K, V]) *redBlackNode[K, V] {
-	return &redBlackNode[K, V]{parent: sentinel, left: sentinel, right: sentinel, key: key, value: value}
+	return &redBlackNode[K, V]{parent: sentinel, left: sentinel, right: sentinel, key: key, value: value, size: 1}
 }
 
 // String returns a string of the form (key, value , color) representing the node.
@@ -50,12 +52,14 @@ type RedBlackTree[K comparable, V any] struct {
 	sentinel *redBlackNode[K, V] // The sentinel node.
 	len      int                 // Number of nodes in the tree.
 	lessThan func(K, K) bool     // The comparison for ordering keys.
+	modCount int                 // Number of structural modifications made to the tree.
 }
 
 // New creates a RedBlackTree. Keys are compared using the lessThan function which should satisfy.
 // k1 < k2 => lessThan(k1, k2) = true and lessThan(k2,k1) = false.
 // k1 = k2 => lessThan(k1,k2) = false and lessThan(k2,k1) = false.
 // k1 > k2 -> lessThan(k1,k2) = false and lessThan(k2,k1) = true.
+// Keys are equal whenever neither is less than the other, even if they are distinct under ==.
 func New[K comparable, V any](lessThan func(K, K) bool) *RedBlackTree[K, V] {
 	sentinel := redBlackNode[K, V]{parent: nil, left: nil, right: nil, color: BLACK}
 	return &RedBlackTree[K, V]{
@@ -72,6 +76,7 @@ func (tree *RedBlackTree[K, V]) Insert(key K, value V) optional.Optional[V] {
 	if ok {
 		tree.insertFix(node)
 		tree.len++
+		tree.modCount++
 		return optional.Empty[V]()
 	}
 	return optional.Of(stored)
@@ -88,23 +93,31 @@ func (tree *RedBlackTree[K, V]) Update(key K, value V) (V, bool) {
 	return temp, true
 }
 
+// equal returns true if neither key is less than the other.
+func (tree *RedBlackTree[K, V]) equal(k1, k2 K) bool {
+	return !tree.lessThan(k1, k2) && !tree.lessThan(k2, k1)
+}
+
 // insert inserts a node into the tree. For internal use to support Insert function.
 func (tree *RedBlackTree[K, V]) insert(z *redBlackNode[K, V]) (V, bool) {
 	var y *redBlackNode[K, V] = tree.sentinel
 	x := tree.root
 	for x != tree.sentinel {
 		y = x
-		if z.key == x.key {
+		if tree.lessThan(z.key, x.key) {
+			x = x.left
+		} else if tree.lessThan(x.key, z.key) {
+			x = x.right
+		} else {
 			stored := x.value
 			x.value = z.value
 			return stored, false
-		} else if tree.lessThan(z.key, x.key) {
-			x = x.left
-		} else {
-			x = x.right
 		}
 	}
 	z.parent = y
+	for node := y; node != tree.sentinel; node = node.parent {
+		node.size++
+	}
 	if y == tree.sentinel {
 		tree.root = z
 	} else if tree.lessThan(z.key, y.key) {
@@ -179,6 +192,8 @@ func (tree *RedBlackTree[K, V]) leftRotate(x *redBlackNode[K, V]) {
 	}
 	y.left = x
 	x.parent = y
+	y.size = x.size
+	x.size = x.left.size + x.right.size + 1
 }
 
 // rightRotate performs a right rotation around the node x of the tree. For internal use to support deleteFix and insertFix functions.
@@ -199,6 +214,8 @@ func (tree *RedBlackTree[K, V]) rightRotate(x *redBlackNode[K, V]) {
 	}
 	y.right = x
 	x.parent = y
+	y.size = x.size
+	x.size = x.left.size + x.right.size + 1
 }
 
 // transplant performs transplant operation on the tree. For internal use to support deleteFix and insertFix functions.
@@ -222,16 +239,360 @@ func (tree *RedBlackTree[K, V]) minimum(node *redBlackNode[K, V]) *redBlackNode[
 	}
 }
 
+// maximum returns the node with largest key value in the subtree rooted at the given node. For internal use to support Last.
+func (tree *RedBlackTree[K, V]) maximum(node *redBlackNode[K, V]) *redBlackNode[K, V] {
+	for node.right != tree.sentinel {
+		node = node.right
+	}
+	return node
+}
+
+// floor returns the node with the greatest key less than the given key, or equal to it if inclusive is true. The sentinel is returned
+// if there is no such node. For internal use to support Floor and Lower functions.
+func (tree *RedBlackTree[K, V]) floor(key K, inclusive bool) *redBlackNode[K, V] {
+	result := tree.sentinel
+	x := tree.root
+	for x != tree.sentinel {
+		if tree.lessThan(x.key, key) || (inclusive && !tree.lessThan(key, x.key)) {
+			result = x
+			x = x.right
+		} else {
+			x = x.left
+		}
+	}
+	return result
+}
+
+// ceiling returns the node with the least key greater than the given key, or equal to it if inclusive is true. The sentinel is returned
+// if there is no such node. For internal use to support Ceiling and Higher functions.
+func (tree *RedBlackTree[K, V]) ceiling(key K, inclusive bool) *redBlackNode[K, V] {
+	result := tree.sentinel
+	x := tree.root
+	for x != tree.sentinel {
+		if tree.lessThan(key, x.key) || (inclusive && !tree.lessThan(x.key, key)) {
+			result = x
+			x = x.left
+		} else {
+			x = x.right
+		}
+	}
+	return result
+}
+
+// entry returns the key, value pair of the node as an option, the option is empty if the node is the sentinel.
+func (tree *RedBlackTree[K, V]) entry(node *redBlackNode[K, V]) optional.Optional[pair.Pair[K, V]] {
+	if node == tree.sentinel {
+		return optional.Empty[pair.Pair[K, V]]()
+	}
+	return optional.Of(pair.Of(node.key, node.value))
+}
+
+// First returns the key, value pair with the smallest key in the tree as an option.
+func (tree *RedBlackTree[K, V]) First() optional.Optional[pair.Pair[K, V]] {
+	if tree.root == tree.sentinel {
+		return optional.Empty[pair.Pair[K, V]]()
+	}
+	return tree.entry(tree.minimum(tree.root))
+}
+
+// Last returns the key, value pair with the largest key in the tree as an option.
+func (tree *RedBlackTree[K, V]) Last() optional.Optional[pair.Pair[K, V]] {
+	if tree.root == tree.sentinel {
+		return optional.Empty[pair.Pair[K, V]]()
+	}
+	return tree.entry(tree.maximum(tree.root))
+}
+
+// Floor returns the key, value pair with the greatest key less than or equal to the given key as an option.
+func (tree *RedBlackTree[K, V]) Floor(key K) optional.Optional[pair.Pair[K, V]] {
+	return tree.entry(tree.floor(key, true))
+}
+
+// Lower returns the key, value pair with the greatest key strictly less than the given key as an option.
+func (tree *RedBlackTree[K, V]) Lower(key K) optional.Optional[pair.Pair[K, V]] {
+	return tree.entry(tree.floor(key, false))
+}
+
+// Ceiling returns the key, value pair with the least key greater than or equal to the given key as an option.
+func (tree *RedBlackTree[K, V]) Ceiling(key K) optional.Optional[pair.Pair[K, V]] {
+	return tree.entry(tree.ceiling(key, true))
+}
+
+// Higher returns the key, value pair with the least key strictly greater than the given key as an option.
+func (tree *RedBlackTree[K, V]) Higher(key K) optional.Optional[pair.Pair[K, V]] {
+	return tree.entry(tree.ceiling(key, false))
+}
+
+// Bound a lower or upper bound on the keys in a range of the tree. The zero value is an unbounded bound.
+type Bound[K comparable] struct {
+	key       K    // The key at the bound.
+	inclusive bool // Whether the key at the bound is part of the range.
+	bounded   bool // Whether the bound restricts the range at all.
+}
+
+// Unbounded returns a bound that does not restrict the range.
+func Unbounded[K comparable]() Bound[K] {
+	return Bound[K]{}
+}
+
+// InclusiveBound returns a bound at the given key that includes the key in the range.
+func InclusiveBound[K comparable](key K) Bound[K] {
+	return Bound[K]{key: key, inclusive: true, bounded: true}
+}
+
+// ExclusiveBound returns a bound at the given key that leaves the key out of the range.
+func ExclusiveBound[K comparable](key K) Bound[K] {
+	return Bound[K]{key: key, inclusive: false, bounded: true}
+}
+
+// Key returns the key at the bound.
+func (bound Bound[K]) Key() K {
+	return bound.key
+}
+
+// Inclusive returns true if the key at the bound is part of the range.
+func (bound Bound[K]) Inclusive() bool {
+	return bound.inclusive
+}
+
+// Bounded returns true if the bound restricts the range.
+func (bound Bound[K]) Bounded() bool {
+	return bound.bounded
+}
+
+// tooLow returns true if the key falls below the given lower bound.
+func (tree *RedBlackTree[K, V]) tooLow(key K, lower Bound[K]) bool {
+	if !lower.bounded {
+		return false
+	} else if tree.equal(key, lower.key) {
+		return !lower.inclusive
+	}
+	return tree.lessThan(key, lower.key)
+}
+
+// tooHigh returns true if the key falls above the given upper bound.
+func (tree *RedBlackTree[K, V]) tooHigh(key K, upper Bound[K]) bool {
+	if !upper.bounded {
+		return false
+	} else if tree.equal(key, upper.key) {
+		return !upper.inclusive
+	}
+	return tree.lessThan(upper.key, key)
+}
+
+// InRange returns true if the key falls within the range described by the given lower and upper bounds. The key does not have to be
+// in the tree.
+func (tree *RedBlackTree[K, V]) InRange(key K, lower Bound[K], upper Bound[K]) bool {
+	return !tree.tooLow(key, lower) && !tree.tooHigh(key, upper)
+}
+
+// lowest returns the node with the smallest key in the given range, the sentinel is returned if the range has no nodes.
+func (tree *RedBlackTree[K, V]) lowest(lower Bound[K], upper Bound[K]) *redBlackNode[K, V] {
+	node := tree.sentinel
+	if !lower.bounded {
+		if tree.root != tree.sentinel {
+			node = tree.minimum(tree.root)
+		}
+	} else {
+		node = tree.ceiling(lower.key, lower.inclusive)
+	}
+	if node == tree.sentinel || tree.tooHigh(node.key, upper) {
+		return tree.sentinel
+	}
+	return node
+}
+
+// highest returns the node with the largest key in the given range, the sentinel is returned if the range has no nodes.
+func (tree *RedBlackTree[K, V]) highest(lower Bound[K], upper Bound[K]) *redBlackNode[K, V] {
+	node := tree.sentinel
+	if !upper.bounded {
+		if tree.root != tree.sentinel {
+			node = tree.maximum(tree.root)
+		}
+	} else {
+		node = tree.floor(upper.key, upper.inclusive)
+	}
+	if node == tree.sentinel || tree.tooLow(node.key, lower) {
+		return tree.sentinel
+	}
+	return node
+}
+
+// successor returns the node that follows the given node in an in order traversal, the sentinel is returned if there is none.
+func (tree *RedBlackTree[K, V]) successor(node *redBlackNode[K, V]) *redBlackNode[K, V] {
+	if node.right != tree.sentinel {
+		return tree.minimum(node.right)
+	}
+	parent := node.parent
+	for parent != tree.sentinel && node == parent.right {
+		node = parent
+		parent = parent.parent
+	}
+	return parent
+}
+
+// predecessor returns the node that precedes the given node in an in order traversal, the sentinel is returned if there is none.
+func (tree *RedBlackTree[K, V]) predecessor(node *redBlackNode[K, V]) *redBlackNode[K, V] {
+	if node.left != tree.sentinel {
+		return tree.maximum(node.left)
+	}
+	parent := node.parent
+	for parent != tree.sentinel && node == parent.left {
+		node = parent
+		parent = parent.parent
+	}
+	return parent
+}
+
+// Lowest returns the key, value pair with the smallest key in the range described by the given bounds as an option.
+func (tree *RedBlackTree[K, V]) Lowest(lower Bound[K], upper Bound[K]) optional.Optional[pair.Pair[K, V]] {
+	return tree.entry(tree.lowest(lower, upper))
+}
+
+// Highest returns the key, value pair with the largest key in the range described by the given bounds as an option.
+func (tree *RedBlackTree[K, V]) Highest(lower Bound[K], upper Bound[K]) optional.Optional[pair.Pair[K, V]] {
+	return tree.entry(tree.highest(lower, upper))
+}
+
+// Range returns a sequence over the key, value pairs with keys in the range described by the given bounds. Pairs are yielded in the
+// sorted order of their keys, or in reverse order if descending is true. Locating the start of the range takes O(log n) and each
+// subsequent step is amortized O(1), the tree must not be structurally modified while ranging over it.
+func (tree *RedBlackTree[K, V]) Range(lower Bound[K], upper Bound[K], descending bool) iter.Seq[pair.Pair[K, V]] {
+	return func(yield func(pair.Pair[K, V]) bool) {
+		it := tree.RangeIterator(lower, upper, descending)
+		for it.HasNext() {
+			if !yield(it.Next()) {
+				return
+			}
+		}
+	}
+}
+
+// Iterator an in order iterator over the key, value pairs of a [RedBlackTree] with keys in a given range. The iterator walks the tree
+// through parent pointers, so it uses O(1) memory and each step is amortized O(1). The tree must not be structurally modified during
+// iteration other than through Remove.
+type Iterator[K comparable, V any] struct {
+	tree       *RedBlackTree[K, V]
+	next       *redBlackNode[K, V] // The node to be returned by Next, the sentinel if there is none.
+	last       *redBlackNode[K, V] // The node last returned by Next, nil if there is none.
+	lower      Bound[K]
+	upper      Bound[K]
+	descending bool
+}
+
+// Iterator returns an iterator over the key, value pairs in the tree in the sorted order of their keys.
+func (tree *RedBlackTree[K, V]) Iterator() *Iterator[K, V] {
+	return tree.RangeIterator(Unbounded[K](), Unbounded[K](), false)
+}
+
+// DescendingIterator returns an iterator over the key, value pairs in the tree in the reverse order of their keys.
+func (tree *RedBlackTree[K, V]) DescendingIterator() *Iterator[K, V] {
+	return tree.RangeIterator(Unbounded[K](), Unbounded[K](), true)
+}
+
+// IteratorFrom returns an iterator that starts at the given key, or the nearest key after it if it is not in the tree. If descending is
+// true the iterator moves towards smaller keys and starts at the nearest key before the given key instead.
+func (tree *RedBlackTree[K, V]) IteratorFrom(key K, descending bool) *Iterator[K, V] {
+	if descending {
+		return tree.RangeIterator(Unbounded[K](), InclusiveBound(key), true)
+	}
+	return tree.RangeIterator(InclusiveBound(key), Unbounded[K](), false)
+}
+
+// RangeIterator returns an iterator over the key, value pairs with keys in the range described by the given bounds. Pairs are returned
+// in the sorted order of their keys, or in reverse order if descending is true. Positioning the iterator takes O(log n).
+func (tree *RedBlackTree[K, V]) RangeIterator(lower Bound[K], upper Bound[K], descending bool) *Iterator[K, V] {
+	it := &Iterator[K, V]{tree: tree, lower: lower, upper: upper, descending: descending}
+	if descending {
+		it.next = tree.highest(lower, upper)
+	} else {
+		it.next = tree.lowest(lower, upper)
+	}
+	return it
+}
+
+// HasNext returns true if the iterator has more elements.
+func (it *Iterator[K, V]) HasNext() bool {
+	return it.next != it.tree.sentinel
+}
+
+// Next returns the next key, value pair in the iteration.
+func (it *Iterator[K, V]) Next() pair.Pair[K, V] {
+	if !it.HasNext() {
+		panic(errors.New("no more nodes to iterate over"))
+	}
+	it.last = it.next
+	if it.descending {
+		it.next = it.tree.predecessor(it.next)
+		if it.next != it.tree.sentinel && it.tree.tooLow(it.next.key, it.lower) {
+			it.next = it.tree.sentinel
+		}
+	} else {
+		it.next = it.tree.successor(it.next)
+		if it.next != it.tree.sentinel && it.tree.tooHigh(it.next.key, it.upper) {
+			it.next = it.tree.sentinel
+		}
+	}
+	return pair.Of(it.last.key, it.last.value)
+}
+
+// Remove deletes the node last returned by Next from the tree. Remove can only be called once per call to Next.
+func (it *Iterator[K, V]) Remove() {
+	if it.last == nil {
+		panic(errors.New("no node to remove, Next has not been called since the last removal"))
+	}
+	// Deleting a node only relocates its successor, so the next node remains valid in either direction.
+	it.tree.deleteNode(it.last)
+	it.last = nil
+}
+
+// Select returns the key, value pair with the given index in the sorted order of keys as an option, the option is empty if the index is
+// out of bounds. The smallest key has index 0.
+func (tree *RedBlackTree[K, V]) Select(i int) optional.Optional[pair.Pair[K, V]] {
+	if i < 0 || i >= tree.len {
+		return optional.Empty[pair.Pair[K, V]]()
+	}
+	x := tree.root
+	for x != tree.sentinel {
+		if i < x.left.size {
+			x = x.left
+		} else if i == x.left.size {
+			break
+		} else {
+			i = i - x.left.size - 1
+			x = x.right
+		}
+	}
+	return tree.entry(x)
+}
+
+// Rank returns the number of keys in the tree that are strictly less than the given key. The key does not have to be in the tree.
+func (tree *RedBlackTree[K, V]) Rank(key K) int {
+	rank := 0
+	x := tree.root
+	for x != tree.sentinel {
+		if tree.lessThan(x.key, key) {
+			rank = rank + x.left.size + 1
+			x = x.right
+		} else if tree.lessThan(key, x.key) {
+			x = x.left
+		} else {
+			return rank + x.left.size
+		}
+	}
+	return rank
+}
+
 // search finds the node with the given key in the tree. For internal use to support Search function.
 func (tree *RedBlackTree[K, V]) search(key K) *redBlackNode[K, V] {
 	x := tree.root
 	for x != tree.sentinel {
-		if x.key == key {
-			return x
-		} else if tree.lessThan(x.key, key) {
+		if tree.lessThan(x.key, key) {
 			x = x.right
-		} else {
+		} else if tree.lessThan(key, x.key) {
 			x = x.left
+		} else {
+			return x
 		}
 	}
 	return x
@@ -240,7 +601,7 @@ func (tree *RedBlackTree[K, V]) search(key K) *redBlackNode[K, V] {
 // SubTree returns a new tree that consists of nodes with keys that are in the specified key range [fromKey,toKey]. If fromInclusive is
 // true then range includes fromKey otherwise it is left out and if toInclusive is true toKey is included in the range.
 func (tree *RedBlackTree[K, V]) SubTree(fromKey K, fromInclusive bool, toKey K, toInclusive bool) *RedBlackTree[K, V] {
-	if tree.lessThan(toKey, fromKey) && !(toKey == fromKey) {
+	if tree.lessThan(toKey, fromKey) {
 		panic(errors.New("undefined range lower key cannot be greater than upper key bound"))
 	}
 	subTree := New[K, V](tree.lessThan)
@@ -254,9 +615,9 @@ func (tree *RedBlackTree[K, V]) SubTree(fromKey K, fromInclusive bool, toKey K,
 			traverse(node.left)
 		}
 
-		if node.key == fromKey && fromInclusive {
+		if tree.equal(node.key, fromKey) && fromInclusive {
 			subTree.Insert(node.key, node.value)
-		} else if node.key == toKey && toInclusive {
+		} else if tree.equal(node.key, toKey) && toInclusive {
 			subTree.Insert(node.key, node.value)
 		} else if tree.lessThan(fromKey, node.key) && tree.lessThan(node.key, toKey) {
 			subTree.Insert(node.key, node.value)
@@ -284,7 +645,7 @@ func (tree *RedBlackTree[K, V]) LeftSubTree(key K, inclusive bool) *RedBlackTree
 		if node.left != tree.sentinel {
 			traverse(node.left)
 		}
-		if node.key == key && inclusive {
+		if tree.equal(node.key, key) && inclusive {
 			subTree.Insert(node.key, node.value)
 		} else if tree.lessThan(node.key, key) {
 			subTree.Insert(node.key, node.value)
@@ -312,7 +673,7 @@ func (tree *RedBlackTree[K, V]) RightSubTree(key K, inclusive bool) *RedBlackTre
 		if node.left != tree.sentinel {
 			traverse(node.left)
 		}
-		if node.key == key && inclusive {
+		if tree.equal(node.key, key) && inclusive {
 			subTree.Insert(node.key, node.value)
 		} else if tree.lessThan(key, node.key) {
 			subTree.Insert(node.key, node.value)
@@ -373,13 +734,18 @@ func (tree *RedBlackTree[K, V]) Delete(key K) optional.Optional[V] {
 	if node == tree.sentinel {
 		return optional.Empty[V]()
 	}
+	temp := node.value
+	tree.deleteNode(node)
+	return optional.Of(temp)
+}
+
+// deleteNode deletes the given node from the tree and updates the bookkeeping of the tree. For internal use to support Delete function.
+func (tree *RedBlackTree[K, V]) deleteNode(node *redBlackNode[K, V]) {
 	tree.delete(node)
 	tree.len = int(math.Max(0, float64(tree.len-1)))
+	tree.modCount++
 	node.left = nil
 	node.right = nil
-	temp := node.value
-	node = nil
-	return optional.Of(temp)
 }
 
 // delete deletes the node z from the tree. For internal use to support Delete function.
@@ -387,6 +753,14 @@ func (tree *RedBlackTree[K, V]) delete(z *redBlackNode[K, V]) {
 	var x, y *redBlackNode[K, V]
 	y = z
 	yOriginalColor := y.color
+	// The node that leaves its position is z if it has at most one child, otherwise its successor which takes its place.
+	removed := z
+	if z.left != tree.sentinel && z.right != tree.sentinel {
+		removed = tree.minimum(z.right)
+	}
+	for node := removed.parent; node != tree.sentinel; node = node.parent {
+		node.size--
+	}
 	if z.left == tree.sentinel {
 		x = z.right
 		tree.transplant(z, z.right)
@@ -409,6 +783,7 @@ func (tree *RedBlackTree[K, V]) delete(z *redBlackNode[K, V]) {
 		y.left = z.left
 		y.left.parent = y
 		y.color = z.color
+		y.size = z.size
 	}
 	if yOriginalColor == BLACK {
 		tree.deleteFix(x)
@@ -550,6 +925,12 @@ func (tree *RedBlackTree[K, V]) Len() int {
 	return tree.len
 }
 
+// Modifications returns the number of structural modifications (insertions of new keys and deletions) made to the tree. Iterators
+// over the tree compare it against the value observed when iteration started to detect modifications during iteration.
+func (tree *RedBlackTree[K, V]) Modifications() int {
+	return tree.modCount
+}
+
 // Clear deletes all the nodes in the tree.
 func (tree *RedBlackTree[K, V]) Clear() {
 	tree.root = nil
@@ -558,6 +939,7 @@ func (tree *RedBlackTree[K, V]) Clear() {
 	sentinel := &redBlackNode[K, V]{parent: nil, left: nil, right: nil, color: BLACK}
 	tree.root = sentinel
 	tree.sentinel = sentinel
+	tree.modCount++
 }
 
 // Empty checks if the tree is empty.
@@ -1,6 +1,7 @@
 package rbt
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/phantom820/collections/types/optional"
@@ -910,3 +911,247 @@ func TestSubTree(t *testing.T) {
 	}
 
 }
+
+func TestModifications(t *testing.T) {
+
+	tree := New[int, int](func(i1, i2 int) bool { return i1 < i2 })
+	assert.Equal(t, 0, tree.Modifications())
+	tree.Insert(1, 1)
+	tree.Insert(2, 2)
+	assert.Equal(t, 2, tree.Modifications())
+	tree.Insert(1, 3)
+	tree.Update(2, 3)
+	tree.Delete(3)
+	assert.Equal(t, 2, tree.Modifications())
+	tree.Delete(1)
+	tree.Clear()
+	assert.Equal(t, 4, tree.Modifications())
+}
+
+func TestNavigation(t *testing.T) {
+
+	lessThan := func(i1, i2 int) bool { return i1 < i2 }
+	empty := New[int, int](lessThan)
+	assert.True(t, empty.First().Empty())
+	assert.True(t, empty.Last().Empty())
+	assert.True(t, empty.Floor(1).Empty())
+	assert.True(t, empty.Ceiling(1).Empty())
+
+	tree := New[int, int](lessThan)
+	for _, key := range []int{50, 20, 80, 10, 30, 70, 90, 60} {
+		tree.Insert(key, key*10)
+	}
+
+	type navigationTest struct {
+		input    optional.Optional[pair.Pair[int, int]]
+		expected optional.Optional[pair.Pair[int, int]]
+	}
+
+	navigationTests := []navigationTest{
+		{input: tree.First(), expected: optional.Of(pair.Of(10, 100))},
+		{input: tree.Last(), expected: optional.Of(pair.Of(90, 900))},
+		{input: tree.Floor(30), expected: optional.Of(pair.Of(30, 300))},
+		{input: tree.Floor(35), expected: optional.Of(pair.Of(30, 300))},
+		{input: tree.Floor(5), expected: optional.Empty[pair.Pair[int, int]]()},
+		{input: tree.Lower(30), expected: optional.Of(pair.Of(20, 200))},
+		{input: tree.Lower(10), expected: optional.Empty[pair.Pair[int, int]]()},
+		{input: tree.Ceiling(60), expected: optional.Of(pair.Of(60, 600))},
+		{input: tree.Ceiling(55), expected: optional.Of(pair.Of(60, 600))},
+		{input: tree.Ceiling(95), expected: optional.Empty[pair.Pair[int, int]]()},
+		{input: tree.Higher(60), expected: optional.Of(pair.Of(70, 700))},
+		{input: tree.Higher(90), expected: optional.Empty[pair.Pair[int, int]]()},
+	}
+
+	for _, test := range navigationTests {
+		assert.Equal(t, test.expected, test.input)
+	}
+}
+
+func TestEquivalentKeys(t *testing.T) {
+
+	// Keys in the same ten are equal under the ordering even though they are distinct.
+	tensLessThan := func(i1, i2 int) bool { return i1/10 < i2/10 }
+	tree := New[int, int](tensLessThan)
+	for _, key := range []int{12, 35, 15, 31, 58} {
+		tree.Insert(key, key)
+	}
+	assert.Equal(t, []int{12, 35, 58}, tree.Keys())
+	assert.Equal(t, []int{15, 31, 58}, tree.Values())
+
+	type equivalentKeysTest struct {
+		input    optional.Optional[pair.Pair[int, int]]
+		expected optional.Optional[pair.Pair[int, int]]
+	}
+
+	equivalentKeysTests := []equivalentKeysTest{
+		{input: tree.Floor(39), expected: optional.Of(pair.Of(35, 31))},
+		{input: tree.Lower(39), expected: optional.Of(pair.Of(12, 15))},
+		{input: tree.Ceiling(10), expected: optional.Of(pair.Of(12, 15))},
+		{input: tree.Higher(10), expected: optional.Of(pair.Of(35, 31))},
+	}
+
+	for _, test := range equivalentKeysTests {
+		assert.Equal(t, test.expected, test.input)
+	}
+	assert.True(t, tree.Search(19))
+	assert.Equal(t, optional.Of(31), tree.Get(30))
+	assert.Equal(t, 1, tree.Rank(33))
+	assert.True(t, tree.InRange(19, InclusiveBound(10), ExclusiveBound(30)))
+	assert.False(t, tree.InRange(39, InclusiveBound(10), ExclusiveBound(30)))
+	tree.Delete(50)
+	assert.Equal(t, []int{12, 35}, tree.Keys())
+}
+
+func TestRange(t *testing.T) {
+
+	lessThan := func(i1, i2 int) bool { return i1 < i2 }
+	tree := New[int, int](lessThan)
+	for i := 1; i <= 20; i++ {
+		tree.Insert(i*5, i)
+	}
+
+	keys := func(lower Bound[int], upper Bound[int], descending bool) []int {
+		keys := make([]int, 0)
+		for pair := range tree.Range(lower, upper, descending) {
+			keys = append(keys, pair.Key())
+		}
+		return keys
+	}
+
+	type rangeTest struct {
+		lower      Bound[int]
+		upper      Bound[int]
+		descending bool
+		expected   []int
+	}
+
+	rangeTests := []rangeTest{
+		{lower: InclusiveBound(10), upper: InclusiveBound(30), expected: []int{10, 15, 20, 25, 30}},
+		{lower: ExclusiveBound(10), upper: ExclusiveBound(30), expected: []int{15, 20, 25}},
+		{lower: InclusiveBound(11), upper: InclusiveBound(29), expected: []int{15, 20, 25}},
+		{lower: ExclusiveBound(10), upper: ExclusiveBound(30), descending: true, expected: []int{25, 20, 15}},
+		{lower: Unbounded[int](), upper: ExclusiveBound(20), expected: []int{5, 10, 15}},
+		{lower: ExclusiveBound(85), upper: Unbounded[int](), expected: []int{90, 95, 100}},
+		{lower: ExclusiveBound(85), upper: Unbounded[int](), descending: true, expected: []int{100, 95, 90}},
+		{lower: InclusiveBound(21), upper: InclusiveBound(24), expected: []int{}},
+		{lower: InclusiveBound(101), upper: Unbounded[int](), expected: []int{}},
+	}
+
+	for _, test := range rangeTests {
+		assert.Equal(t, test.expected, keys(test.lower, test.upper, test.descending))
+	}
+
+	assert.Equal(t, 20, len(keys(Unbounded[int](), Unbounded[int](), false)))
+	assert.Equal(t, tree.Keys(), keys(Unbounded[int](), Unbounded[int](), false))
+	assert.True(t, tree.InRange(10, InclusiveBound(10), ExclusiveBound(20)))
+	assert.False(t, tree.InRange(20, InclusiveBound(10), ExclusiveBound(20)))
+	assert.False(t, tree.InRange(5, InclusiveBound(10), ExclusiveBound(20)))
+	assert.Equal(t, optional.Of(pair.Of(15, 3)), tree.Lowest(ExclusiveBound(10), ExclusiveBound(20)))
+	assert.Equal(t, optional.Of(pair.Of(15, 3)), tree.Highest(ExclusiveBound(10), ExclusiveBound(20)))
+	assert.True(t, tree.Lowest(InclusiveBound(21), InclusiveBound(24)).Empty())
+	assert.True(t, tree.Highest(InclusiveBound(21), InclusiveBound(24)).Empty())
+}
+
+func TestOrderStatistics(t *testing.T) {
+
+	lessThan := func(i1, i2 int) bool { return i1 < i2 }
+	tree := New[int, int](lessThan)
+	assert.True(t, tree.Select(0).Empty())
+	assert.Equal(t, 0, tree.Rank(10))
+
+	// sizes checks that every node stores the size of its subtree.
+	var sizes func(node *redBlackNode[int, int]) int
+	sizes = func(node *redBlackNode[int, int]) int {
+		if node == tree.sentinel {
+			return 0
+		}
+		size := sizes(node.left) + sizes(node.right) + 1
+		assert.Equal(t, size, node.size)
+		return size
+	}
+
+	random := rand.New(rand.NewSource(7))
+	for i := 0; i < 2000; i++ {
+		key := random.Intn(500)
+		if random.Intn(3) == 0 {
+			tree.Delete(key)
+		} else {
+			tree.Insert(key, key)
+		}
+	}
+	assert.Equal(t, tree.Len(), sizes(tree.root))
+
+	keys := tree.Keys()
+	for i, key := range keys {
+		assert.Equal(t, optional.Of(pair.Of(key, key)), tree.Select(i))
+		assert.Equal(t, i, tree.Rank(key))
+		assert.Equal(t, i+1, tree.Rank(key+1))
+	}
+	assert.True(t, tree.Select(-1).Empty())
+	assert.True(t, tree.Select(len(keys)).Empty())
+	assert.Equal(t, 0, tree.Rank(-1))
+	assert.Equal(t, len(keys), tree.Rank(500))
+
+	tree.Clear()
+	tree.Insert(1, 1)
+	assert.Equal(t, 1, sizes(tree.root))
+}
+
+func TestIterator(t *testing.T) {
+
+	lessThan := func(i1, i2 int) bool { return i1 < i2 }
+	tree := New[int, int](lessThan)
+	assert.False(t, tree.Iterator().HasNext())
+	assert.False(t, tree.DescendingIterator().HasNext())
+	for i := 1; i <= 10; i++ {
+		tree.Insert(i*10, i)
+	}
+
+	keys := func(it *Iterator[int, int]) []int {
+		keys := make([]int, 0)
+		for it.HasNext() {
+			keys = append(keys, it.Next().Key())
+		}
+		return keys
+	}
+
+	type iteratorTest struct {
+		input    *Iterator[int, int]
+		expected []int
+	}
+
+	iteratorTests := []iteratorTest{
+		{input: tree.Iterator(), expected: []int{10, 20, 30, 40, 50, 60, 70, 80, 90, 100}},
+		{input: tree.DescendingIterator(), expected: []int{100, 90, 80, 70, 60, 50, 40, 30, 20, 10}},
+		{input: tree.IteratorFrom(75, false), expected: []int{80, 90, 100}},
+		{input: tree.IteratorFrom(80, false), expected: []int{80, 90, 100}},
+		{input: tree.IteratorFrom(25, true), expected: []int{20, 10}},
+		{input: tree.IteratorFrom(5, true), expected: []int{}},
+		{input: tree.IteratorFrom(105, false), expected: []int{}},
+		{input: tree.RangeIterator(InclusiveBound(30), ExclusiveBound(60), true), expected: []int{50, 40, 30}},
+	}
+
+	for _, test := range iteratorTests {
+		assert.Equal(t, test.expected, keys(test.input))
+	}
+
+	it := tree.Iterator()
+	assert.Panics(t, func() { it.Remove() })
+	for it.HasNext() {
+		if it.Next().Value()%2 == 0 {
+			it.Remove()
+		}
+	}
+	assert.Equal(t, []int{10, 30, 50, 70, 90}, tree.Keys())
+	assert.Panics(t, func() { it.Next() })
+
+	it = tree.DescendingIterator()
+	for it.HasNext() {
+		if it.Next().Key() != 50 {
+			it.Remove()
+		}
+	}
+	assert.Equal(t, []int{50}, tree.Keys())
+	assert.Equal(t, 1, tree.Len())
+	assert.Equal(t, 1, tree.root.size)
+}